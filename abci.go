@@ -2,6 +2,7 @@ package weave
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
@@ -47,7 +48,8 @@ type DeliverResult struct {
 	Diff []ValidatorUpdate
 	// Tags, if present, will be used by tendermint to index and search the transaction history
 	Tags []common.KVPair
-	// GasUsed is currently unused field until effects in tendermint are clear
+	// GasUsed is the amount of gas consumed by the transaction. It is set
+	// by the application from the gas meter of the transaction.
 	GasUsed int64
 }
 
@@ -91,8 +93,19 @@ type CheckResult struct {
 	// GasPayment is the total fees for this tx (or other source of payment)
 	//TODO: Implement when tendermint implements this properly
 	GasPayment int64
+	// Priority declares how important this transaction is when compared
+	// to other pending transactions. Higher value means more important.
+	// The ABCI response of this Tendermint version has no priority field,
+	// so the value is returned as the PriorityTag tag. It is also used by
+	// the application, for example to decide if a pending transaction can
+	// be replaced.
+	Priority int64
 }
 
+// PriorityTag is the tag of a check response that holds the priority of the
+// transaction as a decimal number.
+const PriorityTag = "tx.priority"
+
 // NewCheck sets the gas used and the response data but no more info
 // these are the most common info needed to be set by the Handler
 func NewCheck(gasAllocated int64, log string) CheckResult {
@@ -104,11 +117,18 @@ func NewCheck(gasAllocated int64, log string) CheckResult {
 
 // ToABCI converts our internal type into an abci response
 func (c CheckResult) ToABCI() abci.ResponseCheckTx {
-	return abci.ResponseCheckTx{
+	res := abci.ResponseCheckTx{
 		Data:      c.Data,
 		Log:       c.Log,
 		GasWanted: c.GasAllocated,
 	}
+	if c.Priority != 0 {
+		res.Tags = []common.KVPair{{
+			Key:   []byte(PriorityTag),
+			Value: []byte(strconv.FormatInt(c.Priority, 10)),
+		}}
+	}
+	return res
 }
 
 // DeliverTxError converts any error into a abci.ResponseDeliverTx, preserving
@@ -122,6 +142,7 @@ func DeliverTxError(err error, debug bool) abci.ResponseDeliverTx {
 	return abci.ResponseDeliverTx{
 		Code: code,
 		Log:  log,
+		Tags: errorTags(err),
 	}
 }
 
@@ -136,5 +157,36 @@ func CheckTxError(err error, debug bool) abci.ResponseCheckTx {
 	return abci.ResponseCheckTx{
 		Code: code,
 		Log:  log,
+		Tags: errorTags(err),
+	}
+}
+
+// ErrorTagger is implemented by errors that carry details that a client can
+// process, for example the entity that a transaction conflicts with.
+// DeliverTxError and CheckTxError return those details as response tags.
+type ErrorTagger interface {
+	ErrorTags() []common.KVPair
+}
+
+// errorTags returns tags of all errors that given error is or wraps that
+// implement the ErrorTagger interface.
+func errorTags(err error) []common.KVPair {
+	var tags []common.KVPair
+	for err != nil {
+		if t, ok := err.(ErrorTagger); ok {
+			tags = append(tags, t.ErrorTags()...)
+		}
+		if u, ok := err.(interface{ Unpack() []error }); ok {
+			for _, e := range u.Unpack() {
+				tags = append(tags, errorTags(e)...)
+			}
+			return tags
+		}
+		c, ok := err.(interface{ Cause() error })
+		if !ok {
+			return tags
+		}
+		err = c.Cause()
 	}
+	return tags
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/weavetest/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 func TestCreateResults(t *testing.T) {
@@ -26,6 +27,13 @@ func TestCreateResults(t *testing.T) {
 	assert.Equal(t, log, ac.Log)
 	assert.Equal(t, gas, ac.GasWanted)
 	assert.Equal(t, 0, len(ac.Data))
+	assert.Equal(t, 0, len(ac.Tags))
+
+	cres.Priority = 42
+	ac = cres.ToABCI()
+	assert.Equal(t, 1, len(ac.Tags))
+	assert.Equal(t, PriorityTag, string(ac.Tags[0].Key))
+	assert.Equal(t, "42", string(ac.Tags[0].Value))
 }
 
 func TestDeliverTxError(t *testing.T) {
@@ -109,6 +117,33 @@ func TestDeliverTxError(t *testing.T) {
 				Log:  "cannot deliver tx: internal error",
 			},
 		},
+		"error tags are returned": {
+			err:   errors.Wrap(&taggedError{abciError: abciError{code: 666, msg: "not found"}, tag: "first"}, "not here"),
+			debug: false,
+			wantResp: abci.ResponseDeliverTx{
+				Code: 666,
+				Log:  "cannot deliver tx: not here: not found",
+				Tags: []common.KVPair{{Key: []byte("tag"), Value: []byte("first")}},
+			},
+		},
+		"error tags of a multi-error are returned": {
+			err: errors.Append(
+				&taggedError{abciError: abciError{code: 111, msg: "first"}, tag: "first"},
+				&taggedError{abciError: abciError{code: 222, msg: "second"}, tag: "second"},
+			),
+			debug: false,
+			wantResp: abci.ResponseDeliverTx{
+				Code: 1000,
+				Log: `cannot deliver tx: 2 errors occurred:
+	* first
+	* second
+`,
+				Tags: []common.KVPair{
+					{Key: []byte("tag"), Value: []byte("first")},
+					{Key: []byte("tag"), Value: []byte("second")},
+				},
+			},
+		},
 	}
 
 	for testName, tc := range cases {
@@ -187,3 +222,12 @@ func (e *abciError) ABCICode() uint32 {
 func (e *abciError) Error() string {
 	return e.msg
 }
+
+type taggedError struct {
+	abciError
+	tag string
+}
+
+func (e *taggedError) ErrorTags() []common.KVPair {
+	return []common.KVPair{{Key: []byte("tag"), Value: []byte(e.tag)}}
+}
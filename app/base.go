@@ -4,16 +4,19 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
-// BaseApp adds DeliverTx, CheckTx, and BeginBlock
+// BaseApp adds DeliverTx, CheckTx, BeginBlock and EndBlock
 // handlers to the storage and query functionality of StoreApp
 type BaseApp struct {
 	*StoreApp
-	decoder weave.TxDecoder
-	handler weave.Handler
-	ticker  weave.Ticker
-	debug   bool
+	decoder     weave.TxDecoder
+	handler     weave.Handler
+	ticker      weave.Ticker
+	endBlockers []weave.EndBlocker
+	checkCtx    func(weave.Context) weave.Context
+	debug       bool
 }
 
 var _ abci.Application = BaseApp{}
@@ -35,6 +38,25 @@ func NewBaseApp(
 	}
 }
 
+// WithEndBlocker returns a copy of the application that executes given end
+// blocker at the end of every block. End blockers are executed in the order
+// they were registered.
+func (b BaseApp) WithEndBlocker(e weave.EndBlocker) BaseApp {
+	endBlockers := make([]weave.EndBlocker, len(b.endBlockers), len(b.endBlockers)+1)
+	copy(endBlockers, b.endBlockers)
+	b.endBlockers = append(endBlockers, e)
+	return b
+}
+
+// WithCheckContext returns a copy of the application that passes the context
+// of every checked transaction through given function. It allows to provide
+// node local state, for example the transactions waiting in the mempool,
+// that must never be available when delivering transactions.
+func (b BaseApp) WithCheckContext(fn func(weave.Context) weave.Context) BaseApp {
+	b.checkCtx = fn
+	return b
+}
+
 // DeliverTx - ABCI - dispatches to the handler
 func (b BaseApp) DeliverTx(txBytes []byte) abci.ResponseDeliverTx {
 	tx, err := b.loadTx(txBytes)
@@ -46,12 +68,18 @@ func (b BaseApp) DeliverTx(txBytes []byte) abci.ResponseDeliverTx {
 	ctx := weave.WithLogInfo(b.BlockContext(),
 		"call", "deliver_tx",
 		"path", weave.GetPath(tx))
+	meter := weave.NewGasMeter(weave.TxGasLimit(tx))
+	ctx = weave.WithGasMeter(ctx, meter)
+	db := weave.NewGasKVStore(b.DeliverStore(), meter, weave.DefaultGasConfig())
 
-	res, err := b.handler.Deliver(ctx, b.DeliverStore(), tx)
+	res, err := b.handler.Deliver(ctx, db, tx)
 	if err == nil {
 		b.AddValChange(res.Diff)
 	}
-	return weave.DeliverOrError(res, err, b.debug)
+	resp := weave.DeliverOrError(res, err, b.debug)
+	resp.GasWanted = meter.GasLimit()
+	resp.GasUsed = meter.GasConsumed()
+	return resp
 }
 
 // CheckTx - ABCI - dispatches to the handler
@@ -64,9 +92,22 @@ func (b BaseApp) CheckTx(txBytes []byte) abci.ResponseCheckTx {
 	ctx := weave.WithLogInfo(b.BlockContext(),
 		"call", "check_tx",
 		"path", weave.GetPath(tx))
+	if b.checkCtx != nil {
+		ctx = b.checkCtx(ctx)
+	}
+	meter := weave.NewGasMeter(weave.TxGasLimit(tx))
+	ctx = weave.WithGasMeter(ctx, meter)
+	db := weave.NewGasKVStore(b.CheckStore(), meter, weave.DefaultGasConfig())
 
-	res, err := b.handler.Check(ctx, b.CheckStore(), tx)
-	return weave.CheckOrError(res, err, b.debug)
+	res, err := b.handler.Check(ctx, db, tx)
+	resp := weave.CheckOrError(res, err, b.debug)
+	// A transaction that declares a gas limit reserves that much gas in the
+	// block. Otherwise the handler estimate is used.
+	if limit := meter.GasLimit(); limit > 0 {
+		resp.GasWanted = limit
+	}
+	resp.GasUsed = meter.GasConsumed()
+	return resp
 }
 
 // BeginBlock - ABCI
@@ -84,6 +125,23 @@ func (b BaseApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock
 	return response
 }
 
+// EndBlock - ABCI
+// Executes all registered end blockers. Their tags and validator updates are
+// merged with the validator updates produced by the block transactions.
+func (b BaseApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	var tags []common.KVPair
+	for _, e := range b.endBlockers {
+		ctx := weave.WithLogInfo(b.BlockContext(), "call", "end_block")
+		res := e.EndBlock(ctx, b.DeliverStore())
+		tags = append(tags, res.Tags...)
+		b.AddValChange(res.Diff)
+	}
+
+	response := b.StoreApp.EndBlock(req)
+	response.Tags = append(response.Tags, tags...)
+	return response
+}
+
 // loadTx calls the decoder, and capture any panics
 func (b BaseApp) loadTx(txBytes []byte) (tx weave.Tx, err error) {
 	defer errors.Recover(&err)
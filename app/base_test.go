@@ -0,0 +1,85 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+func TestBaseAppEndBlock(t *testing.T) {
+	pubKey := weave.PubKey{Type: "test", Data: []byte("someKey")}
+	pubKey2 := weave.PubKey{Type: "test", Data: []byte("someKey2")}
+
+	handler := &weavetest.Handler{
+		DeliverResult: weave.DeliverResult{
+			Diff: []weave.ValidatorUpdate{
+				{PubKey: pubKey, Power: 10},
+				{PubKey: pubKey2, Power: 20},
+			},
+		},
+	}
+	rewards := &weavetest.EndBlocker{
+		Result: weave.TickResult{
+			Tags: []common.KVPair{{Key: []byte("rewards"), Value: []byte("paid")}},
+		},
+		Exec: func(ctx weave.Context, db weave.CacheableKVStore) {
+			if err := db.Set([]byte("rewards"), []byte("paid")); err != nil {
+				t.Fatalf("cannot set: %s", err)
+			}
+		},
+	}
+	rotation := &weavetest.EndBlocker{
+		Result: weave.TickResult{
+			Tags: []common.KVPair{{Key: []byte("rotation"), Value: []byte("done")}},
+			// End blocker update overwrites the transaction update.
+			Diff: []weave.ValidatorUpdate{{PubKey: pubKey, Power: 1}},
+		},
+	}
+
+	decoder := func(raw []byte) (weave.Tx, error) {
+		var tx weavetest.Tx
+		return &tx, tx.Unmarshal(raw)
+	}
+	store := NewStoreApp("dummy", iavl.MockCommitStore(), weave.NewQueryRouter(), context.Background())
+	app := NewBaseApp(store, decoder, handler, nil, false).
+		WithEndBlocker(rewards).
+		WithEndBlocker(rotation)
+
+	runner := weavetest.NewWeaveRunner(t, app, "mychain")
+	changed := runner.InBlock(func(wapp weavetest.WeaveApp) error {
+		return wapp.DeliverTx(&weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "test/msg"}})
+	})
+	assert.Equal(t, true, changed)
+	assert.Equal(t, 1, handler.DeliverCallCount())
+	assert.Equal(t, 1, rewards.CallCount())
+	assert.Equal(t, 1, rotation.CallCount())
+
+	res := runner.LastEndBlock()
+	wantTags := []common.KVPair{
+		{Key: []byte("rewards"), Value: []byte("paid")},
+		{Key: []byte("rotation"), Value: []byte("done")},
+	}
+	assert.Equal(t, wantTags, res.Tags)
+	wantDiff := []weave.ValidatorUpdate{
+		{PubKey: pubKey, Power: 1},
+		{PubKey: pubKey2, Power: 20},
+	}
+	assert.Equal(t, wantDiff, weave.ValidatorUpdatesFromABCI(res.ValidatorUpdates).ValidatorUpdates)
+
+	// State modified by an end blocker is committed.
+	val, err := store.DeliverStore().Get([]byte("rewards"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("paid"), val)
+
+	// Validator updates are not carried over to the next block.
+	handler.DeliverResult = weave.DeliverResult{}
+	rotation.Result.Diff = nil
+	runner.InBlock(func(weavetest.WeaveApp) error { return nil })
+	assert.Equal(t, 2, rewards.CallCount())
+	assert.Equal(t, 0, len(runner.LastEndBlock().ValidatorUpdates))
+}
@@ -1,7 +1,11 @@
 package app
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 )
 
 //------ init state -----
@@ -26,3 +30,108 @@ func (c chainInitializer) FromGenesis(opts weave.Options, params weave.GenesisPa
 	}
 	return nil
 }
+
+// ChainExporters lets you export the state of many extensions with one
+// function
+func ChainExporters(exps ...weave.Exporter) weave.Exporter {
+	return chainExporter{exps}
+}
+
+type chainExporter struct {
+	exps []weave.Exporter
+}
+
+// ToGenesis will call all Exporters in the list and merge their options,
+// aborting at the first error.
+//
+// Options exported under the same key are merged only if they are all JSON
+// objects with distinct attributes, as it is the case for the "conf" key
+// used by gconf.
+func (c chainExporter) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	res := make(weave.Options)
+	for _, e := range c.exps {
+		opts, err := e.ToGenesis(kv)
+		if err != nil {
+			return nil, err
+		}
+		for key, raw := range opts {
+			if res[key] == nil {
+				res[key] = raw
+				continue
+			}
+			merged, err := mergeObjects(res[key], raw)
+			if err != nil {
+				return nil, errors.Wrapf(err, "key %q", key)
+			}
+			res[key] = merged
+		}
+	}
+	return res, nil
+}
+
+// RequireExported returns an exporter that fails if any key that does not
+// start with one of the given prefixes is stored. Prefixes must cover all the
+// state written by the chained exporters, so that the state of an extension
+// that is not exported is never silently dropped.
+func RequireExported(prefixes ...string) weave.Exporter {
+	return requireExported{prefixes: prefixes}
+}
+
+type requireExported struct {
+	prefixes []string
+}
+
+// ToGenesis returns no options if all stored keys are covered by the
+// prefixes.
+func (r requireExported) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	it, err := kv.Iterator(nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+
+	for {
+		key, _, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			return make(weave.Options), nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "iterator")
+		}
+		if !r.covers(key) {
+			return nil, errors.Wrapf(errors.ErrState, "state cannot be exported: %q is stored", key)
+		}
+	}
+}
+
+func (r requireExported) covers(key []byte) bool {
+	for _, p := range r.prefixes {
+		if bytes.HasPrefix(key, []byte(p)) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeObjects returns a JSON object with attributes of both given JSON
+// objects.
+func mergeObjects(a, b json.RawMessage) (json.RawMessage, error) {
+	var objA, objB map[string]json.RawMessage
+	if err := json.Unmarshal(a, &objA); err != nil {
+		return nil, errors.Wrap(errors.ErrDuplicate, "not an object")
+	}
+	if err := json.Unmarshal(b, &objB); err != nil {
+		return nil, errors.Wrap(errors.ErrDuplicate, "not an object")
+	}
+	for name, raw := range objB {
+		if _, ok := objA[name]; ok {
+			return nil, errors.Wrapf(errors.ErrDuplicate, "attribute %q", name)
+		}
+		objA[name] = raw
+	}
+	raw, err := json.Marshal(objA)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrState, err.Error())
+	}
+	return raw, nil
+}
@@ -0,0 +1,180 @@
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto/bech32"
+	"github.com/iov-one/weave/errors"
+)
+
+// DefaultBech32Prefix is the human readable part of bech32 addresses used by
+// the JSON query format, unless configured otherwise.
+const DefaultBech32Prefix = "iov"
+
+// CanonicalJSON returns a JSON representation of given value that can be read
+// by clients that do not understand protobuf. Object keys are sorted and no
+// whitespace is used, so the same value is always serialized the same way.
+//
+// Addresses are bech32 encoded using given prefix, coins use the human
+// readable format (ie. "1.5 IOV"), time is encoded using RFC 3339 in UTC and
+// binary data is hex encoded. Field names are the same as in protobuf
+// declarations.
+func CanonicalJSON(v interface{}, bech32Prefix string) ([]byte, error) {
+	val, err := canonicalValue(reflect.ValueOf(v), bech32Prefix)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(val)
+}
+
+var (
+	addressType   = reflect.TypeOf(weave.Address(nil))
+	unixTimeType  = reflect.TypeOf(weave.UnixTime(0))
+	coinType      = reflect.TypeOf(coin.Coin{})
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	stringerType  = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// canonicalValue converts given value into a structure that the standard
+// JSON encoder serializes into the canonical form.
+func canonicalValue(v reflect.Value, bech32Prefix string) (interface{}, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+
+	switch v.Type() {
+	case addressType:
+		if v.Len() == 0 {
+			return "", nil
+		}
+		bech, err := bech32.Encode(bech32Prefix, v.Bytes())
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrInput, "cannot encode bech32 address")
+		}
+		return string(bech), nil
+	case unixTimeType:
+		return v.Interface().(weave.UnixTime).Time().UTC().Format(time.RFC3339), nil
+	case coinType:
+		return v.Interface().(coin.Coin).String(), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return canonicalValue(v.Elem(), bech32Prefix)
+	}
+
+	if v.Type().Implements(marshalerType) {
+		raw, err := v.Interface().(json.Marshaler).MarshalJSON()
+		if err != nil {
+			return nil, errors.Wrapf(errors.ErrInput, "cannot serialize %s: %s", v.Type(), err)
+		}
+		return json.RawMessage(raw), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return canonicalStruct(v, bech32Prefix)
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if v.Kind() == reflect.Slice {
+				return hex.EncodeToString(v.Bytes()), nil
+			}
+			raw := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(raw), v)
+			return hex.EncodeToString(raw), nil
+		}
+		res := make([]interface{}, v.Len())
+		for i := range res {
+			val, err := canonicalValue(v.Index(i), bech32Prefix)
+			if err != nil {
+				return nil, errors.Wrapf(err, "index %d", i)
+			}
+			res[i] = val
+		}
+		return res, nil
+	case reflect.Map:
+		res := make(map[string]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			val, err := canonicalValue(v.MapIndex(k), bech32Prefix)
+			if err != nil {
+				return nil, errors.Wrapf(err, "key %v", k)
+			}
+			res[fmt.Sprint(k.Interface())] = val
+		}
+		return res, nil
+	case reflect.Int32:
+		// Protobuf enumerations are represented by their names.
+		if v.Type().Implements(stringerType) {
+			return v.Interface().(fmt.Stringer).String(), nil
+		}
+	}
+	return v.Interface(), nil
+}
+
+func canonicalStruct(v reflect.Value, bech32Prefix string) (interface{}, error) {
+	res := make(map[string]interface{}, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		// Skip unexported and protobuf internal fields.
+		if f.PkgPath != "" || strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		name := f.Name
+		omitEmpty := false
+		if tag := f.Tag.Get("json"); tag != "" {
+			chunks := strings.Split(tag, ",")
+			if chunks[0] == "-" {
+				continue
+			}
+			if chunks[0] != "" {
+				name = chunks[0]
+			}
+			for _, opt := range chunks[1:] {
+				omitEmpty = omitEmpty || opt == "omitempty"
+			}
+		} else if oneof := f.Tag.Get("protobuf_oneof"); oneof != "" {
+			name = oneof
+			omitEmpty = true
+		}
+		fv := v.Field(i)
+		if omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		val, err := canonicalValue(fv, bech32Prefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %s", name)
+		}
+		res[name] = val
+	}
+	return res, nil
+}
+
+// isEmptyValue returns true if given value is considered empty by the
+// omitempty option of the standard JSON encoder.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	type item struct {
+		Name string `json:"name"`
+	}
+	type model struct {
+		Metadata *weave.Metadata `json:"metadata,omitempty"`
+		Owner    weave.Address   `json:"owner,omitempty"`
+		Amount   []*coin.Coin    `json:"amount,omitempty"`
+		Expires  weave.UnixTime  `json:"expires,omitempty"`
+		Period   weave.UnixDuration
+		Data     []byte `json:"data,omitempty"`
+		Items    []item `json:"items"`
+		Memo     string `json:"memo,omitempty"`
+		Ignored  string `json:"-"`
+		internal string
+	}
+
+	addr := weave.Address{
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
+		10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+	}
+	m := model{
+		Metadata: &weave.Metadata{Schema: 1},
+		Owner:    addr,
+		Amount:   []*coin.Coin{coin.NewCoinp(1, 500000000, "IOV")},
+		Expires:  weave.AsUnixTime(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Period:   weave.AsUnixDuration(time.Hour),
+		Data:     []byte{0xca, 0xfe},
+		Items:    []item{{Name: "a"}, {Name: "b"}},
+		Ignored:  "ignored",
+	}
+
+	raw, err := CanonicalJSON(&m, "tiov")
+	assert.Nil(t, err)
+	want := `{` +
+		`"Period":3600,` +
+		`"amount":["1.5 IOV"],` +
+		`"data":"cafe",` +
+		`"expires":"2020-01-02T03:04:05Z",` +
+		`"items":[{"name":"a"},{"name":"b"}],` +
+		`"metadata":{"schema":1},` +
+		`"owner":"tiov1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnjnkkef"` +
+		`}`
+	assert.Equal(t, want, string(raw))
+
+	// Empty values are omitted if requested.
+	raw, err = CanonicalJSON(&model{}, "tiov")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Period":0,"items":[]}`, string(raw))
+}
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// provingStore wraps a store and records all reads, so that a proof for
+// everything that was read can be created.
+type provingStore struct {
+	weave.ReadOnlyKVStore
+	reads []*provenRead
+}
+
+var _ weave.ReadOnlyKVStore = (*provingStore)(nil)
+
+// provenRead describes a read of the first count entries of the [start, end)
+// range. If complete is true, all entries of that range were read.
+type provenRead struct {
+	start    []byte
+	end      []byte
+	count    int
+	complete bool
+}
+
+func newProvingStore(db weave.ReadOnlyKVStore) *provingStore {
+	return &provingStore{ReadOnlyKVStore: db}
+}
+
+// Get records a read of a single key range.
+func (p *provingStore) Get(key []byte) ([]byte, error) {
+	value, err := p.ReadOnlyKVStore.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	p.recordKey(key, value != nil)
+	return value, nil
+}
+
+// Has records a read of a single key range.
+func (p *provingStore) Has(key []byte) (bool, error) {
+	ok, err := p.ReadOnlyKVStore.Has(key)
+	if err != nil {
+		return false, err
+	}
+	p.recordKey(key, ok)
+	return ok, nil
+}
+
+func (p *provingStore) recordKey(key []byte, exists bool) {
+	r := &provenRead{
+		start:    key,
+		end:      append(append([]byte{}, key...), 0),
+		complete: true,
+	}
+	if exists {
+		r.count = 1
+	}
+	p.reads = append(p.reads, r)
+}
+
+// Iterator records a read of all entries returned by the iterator.
+func (p *provingStore) Iterator(start, end []byte) (weave.Iterator, error) {
+	it, err := p.ReadOnlyKVStore.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	r := &provenRead{start: start, end: end}
+	p.reads = append(p.reads, r)
+	return &provingIterator{Iterator: it, read: r}, nil
+}
+
+// ReverseIterator records a read of all entries returned by the iterator.
+func (p *provingStore) ReverseIterator(start, end []byte) (weave.Iterator, error) {
+	it, err := p.ReadOnlyKVStore.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	r := &provenRead{start: start, end: end}
+	p.reads = append(p.reads, r)
+	return &provingIterator{Iterator: it, read: r, reverse: true, start: start}, nil
+}
+
+// Proof returns a proof of all recorded reads.
+func (p *provingStore) Proof(db weave.ProvableKVStore, version int64) (*merkle.Proof, error) {
+	var proof merkle.Proof
+	for _, r := range p.reads {
+		// Iterator was created but never used.
+		if r.count == 0 && !r.complete {
+			continue
+		}
+		op, err := db.ProveRange(version, r.start, r.end, r.count, r.complete)
+		if err != nil {
+			return nil, errors.Wrap(err, "prove range")
+		}
+		proof.Ops = append(proof.Ops, op)
+	}
+	return &proof, nil
+}
+
+// provingIterator counts all returned entries and updates the read range
+// accordingly.
+type provingIterator struct {
+	weave.Iterator
+	read    *provenRead
+	reverse bool
+	// start is the beginning of the reverse iterator range.
+	start []byte
+}
+
+func (i *provingIterator) Next() ([]byte, []byte, error) {
+	key, value, err := i.Iterator.Next()
+	switch {
+	case err == nil:
+		i.read.count++
+		// Reverse iteration always reads the whole range between the
+		// last returned key and the end.
+		if i.reverse {
+			i.read.start = append([]byte{}, key...)
+			i.read.complete = true
+		}
+	case errors.ErrIteratorDone.Is(err):
+		if i.reverse {
+			i.read.start = i.start
+		}
+		i.read.complete = true
+	}
+	return key, value, err
+}
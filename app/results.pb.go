@@ -24,6 +24,9 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // ResultSet contains a list of keys or values
 type ResultSet struct {
 	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Cursor is set only in the result set of keys, when the query result is
+	// paginated and there are more results. Use it to request the next page.
+	Cursor []byte `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (m *ResultSet) Reset()         { *m = ResultSet{} }
@@ -66,6 +69,13 @@ func (m *ResultSet) GetResults() [][]byte {
 	return nil
 }
 
+func (m *ResultSet) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ResultSet)(nil), "app.ResultSet")
 }
@@ -73,14 +83,15 @@ func init() {
 func init() { proto.RegisterFile("app/results.proto", fileDescriptor_9ef4977b2ac0c9d2) }
 
 var fileDescriptor_9ef4977b2ac0c9d2 = []byte{
-	// 105 bytes of a gzipped FileDescriptorProto
+	// 121 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0x2c, 0x28, 0xd0,
 	0x2f, 0x4a, 0x2d, 0x2e, 0xcd, 0x29, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e,
-	0x2c, 0x28, 0x50, 0x52, 0xe5, 0xe2, 0x0c, 0x02, 0x8b, 0x06, 0xa7, 0x96, 0x08, 0x49, 0x70, 0xb1,
-	0x43, 0x95, 0x48, 0x30, 0x2a, 0x30, 0x6b, 0xf0, 0x04, 0xc1, 0xb8, 0x4e, 0x12, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x90, 0xc4, 0x06, 0x36, 0xcc, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x13, 0x46, 0x35, 0xf1, 0x61, 0x00, 0x00, 0x00,
+	0x2c, 0x28, 0x50, 0xb2, 0xe5, 0xe2, 0x0c, 0x02, 0x8b, 0x06, 0xa7, 0x96, 0x08, 0x49, 0x70, 0xb1,
+	0x43, 0x95, 0x48, 0x30, 0x2a, 0x30, 0x6b, 0xf0, 0x04, 0xc1, 0xb8, 0x42, 0x62, 0x5c, 0x6c, 0xc9,
+	0xa5, 0x45, 0xc5, 0xf9, 0x45, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x3c, 0x41, 0x50, 0x9e, 0x93, 0xc4,
+	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
+	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81, 0x2d, 0x31, 0x06, 0x0c,
+	0x00, 0xe1, 0x98, 0x26, 0x15, 0x79, 0x00, 0x00, 0x00,
 }
 
 func (m *ResultSet) Marshal() (dAtA []byte, err error) {
@@ -106,6 +117,12 @@ func (m *ResultSet) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], b)
 		}
 	}
+	if len(m.Cursor) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(len(m.Cursor)))
+		i += copy(dAtA[i:], m.Cursor)
+	}
 	return i, nil
 }
 
@@ -130,6 +147,10 @@ func (m *ResultSet) Size() (n int) {
 			n += 1 + l + sovResults(uint64(l))
 		}
 	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovResults(uint64(l))
+	}
 	return n
 }
 
@@ -207,6 +228,40 @@ func (m *ResultSet) Unmarshal(dAtA []byte) error {
 			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
 			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResults(dAtA[iNdEx:])
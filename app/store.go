@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -38,6 +39,9 @@ type StoreApp struct {
 	// How to handle queries
 	queryRouter weave.QueryRouter
 
+	// bech32Prefix is used to encode addresses of JSON query results
+	bech32Prefix string
+
 	// chainID is loaded from db in initialization
 	// saved once in parseGenesis
 	chainID string
@@ -63,9 +67,10 @@ func NewStoreApp(name string, store weave.CommitKVStore,
 	s := &StoreApp{
 		name: name,
 		// note: panics if trouble initializing from store
-		store:       NewCommitStore(store),
-		queryRouter: queryRouter,
-		baseContext: baseContext,
+		store:        NewCommitStore(store),
+		queryRouter:  queryRouter,
+		bech32Prefix: DefaultBech32Prefix,
+		baseContext:  baseContext,
 	}
 	s = s.WithLogger(log.NewNopLogger())
 
@@ -144,6 +149,14 @@ func (s *StoreApp) WithLogger(logger log.Logger) *StoreApp {
 	return s
 }
 
+// WithBech32Prefix sets the human readable part of bech32 addresses returned
+// by JSON encoded queries and returns the StoreApp, to make it easy to chain
+// in initialization.
+func (s *StoreApp) WithBech32Prefix(prefix string) *StoreApp {
+	s.bech32Prefix = prefix
+	return s
+}
+
 // Logger returns the application base logger
 func (s *StoreApp) Logger() log.Logger {
 	return s.logger
@@ -199,22 +212,33 @@ Query gets data from the app store.
 A query request has the following elements:
 * Path - the type of query
 * Data - what to query, interpreted based on Path
-* Height - the block height to query (if 0 most recent)
+* Height - the block height to query (if 0 most recent). Only recent
+  heights are available, older state is pruned and querying it fails
 * Prove - if true, also return a proof
 
 Path may be "/", "/<bucket>", or "/<bucket>/<index>"
 It may be followed by "?prefix" to make a prefix query.
 Soon we will support "?range" for powerful range queries
 
+If Prove is set, Proof contains a proof for every key range that was
+read in order to build the result. See store/iavl.RangeOp for details.
+
 Key and Value in Results are always serialized ResultSet
 objects, able to support 0 to N values. They must be the
 same size. This makes things a little more difficult for
 simple queries, but provides a consistent interface.
+
+If the query path declares "format=json" parameter, for example
+"/wallets?prefix&format=json", Key is empty and Value is a JSON object
+with the list of models and the cursor of the next page. Each model value is
+decoded using the type registered for its bucket and serialized using
+CanonicalJSON. The result of a typed query is decoded using the response type
+declared by the query handler.
 */
 func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuery) {
 
 	// find the handler
-	path, mod := splitPath(reqQuery.Path)
+	path, rawMod := splitPath(reqQuery.Path)
 	qh := s.queryRouter.Handler(path)
 	if qh == nil {
 		code, _ := errors.ABCIInfo(errors.ErrNotFound, false)
@@ -222,33 +246,74 @@ func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuer
 		resQuery.Log = fmt.Sprintf("Unexpected Query path: %v", reqQuery.Path)
 		return
 	}
+	mod, page, err := weave.ParseQueryMod(rawMod)
+	if err != nil {
+		return queryError(err)
+	}
+	format, err := weave.ParseQueryFormat(rawMod)
+	if err != nil {
+		return queryError(err)
+	}
 
-	// TODO: support historical queries by getting old read-only
-	// height := reqQuery.Height
-	// if height == 0 {
-	// 	withProof := s.CommittedHeight() - 1
-	// 	if tree.Tree.VersionExists(uint64(withProof)) {
-	// 		height = withProof
-	// 	} else {
-	// 		height = s.CommittedHeight()
-	// 	}
-	// }
-	info, err := s.store.CommitInfo()
+	db, height, err := s.queryStore(reqQuery.Height)
 	if err != nil {
 		return queryError(err)
 	}
-	resQuery.Height = info.Version
-	// TODO: better version handling!
-	db := s.store.committed.CacheWrap()
+	resQuery.Height = height
+
+	var proving *provingStore
+	if reqQuery.Prove {
+		proving = newProvingStore(db)
+		db = proving
+	}
 
 	// make the query
-	models, err := qh.Query(db, mod, reqQuery.Data)
+	var (
+		models []weave.Model
+		cursor []byte
+	)
+	if ph, ok := qh.(weave.PagedQueryHandler); ok {
+		models, cursor, err = ph.QueryPage(db, mod, reqQuery.Data, page)
+	} else if !page.IsZero() {
+		err = errors.Wrapf(errors.ErrInput, "%s query does not support pagination", path)
+	} else {
+		models, err = qh.Query(db, mod, reqQuery.Data)
+	}
 	if err != nil {
 		return queryError(err)
 	}
 
+	if proving != nil {
+		provable, ok := s.store.committed.(weave.ProvableKVStore)
+		if !ok {
+			return queryError(errors.Wrap(errors.ErrHuman, "store does not support proofs"))
+		}
+		resQuery.Proof, err = proving.Proof(provable, height)
+		if err != nil {
+			return queryError(err)
+		}
+	}
+
+	if format == weave.JSONQueryFormat {
+		newModel := s.queryRouter.NewModel
+		if th, ok := qh.(interface{ NewResponse() weave.Persistent }); ok {
+			// Typed query result has no key. Its type is
+			// declared by the handler.
+			newModel = func([]byte) (weave.Persistent, bool) {
+				return th.NewResponse(), true
+			}
+		}
+		resQuery.Value, err = s.jsonResult(models, cursor, newModel)
+		if err != nil {
+			return queryError(err)
+		}
+		return resQuery
+	}
+
 	// set the info as ResultSets....
-	resQuery.Key, err = ResultsFromKeys(models).Marshal()
+	keys := ResultsFromKeys(models)
+	keys.Cursor = cursor
+	resQuery.Key, err = keys.Marshal()
 	if err != nil {
 		return queryError(err)
 	}
@@ -257,19 +322,71 @@ func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuer
 		return queryError(err)
 	}
 
-	// TODO: support proofs given this info....
-	// if reqQuery.Prove {
-	//  value, proof, err := tree.GetVersionedWithProof(key, height)
-	//  if err != nil {
-	//      resQuery.Log = err.Error()
-	//      break
-	//  }
-	//  resQuery.Value = value
-	//  resQuery.Proof = proof.Bytes()
-
 	return resQuery
 }
 
+// jsonResult returns query result encoded as a JSON object. Each model
+// value is decoded into an instance returned by newModel for its key and
+// serialized using CanonicalJSON.
+func (s *StoreApp) jsonResult(models []weave.Model, cursor []byte, newModel func(key []byte) (weave.Persistent, bool)) ([]byte, error) {
+	type jsonModel struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+	}
+	res := struct {
+		Models []jsonModel `json:"models"`
+		Cursor string      `json:"cursor,omitempty"`
+	}{
+		Models: make([]jsonModel, len(models)),
+		Cursor: hex.EncodeToString(cursor),
+	}
+	for i, m := range models {
+		res.Models[i].Key = hex.EncodeToString(m.Key)
+		if len(m.Value) == 0 {
+			res.Models[i].Value = json.RawMessage("null")
+			continue
+		}
+		obj, ok := newModel(m.Key)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrInput, "no model type registered for %x key", m.Key)
+		}
+		if err := obj.Unmarshal(m.Value); err != nil {
+			return nil, errors.Wrapf(errors.ErrState, "cannot unmarshal %x model: %s", m.Key, err)
+		}
+		raw, err := CanonicalJSON(obj, s.bech32Prefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot serialize %x model", m.Key)
+		}
+		res.Models[i].Value = raw
+	}
+	return json.Marshal(res)
+}
+
+// queryStore returns a read only store with the state of the given height. A
+// zero height means the most recent committed state. Returned is the store
+// and the height it represents.
+func (s *StoreApp) queryStore(height int64) (weave.ReadOnlyKVStore, int64, error) {
+	info, err := s.store.CommitInfo()
+	if err != nil {
+		return nil, 0, err
+	}
+	if height == 0 || height == info.Version {
+		return s.store.committed.CacheWrap(), info.Version, nil
+	}
+	if height < 0 {
+		return nil, 0, errors.Wrapf(errors.ErrInput, "invalid height %d", height)
+	}
+	historical, ok := s.store.committed.(weave.HistoricalKVStore)
+	if !ok {
+		return nil, 0, errors.Wrap(errors.ErrHuman, "store does not support historical queries")
+	}
+	db, err := historical.AtVersion(height)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "height %d", height)
+	}
+	return db, height, nil
+}
+
 // splitPath splits out the real path along with the query
 // modifier (everything after the ?)
 func splitPath(path string) (string, string) {
@@ -328,6 +445,7 @@ func (s *StoreApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBegi
 	ctx := weave.WithHeader(s.baseContext, req.Header)
 	ctx = weave.WithHeight(ctx, req.Header.GetHeight())
 	ctx = weave.WithCommitInfo(ctx, req.LastCommitInfo)
+	ctx = weave.WithEvidence(ctx, req.ByzantineValidators)
 
 	now := req.Header.GetTime()
 	if now.IsZero() {
@@ -2,9 +2,12 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store/iavl"
 	"github.com/iov-one/weave/weavetest/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -55,3 +58,200 @@ func TestAddValChange(t *testing.T) {
 		assert.Equal(t, diff, weave.ValidatorUpdatesFromABCI(res.ValidatorUpdates).ValidatorUpdates)
 	})
 }
+
+func TestHistoricalQuery(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	qr.Register("/", rawQueryHandler{})
+	commit := iavl.MockCommitStore()
+	app := NewStoreApp("dummy", commit, qr, context.Background())
+
+	// Each version stores its number under the same key.
+	const versions = iavl.DefaultHistory + 5
+	for i := 1; i <= int(versions); i++ {
+		assert.Nil(t, app.DeliverStore().Set([]byte("version"), []byte(fmt.Sprint(i))))
+		app.Commit()
+	}
+
+	cases := map[string]struct {
+		height     int64
+		wantCode   uint32
+		wantHeight int64
+		wantValue  string
+	}{
+		"latest": {
+			height:     0,
+			wantHeight: versions,
+			wantValue:  fmt.Sprint(versions),
+		},
+		"latest by height": {
+			height:     versions,
+			wantHeight: versions,
+			wantValue:  fmt.Sprint(versions),
+		},
+		"recent": {
+			height:     versions - 3,
+			wantHeight: versions - 3,
+			wantValue:  fmt.Sprint(versions - 3),
+		},
+		"pruned": {
+			height:   2,
+			wantCode: errors.ErrNotFound.ABCICode(),
+		},
+		"future": {
+			height:   versions + 1,
+			wantCode: errors.ErrInput.ABCICode(),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			res := app.Query(abci.RequestQuery{Path: "/", Data: []byte("version"), Height: tc.height})
+			assert.Equal(t, tc.wantCode, res.Code)
+			if tc.wantCode != 0 {
+				return
+			}
+			assert.Equal(t, tc.wantHeight, res.Height)
+			models, err := toModels(res.Key, res.Value)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(models))
+			assert.Equal(t, tc.wantValue, string(models[0].Value))
+		})
+	}
+}
+
+func TestPaginatedQuery(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	qr.Register("/raw", rawQueryHandler{})
+	qr.Register("/letters", lettersQueryHandler("abcde"))
+	app := NewStoreApp("dummy", iavl.MockCommitStore(), qr, context.Background())
+
+	var (
+		got    string
+		cursor []byte
+	)
+	for {
+		path := weave.QueryPath("/letters", weave.PrefixQueryMod, weave.QueryPage{Cursor: cursor, Limit: 2})
+		res := app.Query(abci.RequestQuery{Path: path})
+		assert.Equal(t, uint32(0), res.Code)
+		models, err := toModels(res.Key, res.Value)
+		assert.Nil(t, err)
+		for _, m := range models {
+			got += string(m.Key)
+		}
+
+		var keys ResultSet
+		assert.Nil(t, keys.Unmarshal(res.Key))
+		if len(keys.Cursor) == 0 {
+			break
+		}
+		cursor = keys.Cursor
+	}
+	assert.Equal(t, "abcde", got)
+
+	// Handlers that do not support pagination reject paginated queries.
+	res := app.Query(abci.RequestQuery{Path: "/raw?limit=2"})
+	assert.Equal(t, errors.ErrInput.ABCICode(), res.Code)
+
+	res = app.Query(abci.RequestQuery{Path: "/letters?prefix&limit=x"})
+	assert.Equal(t, errors.ErrInput.ABCICode(), res.Code)
+}
+
+// lettersQueryHandler returns each letter as a separate model. Cursor is the
+// letter index.
+type lettersQueryHandler string
+
+func (h lettersQueryHandler) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, _, err := h.QueryPage(db, mod, data, weave.QueryPage{})
+	return models, err
+}
+
+func (h lettersQueryHandler) QueryPage(db weave.ReadOnlyKVStore, mod string, data []byte, page weave.QueryPage) ([]weave.Model, []byte, error) {
+	var start int
+	if len(page.Cursor) != 0 {
+		start = int(page.Cursor[0])
+	}
+	end := len(h)
+	if page.Limit != 0 && start+page.Limit < end {
+		end = start + page.Limit
+	}
+	var models []weave.Model
+	for i := start; i < end; i++ {
+		models = append(models, weave.Pair([]byte{h[i]}, nil))
+	}
+	if end == len(h) {
+		return models, nil, nil
+	}
+	return models, []byte{byte(end)}, nil
+}
+
+// rawQueryHandler returns the value stored under the key given as the query
+// data.
+type rawQueryHandler struct{}
+
+func (rawQueryHandler) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	value, err := db.Get(data)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return []weave.Model{weave.Pair(data, value)}, nil
+}
+
+func TestJSONQuery(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	b := orm.NewModelBucket("cnts", &orm.Counter{})
+	b.Register("counters", qr)
+	qr.Register("/raw", rawQueryHandler{})
+	qr.RegisterTyped("/double", doubleQuery{})
+	app := NewStoreApp("dummy", iavl.MockCommitStore(), qr, context.Background())
+
+	for i := int64(1); i <= 3; i++ {
+		_, err := b.Put(app.DeliverStore(), nil, &orm.Counter{Count: i})
+		assert.Nil(t, err)
+	}
+	app.Commit()
+
+	res := app.Query(abci.RequestQuery{Path: "/counters?prefix&limit=2&format=json"})
+	assert.Equal(t, uint32(0), res.Code)
+	assert.Equal(t, 0, len(res.Key))
+	want := `{"models":[` +
+		`{"key":"636e74733a0000000000000001","value":{"count":1}},` +
+		`{"key":"636e74733a0000000000000002","value":{"count":2}}` +
+		`],"cursor":"636e74733a0000000000000003"}`
+	assert.Equal(t, want, string(res.Value))
+
+	// Models of a bucket without a registered type cannot be decoded.
+	res = app.Query(abci.RequestQuery{Path: "/raw?format=json", Data: []byte("cnts:x")})
+	assert.Equal(t, uint32(0), res.Code)
+	assert.Equal(t, `{"models":[]}`, string(res.Value))
+	assert.Nil(t, app.DeliverStore().Set([]byte("other:1"), []byte("value")))
+	app.Commit()
+	res = app.Query(abci.RequestQuery{Path: "/raw?format=json", Data: []byte("other:1")})
+	assert.Equal(t, errors.ErrInput.ABCICode(), res.Code)
+
+	// Typed query result has no key and is decoded using the response
+	// type declared by the handler.
+	req, err := (&orm.Counter{Count: 21}).Marshal()
+	assert.Nil(t, err)
+	res = app.Query(abci.RequestQuery{Path: "/double?format=json", Data: req})
+	assert.Equal(t, uint32(0), res.Code)
+	assert.Equal(t, `{"models":[{"key":"","value":{"count":42}}]}`, string(res.Value))
+
+	res = app.Query(abci.RequestQuery{Path: "/counters?prefix&format=xml"})
+	assert.Equal(t, errors.ErrInput.ABCICode(), res.Code)
+}
+
+// doubleQuery is a typed query that returns a counter with the doubled count
+// of the requested one.
+type doubleQuery struct{}
+
+func (doubleQuery) NewRequest() weave.Persistent {
+	return &orm.Counter{}
+}
+
+func (doubleQuery) NewResponse() weave.Persistent {
+	return &orm.Counter{}
+}
+
+func (doubleQuery) Query(db weave.ReadOnlyKVStore, req weave.Persistent) (weave.Persistent, error) {
+	return &orm.Counter{Count: 2 * req.(*orm.Counter).Count}, nil
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store/iavl"
+)
+
+// ProvenQuery executes given query requesting a proof and verifies the
+// result against the application hash of the block header that follows the
+// queried state. This allows to trust the result without trusting the node.
+// Query router must be the one used by the application, so that the
+// requested data can be located in the state (see VerifyQuery).
+//
+// Because the application hash of a state is known only after the next block
+// is created, this function may block until that happens.
+func (c *Client) ProvenQuery(ctx context.Context, qr weave.QueryRouter, query RequestQuery) ([]weave.Model, error) {
+	query.Prove = true
+	res := c.Query(query)
+	if res.Code != 0 {
+		return nil, errors.ABCIError(res.Code, res.Log)
+	}
+
+	header, err := c.Header(ctx, res.Height+1)
+	if err != nil {
+		header, err = c.WaitForHeight(ctx, res.Height+1)
+		if err != nil {
+			return nil, errors.Wrap(err, "next header")
+		}
+	}
+	return VerifyQuery(qr, query, res, header.AppHash)
+}
+
+// rangeQuerier is implemented by query handlers that answer key, prefix and
+// range queries by reading a single database key range, for example orm
+// buckets.
+type rangeQuerier interface {
+	QueryRange(mod string, data []byte, page weave.QueryPage) (start, end []byte, limit int, err error)
+}
+
+// VerifyQuery checks the response to given query against given application
+// hash. On success, query result is returned.
+//
+// Query router is used to find the database key range that the query must
+// read. The proof must cover exactly that range, so that a proof of any other
+// data is rejected. All entries stored within that range must be part of the
+// result, so that a node cannot omit entries or claim absence of an existing
+// key. A result that is limited to a single page must end with a cursor that
+// is the key of the first entry not returned.
+//
+// Only key, prefix and range queries of handlers that read a single key range
+// (for example, a bucket but not an index) can be verified.
+func VerifyQuery(qr weave.QueryRouter, query RequestQuery, res ResponseQuery, appHash []byte) ([]weave.Model, error) {
+	path, rawMod := query.Path, ""
+	if chunks := strings.SplitN(query.Path, "?", 2); len(chunks) == 2 {
+		path, rawMod = chunks[0], chunks[1]
+	}
+	rq, ok := qr.Handler(path).(rangeQuerier)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrInput, "%s query cannot be verified", path)
+	}
+	mod, page, err := weave.ParseQueryMod(rawMod)
+	if err != nil {
+		return nil, err
+	}
+	if format, err := weave.ParseQueryFormat(rawMod); err != nil {
+		return nil, err
+	} else if format == weave.JSONQueryFormat {
+		return nil, errors.Wrap(errors.ErrInput, "JSON query result cannot be verified")
+	}
+	start, end, limit, err := rq.QueryRange(mod, query.Data, page)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Proof == nil || len(res.Proof.Ops) == 0 {
+		return nil, errors.Wrap(errors.ErrInput, "no proof")
+	}
+	if len(res.Proof.Ops) != 1 {
+		return nil, errors.Wrapf(errors.ErrInput, "expected a single proof, got %d", len(res.Proof.Ops))
+	}
+	op, err := iavl.NewProofRuntime().Decode(res.Proof.Ops[0])
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	root, err := op.Run(nil)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(root[0], appHash) {
+		return nil, errors.Wrap(errors.ErrInput, "app hash mismatch")
+	}
+	rop, ok := op.(*iavl.RangeOp)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrInput, "unexpected proof %T", op)
+	}
+	if !bytes.Equal(rop.Start, start) || !bytes.Equal(rop.End, end) {
+		return nil, errors.Wrap(errors.ErrInput, "proof does not cover the requested range")
+	}
+
+	var keys, values app.ResultSet
+	if err := keys.Unmarshal(res.Key); err != nil {
+		return nil, errors.Wrapf(errors.ErrState, "cannot unmarshal keys: %s", err)
+	}
+	if err := values.Unmarshal(res.Value); err != nil {
+		return nil, errors.Wrapf(errors.ErrState, "cannot unmarshal values: %s", err)
+	}
+	models, err := app.JoinResults(&keys, &values)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrState, err.Error())
+	}
+
+	proven := rop.Models()
+	if len(keys.Cursor) == 0 {
+		if !rop.Complete {
+			return nil, errors.Wrap(errors.ErrInput, "incomplete proof of a result without a cursor")
+		}
+	} else {
+		if limit == 0 || len(models) != limit || len(proven) != limit+1 {
+			return nil, errors.Wrap(errors.ErrInput, "proof does not match the result page")
+		}
+		if !bytes.Equal(proven[limit].Key, keys.Cursor) {
+			return nil, errors.Wrap(errors.ErrInput, "cursor is not the next proven key")
+		}
+		proven = proven[:limit]
+	}
+	if len(proven) != len(models) {
+		return nil, errors.Wrapf(errors.ErrInput, "%d entries proven, %d returned", len(proven), len(models))
+	}
+	for i, m := range models {
+		if !bytes.Equal(m.Key, proven[i].Key) {
+			return nil, errors.Wrapf(errors.ErrInput, "no proof for key %X", m.Key)
+		}
+		if !bytes.Equal(m.Value, proven[i].Value) {
+			return nil, errors.Wrapf(errors.ErrInput, "value mismatch for key %X", m.Key)
+		}
+	}
+	return models, nil
+}
@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestVerifyQuery(t *testing.T) {
+	counters := orm.NewBucket("cnts", &orm.Counter{}).
+		WithIndex("value", func(obj orm.Object) ([]byte, error) {
+			c := obj.Value().(*orm.Counter)
+			return []byte(fmt.Sprint(c.Count % 2)), nil
+		}, false)
+
+	qr := weave.NewQueryRouter()
+	counters.Register("counters", qr)
+	qr.RegisterAll(orm.RegisterQuery)
+
+	commit := iavl.MockCommitStore()
+	store := app.NewStoreApp("test", commit, qr, context.Background())
+	for _, key := range []string{"a", "aa", "ab", "b", "ba", "c"} {
+		obj := orm.NewSimpleObj([]byte(key), orm.NewCounter(int64(len(key))))
+		assert.Nil(t, counters.Save(store.DeliverStore(), obj))
+	}
+	appHash := store.Commit().Data
+
+	cases := map[string]struct {
+		path     string
+		data     []byte
+		wantKeys []string
+	}{
+		"existing key": {
+			path:     "/counters",
+			data:     []byte("aa"),
+			wantKeys: []string{"cnts:aa"},
+		},
+		"missing key": {
+			path: "/counters",
+			data: []byte("ac"),
+		},
+		"prefix": {
+			path:     "/counters?prefix",
+			data:     []byte("a"),
+			wantKeys: []string{"cnts:a", "cnts:aa", "cnts:ab"},
+		},
+		"range": {
+			path:     "/counters?range",
+			data:     []byte("6161:61ff"),
+			wantKeys: []string{"cnts:aa", "cnts:ab"},
+		},
+		"page": {
+			path:     "/counters?prefix&limit=2",
+			data:     []byte("a"),
+			wantKeys: []string{"cnts:a", "cnts:aa"},
+		},
+		"raw prefix": {
+			path:     "/?prefix",
+			data:     []byte("cnts:b"),
+			wantKeys: []string{"cnts:b", "cnts:ba"},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			query := RequestQuery{Path: tc.path, Data: tc.data, Prove: true}
+			res := store.Query(query)
+			assert.Equal(t, uint32(0), res.Code)
+
+			models, err := VerifyQuery(qr, query, res, appHash)
+			assert.Nil(t, err)
+			var keys []string
+			for _, m := range models {
+				keys = append(keys, string(m.Key))
+			}
+			assert.Equal(t, tc.wantKeys, keys)
+
+			if _, err := VerifyQuery(qr, query, res, []byte("invalid app hash")); !errors.ErrInput.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+
+	// Index query result is resolved from several key ranges and cannot be
+	// verified.
+	query := RequestQuery{Path: "/counters/value", Data: []byte("1"), Prove: true}
+	res := store.Query(query)
+	assert.Equal(t, uint32(0), res.Code)
+	if _, err := VerifyQuery(qr, query, res, appHash); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func TestVerifyQueryForgedResult(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	qr.RegisterAll(orm.RegisterQuery)
+	commit := iavl.MockCommitStore()
+	store := app.NewStoreApp("test", commit, qr, context.Background())
+	assert.Nil(t, store.DeliverStore().Set([]byte("a"), []byte("value")))
+	appHash := store.Commit().Data
+
+	query := RequestQuery{Path: "/", Data: []byte("a"), Prove: true}
+	res := store.Query(query)
+	assert.Equal(t, uint32(0), res.Code)
+
+	var err error
+	res.Value, err = app.ResultsFromValues([]weave.Model{{Key: []byte("a"), Value: []byte("forged")}}).Marshal()
+	assert.Nil(t, err)
+	if _, err := VerifyQuery(qr, query, res, appHash); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func TestVerifyQueryForgedAbsence(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	qr.RegisterAll(orm.RegisterQuery)
+	commit := iavl.MockCommitStore()
+	store := app.NewStoreApp("test", commit, qr, context.Background())
+	assert.Nil(t, store.DeliverStore().Set([]byte("a"), []byte("value")))
+	assert.Nil(t, store.DeliverStore().Set([]byte("ab"), []byte("value")))
+	appHash := store.Commit().Data
+
+	cases := map[string]struct {
+		path string
+		data []byte
+	}{
+		"existing key reported as missing": {
+			path: "/",
+			data: []byte("a"),
+		},
+		"entry omitted from prefix": {
+			path: "/?prefix",
+			data: []byte("a"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			query := RequestQuery{Path: tc.path, Data: tc.data, Prove: true}
+			res := store.Query(query)
+			assert.Equal(t, uint32(0), res.Code)
+
+			var keys, values app.ResultSet
+			assert.Nil(t, keys.Unmarshal(res.Key))
+			assert.Nil(t, values.Unmarshal(res.Value))
+			if len(keys.Results) == 0 {
+				t.Fatal("no result to forge")
+			}
+			keys.Results = keys.Results[1:]
+			values.Results = values.Results[1:]
+			var err error
+			res.Key, err = keys.Marshal()
+			assert.Nil(t, err)
+			res.Value, err = values.Marshal()
+			assert.Nil(t, err)
+
+			if _, err := VerifyQuery(qr, query, res, appHash); !errors.ErrInput.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+
+	query := RequestQuery{Path: "/", Data: []byte("a"), Prove: true}
+	res := store.Query(query)
+	assert.Equal(t, uint32(0), res.Code)
+	res.Key, res.Value = nil, nil
+	res.Proof.Ops = nil
+	if _, err := VerifyQuery(qr, query, res, appHash); !errors.ErrInput.Is(err) {
+		t.Fatalf("proof stripped: unexpected error: %+v", err)
+	}
+}
+
+func TestVerifyQueryUnrelatedProof(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	qr.RegisterAll(orm.RegisterQuery)
+	commit := iavl.MockCommitStore()
+	store := app.NewStoreApp("test", commit, qr, context.Background())
+	for _, key := range []string{"a", "ab", "b", "ba"} {
+		assert.Nil(t, store.DeliverStore().Set([]byte(key), []byte("value")))
+	}
+	appHash := store.Commit().Data
+
+	query := RequestQuery{Path: "/?prefix", Data: []byte("a"), Prove: true}
+
+	// A valid proof of a different range cannot be used to answer the
+	// query.
+	res := store.Query(RequestQuery{Path: "/?prefix", Data: []byte("b"), Prove: true})
+	assert.Equal(t, uint32(0), res.Code)
+	if _, err := VerifyQuery(qr, query, res, appHash); !errors.ErrInput.Is(err) {
+		t.Fatalf("other range: unexpected error: %+v", err)
+	}
+
+	// Incomplete proof cannot be used to omit entries.
+	res = store.Query(RequestQuery{Path: "/?prefix&limit=1", Data: []byte("a"), Prove: true})
+	assert.Equal(t, uint32(0), res.Code)
+	var keys, values app.ResultSet
+	assert.Nil(t, keys.Unmarshal(res.Key))
+	assert.Nil(t, values.Unmarshal(res.Value))
+	keys.Cursor = nil
+	var err error
+	res.Key, err = keys.Marshal()
+	assert.Nil(t, err)
+	if _, err := VerifyQuery(qr, query, res, appHash); !errors.ErrInput.Is(err) {
+		t.Fatalf("incomplete proof: unexpected error: %+v", err)
+	}
+}
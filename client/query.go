@@ -0,0 +1,37 @@
+package client
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+)
+
+// TypedQuery executes a query registered using weave.QueryRouter.RegisterTyped
+// under given path. Given request is serialized and the response is
+// deserialized into given destination.
+func (c *Client) TypedQuery(path string, req weave.Marshaller, dest weave.Persistent) error {
+	data, err := req.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal request")
+	}
+	return DecodeTypedQuery(c.Query(RequestQuery{Path: path, Data: data}), dest)
+}
+
+// DecodeTypedQuery deserializes the response of a typed query into given
+// destination.
+func DecodeTypedQuery(res ResponseQuery, dest weave.Persistent) error {
+	if res.Code != 0 {
+		return errors.ABCIError(res.Code, res.Log)
+	}
+	var values app.ResultSet
+	if err := values.Unmarshal(res.Value); err != nil {
+		return errors.Wrapf(errors.ErrState, "cannot unmarshal values: %s", err)
+	}
+	if n := len(values.Results); n != 1 {
+		return errors.Wrapf(errors.ErrState, "typed query must return one result, got %d", n)
+	}
+	if err := dest.Unmarshal(values.Results[0]); err != nil {
+		return errors.Wrapf(errors.ErrState, "cannot unmarshal %T response: %s", dest, err)
+	}
+	return nil
+}
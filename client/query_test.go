@@ -0,0 +1,37 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func TestDecodeTypedQuery(t *testing.T) {
+	want := &cash.BalanceResponse{Coins: []*coin.Coin{coin.NewCoinp(5, 0, "IOV")}}
+	raw, err := want.Marshal()
+	assert.Nil(t, err)
+
+	response := func(models ...weave.Model) ResponseQuery {
+		values, err := app.ResultsFromValues(models).Marshal()
+		assert.Nil(t, err)
+		return ResponseQuery{Value: values}
+	}
+
+	var got cash.BalanceResponse
+	assert.Nil(t, DecodeTypedQuery(response(weave.Model{Value: raw}), &got))
+	assert.Equal(t, want, &got)
+
+	if err := DecodeTypedQuery(response(), &got); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error for no result, got %v", err)
+	}
+
+	code, log := errors.ABCIInfo(errors.ErrNotFound, false)
+	if err := DecodeTypedQuery(ResponseQuery{Code: code, Log: log}, &got); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %v", err)
+	}
+}
@@ -11,11 +11,15 @@ import (
 	"github.com/iov-one/weave/cmd/bnsd/x/username"
 	"github.com/iov-one/weave/datamigration"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/paychan"
+	"github.com/iov-one/weave/x/slashing"
+	"github.com/iov-one/weave/x/staking"
 	"github.com/iov-one/weave/x/txfee"
 	"github.com/iov-one/weave/x/validators"
 )
@@ -261,6 +265,60 @@ original transactions (ie signatures) are being dropped.
 					TxfeeUpdateConfigurationMsg: msg,
 				},
 			})
+		case *paychan.CreateMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_PaychanCreateMsg{
+					PaychanCreateMsg: msg,
+				},
+			})
+		case *paychan.TransferMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_PaychanTransferMsg{
+					PaychanTransferMsg: msg,
+				},
+			})
+		case *paychan.CloseMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_PaychanCloseMsg{
+					PaychanCloseMsg: msg,
+				},
+			})
+		case *slashing.UnjailMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_SlashingUnjailMsg{
+					SlashingUnjailMsg: msg,
+				},
+			})
+		case *slashing.UpdateConfigurationMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg{
+					SlashingUpdateConfigurationMsg: msg,
+				},
+			})
+		case *staking.BondMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_StakingBondMsg{
+					StakingBondMsg: msg,
+				},
+			})
+		case *staking.UnbondMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_StakingUnbondMsg{
+					StakingUnbondMsg: msg,
+				},
+			})
+		case *staking.UpdateConfigurationMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg{
+					StakingUpdateConfigurationMsg: msg,
+				},
+			})
+		case *cron.CancelTaskMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CronCancelTaskMsg{
+					CronCancelTaskMsg: msg,
+				},
+			})
 
 		case nil:
 			return errors.New("transaction without a message")
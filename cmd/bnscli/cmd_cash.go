@@ -82,13 +82,19 @@ func cmdWithFee(input io.Reader, output io.Writer, args []string) error {
 		fmt.Fprintln(flag.CommandLine.Output(), `
 Modify given transaction and addatch a fee as specified to it. If a transaction
 already has a fee set, overwrite it with a new value.
+
+Optionally a gas limit and a gas price can be declared. The price of the whole
+gas limit is charged upfront and the price of the gas that was not consumed is
+refunded once the transaction is processed.
 		`)
 		fl.PrintDefaults()
 	}
 	var (
-		payerFl  = flHex(fl, "payer", "", "Optional address of a payer. If not provided the main signer will be used.")
-		amountFl = flCoin(fl, "amount", "", "Fee value that should be attached to the transaction. If not provided, default minimal fee is used.")
-		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+		payerFl    = flHex(fl, "payer", "", "Optional address of a payer. If not provided the main signer will be used.")
+		amountFl   = flCoin(fl, "amount", "", "Fee value that should be attached to the transaction. If not provided, default minimal fee is used.")
+		gasLimitFl = fl.Int64("gas-limit", 0, "Maximum amount of gas the transaction can consume. Required if the network configures a minimal gas price.")
+		gasPriceFl = flCoin(fl, "gas-price", "", "Price paid for a single unit of consumed gas.")
+		tmAddrFl   = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
 	)
 	fl.Parse(args)
@@ -103,6 +109,15 @@ already has a fee set, overwrite it with a new value.
 	if !amountFl.IsNonNegative() {
 		flagDie("fee value cannot be negative.")
 	}
+	if *gasLimitFl < 0 {
+		flagDie("gas limit cannot be negative.")
+	}
+	if !gasPriceFl.IsNonNegative() {
+		flagDie("gas price cannot be negative.")
+	}
+	if !coin.IsEmpty(gasPriceFl) && *gasLimitFl == 0 {
+		flagDie("gas price requires gas limit.")
+	}
 
 	tx, _, err := readTx(input)
 	if err != nil {
@@ -133,8 +148,12 @@ already has a fee set, overwrite it with a new value.
 
 	}
 	tx.Fees = &cash.FeeInfo{
-		Payer: payer,
-		Fees:  amountFl,
+		Payer:    payer,
+		Fees:     amountFl,
+		GasLimit: *gasLimitFl,
+	}
+	if !coin.IsEmpty(gasPriceFl) {
+		tx.Fees.GasPrice = gasPriceFl
 	}
 
 	_, err = writeTx(output, tx)
@@ -91,6 +91,42 @@ func TestCmdWithFeeHappyPath(t *testing.T) {
 	assert.Equal(t, sendMsg, txmsg)
 }
 
+func TestCmdWithFeeGas(t *testing.T) {
+	sendTx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashSendMsg{
+			CashSendMsg: &cash.SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      fromHex(t, "b1ca7e78f74423ae01da3b51e676934d9105f282"),
+				Destination: fromHex(t, "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0"),
+				Amount:      coin.NewCoinp(5, 0, "DOGE"),
+			},
+		},
+	}
+	var input bytes.Buffer
+	if _, err := writeTx(&input, sendTx); err != nil {
+		t.Fatalf("cannot serialize transaction: %s", err)
+	}
+
+	var output bytes.Buffer
+	args := []string{
+		"-amount", "5 DOGE",
+		"-gas-limit", "20000",
+		"-gas-price", "0.000001 DOGE",
+	}
+	if err := cmdWithFee(&input, &output, args); err != nil {
+		t.Fatalf("cannot attach a fee to transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	assert.Equal(t, coin.NewCoinp(5, 0, "DOGE"), tx.Fees.Fees)
+	assert.Equal(t, int64(20000), tx.Fees.GasLimit)
+	assert.Equal(t, coin.NewCoinp(0, 1000, "DOGE"), tx.Fees.GasPrice)
+	assert.Equal(t, int64(20000), tx.GetGasLimit())
+}
+
 func TestCmdWithFeeHappyPathDefaultAmount(t *testing.T) {
 	sendMsg := &cash.SendMsg{
 		Metadata:    &weave.Metadata{Schema: 1},
@@ -24,6 +24,8 @@ import (
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/slashing"
+	"github.com/iov-one/weave/x/staking"
 	"github.com/iov-one/weave/x/txfee"
 	"github.com/iov-one/weave/x/validators"
 )
@@ -338,6 +340,18 @@ transaction (ie signatures) are being dropped.
 						MsgfeeUpdateConfigurationMsg: m,
 					},
 				})
+			case *slashing.UpdateConfigurationMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg{
+						SlashingUpdateConfigurationMsg: m,
+					},
+				})
+			case *staking.UpdateConfigurationMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg{
+						StakingUpdateConfigurationMsg: m,
+					},
+				})
 			}
 		}
 		option.Option = &bnsd.ProposalOptions_ExecuteProposalBatchMsg{
@@ -489,6 +503,14 @@ transaction (ie signatures) are being dropped.
 		option.Option = &bnsd.ProposalOptions_MsgfeeUpdateConfigurationMsg{
 			MsgfeeUpdateConfigurationMsg: msg,
 		}
+	case *slashing.UpdateConfigurationMsg:
+		option.Option = &bnsd.ProposalOptions_SlashingUpdateConfigurationMsg{
+			SlashingUpdateConfigurationMsg: msg,
+		}
+	case *staking.UpdateConfigurationMsg:
+		option.Option = &bnsd.ProposalOptions_StakingUpdateConfigurationMsg{
+			StakingUpdateConfigurationMsg: msg,
+		}
 	}
 
 	rawOption, err := option.Marshal()
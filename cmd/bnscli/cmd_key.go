@@ -7,10 +7,12 @@ import (
 	"flag"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/crypto/bech32"
 	"github.com/stellar/go/exp/crypto/derivation"
@@ -33,6 +35,7 @@ created. This command fails if the private key file already exists.
 		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
 		pathFl = fl.String("path", "m/44'/234'/0'", "Derivation path as described in BIP-44.")
+		algoFl = fl.String("algo", "ed25519", "Algorithm of the generated key. Either ed25519 or secp256k1.")
 	)
 	fl.Parse(args)
 
@@ -49,10 +52,14 @@ created. This command fails if the private key file already exists.
 	}
 	mnemonic = bytes.TrimSpace(mnemonic)
 
-	priv, err := keygen(string(mnemonic), *pathFl)
+	priv, err := keygen(string(mnemonic), *pathFl, *algoFl)
 	if err != nil {
 		return fmt.Errorf("cannot generate key: %s", err)
 	}
+	raw, err := encodePrivateKey(priv)
+	if err != nil {
+		return err
+	}
 
 	fd, err := os.OpenFile(*keyPathFl, os.O_CREATE|os.O_WRONLY, 0400)
 	if err != nil {
@@ -60,7 +67,7 @@ created. This command fails if the private key file already exists.
 	}
 	defer fd.Close()
 
-	if _, err := fd.Write(priv); err != nil {
+	if _, err := fd.Write(raw); err != nil {
 		return fmt.Errorf("cannot write private key: %s", err)
 	}
 	if err := fd.Close(); err != nil {
@@ -70,8 +77,8 @@ created. This command fails if the private key file already exists.
 }
 
 // keygen returns a private key generated using given mnemonic and derivation
-// path.
-func keygen(mnemonic, derivationPath string) (ed25519.PrivateKey, error) {
+// path. Algorithm must be either ed25519 or secp256k1.
+func keygen(mnemonic, derivationPath, algo string) (*crypto.PrivateKey, error) {
 	if err := validateMnemonic(string(mnemonic)); err != nil {
 		return nil, fmt.Errorf("invalid mnemonic: %s", err)
 	}
@@ -79,6 +86,19 @@ func keygen(mnemonic, derivationPath string) (ed25519.PrivateKey, error) {
 	// We do not allow for passphrase.
 	seed := bip39.NewSeed(string(mnemonic), "")
 
+	switch algo {
+	case "ed25519":
+		return keygenEd25519(seed, derivationPath)
+	case "secp256k1":
+		return keygenSecp256k1(seed, derivationPath)
+	default:
+		return nil, fmt.Errorf("unknown key algorithm %q", algo)
+	}
+}
+
+// keygenEd25519 derives an ed25519 key as described in SLIP-0010. Only
+// hardened derivation is supported.
+func keygenEd25519(seed []byte, derivationPath string) (*crypto.PrivateKey, error) {
 	key, err := derivation.DeriveForPath(derivationPath, seed)
 	if err != nil {
 		return nil, fmt.Errorf("cannot deriviate master key from seed: %s", err)
@@ -88,7 +108,54 @@ func keygen(mnemonic, derivationPath string) (ed25519.PrivateKey, error) {
 	if err != nil {
 		return nil, fmt.Errorf("cannot generate ed25519 private key: %s", err)
 	}
-	return priv, nil
+	return &crypto.PrivateKey{
+		Priv: &crypto.PrivateKey_Ed25519{Ed25519: priv},
+	}, nil
+}
+
+// keygenSecp256k1 derives a secp256k1 key as described in BIP-32.
+func keygenSecp256k1(seed []byte, derivationPath string) (*crypto.PrivateKey, error) {
+	path, err := parseDerivationPath(derivationPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid derivation path: %s", err)
+	}
+	key, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
+	if err != nil {
+		return nil, fmt.Errorf("cannot deriviate master key from seed: %s", err)
+	}
+	for _, index := range path {
+		if key, err = key.Child(index); err != nil {
+			return nil, fmt.Errorf("cannot deriviate child key: %s", err)
+		}
+	}
+	ec, err := key.ECPrivKey()
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate secp256k1 private key: %s", err)
+	}
+	return crypto.PrivKeySecp256k1FromBytes(ec.Serialize())
+}
+
+// parseDerivationPath returns child indexes of a BIP-32 derivation path, for
+// example m/44'/234'/0'/0/1. Hardened indexes are marked with an apostrophe.
+func parseDerivationPath(path string) ([]uint32, error) {
+	segments := strings.Split(path, "/")
+	if segments[0] != "m" {
+		return nil, errors.New("path must start with m")
+	}
+	indexes := make([]uint32, 0, len(segments)-1)
+	for _, s := range segments[1:] {
+		var offset uint32
+		if strings.HasSuffix(s, "'") {
+			offset = hdkeychain.HardenedKeyStart
+			s = s[:len(s)-1]
+		}
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil || uint32(n) >= hdkeychain.HardenedKeyStart {
+			return nil, fmt.Errorf("invalid index %q", s)
+		}
+		indexes = append(indexes, uint32(n)+offset)
+	}
+	return indexes, nil
 }
 
 // isMnemonicValid returns true if given mnemonic string is valid. Whitespaces
@@ -131,22 +198,12 @@ Print out a hex-address associated with your private key.
 	)
 	fl.Parse(args)
 
-	raw, err := ioutil.ReadFile(*keyPathFl)
+	key, err := decodePrivateKey(*keyPathFl)
 	if err != nil {
-		return fmt.Errorf("cannot read private key file: %s", err)
-	}
-
-	if len(raw) != ed25519.PrivateKeySize {
-		return fmt.Errorf("invalid private key length: %d", len(raw))
-	}
-
-	key := &crypto.PrivateKey{
-		Priv: &crypto.PrivateKey_Ed25519{
-			Ed25519: raw,
-		},
+		return fmt.Errorf("cannot load private key: %s", err)
 	}
 
-	bech, err := toBech32(*bechPrefixFl, key.PublicKey().GetEd25519())
+	bech, err := toBech32(*bechPrefixFl, key.PublicKey())
 	if err != nil {
 		return fmt.Errorf("cannot generate bech32 address format: %s", err)
 	}
@@ -158,9 +215,10 @@ Print out a hex-address associated with your private key.
 
 // toBech32 computes the bech32 address representation as described in
 // https://github.com/iov-one/iov-core/blob/8846fed17443766a9ad9c908c3d7fc9d205e02ef/docs/address-derivation-v1.md#deriving-addresses-from-keypairs
-func toBech32(prefix string, pubkey []byte) ([]byte, error) {
-	data := append([]byte("sigs/ed25519/"), pubkey...)
-	hash := sha256.Sum256(data)
+func toBech32(prefix string, pubkey *crypto.PublicKey) ([]byte, error) {
+	// Condition is the algorithm prefixed public key, for example
+	// sigs/ed25519/<pubkey>
+	hash := sha256.Sum256(pubkey.Condition())
 	bech, err := bech32.Encode(prefix, hash[:20])
 	if err != nil {
 		return nil, fmt.Errorf("cannot compute bech32: %s", err)
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
 	"testing"
 
-	"golang.org/x/crypto/ed25519"
+	"github.com/iov-one/weave/crypto"
 )
 
 func TestKeygen(t *testing.T) {
@@ -21,11 +24,11 @@ func TestKeygen(t *testing.T) {
 
 	for path, bech := range cases {
 		t.Run(path, func(t *testing.T) {
-			priv, err := keygen(mnemonic, path)
+			priv, err := keygen(mnemonic, path, "ed25519")
 			if err != nil {
 				t.Fatalf("cannot generate key: %s", err)
 			}
-			b, err := toBech32("tiov", priv.Public().(ed25519.PublicKey))
+			b, err := toBech32("tiov", priv.PublicKey())
 			if err != nil {
 				t.Fatalf("cannot serialize to bech32: %s", err)
 			}
@@ -38,6 +41,40 @@ func TestKeygen(t *testing.T) {
 	}
 }
 
+func TestKeygenSecp256k1(t *testing.T) {
+	// Test vector 1 from BIP-32 specification.
+	// https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki#test-vector-1
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	if err != nil {
+		t.Fatalf("cannot decode seed: %s", err)
+	}
+	cases := map[string]string{
+		"m":                      "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		"m/0'":                   "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		"m/0'/1/2'":              "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca",
+		"m/0'/1/2'/2/1000000000": "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8",
+	}
+	for path, want := range cases {
+		t.Run(path, func(t *testing.T) {
+			priv, err := keygenSecp256k1(seed, path)
+			if err != nil {
+				t.Fatalf("cannot generate key: %s", err)
+			}
+			if got := hex.EncodeToString(priv.GetSecp256K1()); got != want {
+				t.Logf("want: %s", want)
+				t.Logf(" got: %s", got)
+				t.Fatal("unexpected private key")
+			}
+		})
+	}
+
+	for _, path := range []string{"", "44'/0'", "m/x", "m/4294967296", "m/2147483648'"} {
+		if _, err := keygenSecp256k1(seed, path); err == nil {
+			t.Errorf("invalid path %q accepted", path)
+		}
+	}
+}
+
 func TestMnemonic(t *testing.T) {
 	cases := map[string]struct {
 		mnemonic string
@@ -96,10 +133,39 @@ func TestMnemonic(t *testing.T) {
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
-			_, err := keygen(tc.mnemonic, "m/44'/234'/0'")
+			_, err := keygen(tc.mnemonic, "m/44'/234'/0'", "ed25519")
 			if hasErr := err != nil; hasErr != tc.wantErr {
 				t.Fatalf("returned erorr value: %+v", err)
 			}
 		})
 	}
 }
+
+func TestKeyaddrSecp256k1(t *testing.T) {
+	raw, err := hex.DecodeString("471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8")
+	if err != nil {
+		t.Fatalf("cannot decode key: %s", err)
+	}
+	var output bytes.Buffer
+	args := []string{
+		"-key", mustCreateFile(t, bytes.NewReader(raw)),
+	}
+	if err := cmdKeyaddr(nil, &output, args); err != nil {
+		t.Fatalf("cannot get address: %s", err)
+	}
+
+	key, err := crypto.PrivKeySecp256k1FromBytes(raw)
+	if err != nil {
+		t.Fatalf("cannot create key: %s", err)
+	}
+	bech, err := toBech32("iov", key.PublicKey())
+	if err != nil {
+		t.Fatalf("cannot serialize to bech32: %s", err)
+	}
+	want := fmt.Sprintf("bech32\t%s\nhex\t%s\n", bech, key.PublicKey().Address())
+	if got := output.String(); got != want {
+		t.Logf("want: %s", want)
+		t.Logf(" got: %s", got)
+		t.Fatal("unexpected output")
+	}
+}
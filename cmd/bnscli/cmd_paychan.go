@@ -0,0 +1,318 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x/paychan"
+)
+
+func cmdPaychanCreate(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for opening a new payment channel. Funds are allocated
+from the source account. Vouchers for this channel must be signed with the
+given private key.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
+			"Path to the private key file that vouchers are going to be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+		srcFl     = flAddress(fl, "src", "", "Optional address of the source account. If not provided, the address of the private key is used.")
+		dstFl     = flAddress(fl, "dst", "", "Address of the destination account.")
+		amountFl  = flCoin(fl, "amount", "", "Total amount that can be transferred via this channel.")
+		timeoutFl = flTime(fl, "timeout", nextWeek, "Expiration time of the channel. After that time, anyone can close the channel.")
+		memoFl    = fl.String("memo", "", "A short text attached to the channel.")
+	)
+	fl.Parse(args)
+
+	key, err := decodePrivateKey(*keyPathFl)
+	if err != nil {
+		return fmt.Errorf("cannot load private key: %s", err)
+	}
+	src := *srcFl
+	if len(src) == 0 {
+		src = key.PublicKey().Address()
+	}
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_PaychanCreateMsg{
+			PaychanCreateMsg: &paychan.CreateMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Source:       src,
+				SourcePubkey: key.PublicKey(),
+				Destination:  *dstFl,
+				Total:        amountFl,
+				Timeout:      timeoutFl.UnixTime(),
+				Memo:         *memoFl,
+			},
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdPaychanVoucher(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create and sign a payment voucher for given payment channel. The voucher is a
+transaction with a transfer message that the destination of the channel can
+submit at any time.
+
+The amount is cumulative. Every voucher must be created with an amount greater
+than the previous one. The voucher is signed off-chain with the private key of
+the channel source.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+			"Tendermint node address, used only to fetch the chain ID if not provided. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
+		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
+			"Path to the private key file that the voucher should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+		chainIDFl = fl.String("chain-id", "", "Optional ID of the chain that the voucher is created for. If not provided, it is fetched from the Tendermint node.")
+		channelFl = flSeq(fl, "channel", "", "An ID of the payment channel.")
+		amountFl  = flCoin(fl, "amount", "", "Total amount transferred via the channel, including all previous payments.")
+		memoFl    = fl.String("memo", "", "A short text attached to the payment.")
+	)
+	fl.Parse(args)
+
+	key, err := decodePrivateKey(*keyPathFl)
+	if err != nil {
+		return fmt.Errorf("cannot load private key: %s", err)
+	}
+	chainID := *chainIDFl
+	if chainID == "" {
+		genesis, err := fetchGenesis(*tmAddrFl)
+		if err != nil {
+			return fmt.Errorf("cannot fetch genesis: %s", err)
+		}
+		chainID = genesis.ChainID
+	}
+
+	payment := &paychan.Payment{
+		ChainID:   chainID,
+		ChannelID: *channelFl,
+		Amount:    amountFl,
+		Memo:      *memoFl,
+	}
+	raw, err := payment.Marshal()
+	if err != nil {
+		return fmt.Errorf("cannot serialize payment: %s", err)
+	}
+	sig, err := key.Sign(raw)
+	if err != nil {
+		return fmt.Errorf("cannot sign payment: %s", err)
+	}
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_PaychanTransferMsg{
+			PaychanTransferMsg: &paychan.TransferMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Payment:   payment,
+				Signature: sig,
+			},
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdPaychanVerifyVoucher(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Read any number of vouchers from the stdin and verify them against the current
+state of their payment channels. This is the same verification that is done
+when a voucher is submitted, but nothing is written to the blockchain.
+
+A summary is printed for every voucher. An error is returned if any of the
+vouchers is not valid.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
+	)
+	fl.Parse(args)
+
+	vouchers, err := readVouchers(input)
+	if err != nil {
+		return err
+	}
+	verifier, err := tendermintVoucherVerifier(*tmAddrFl)
+	if err != nil {
+		return err
+	}
+
+	var invalid int
+	for i, v := range vouchers {
+		channelID, err := fromSequence(v.Payment.ChannelID)
+		if err != nil {
+			return fmt.Errorf("voucher %d: invalid channel ID: %s", i, err)
+		}
+		if err := verifier.Verify(v); err != nil {
+			invalid++
+			fmt.Fprintf(output, "voucher %d: channel %d, amount %s: %s\n", i, channelID, v.Payment.Amount, err)
+		} else {
+			fmt.Fprintf(output, "voucher %d: channel %d, amount %s: valid\n", i, channelID, v.Payment.Amount)
+		}
+	}
+	if invalid != 0 {
+		return fmt.Errorf("%d of %d vouchers are not valid", invalid, len(vouchers))
+	}
+	return nil
+}
+
+func cmdPaychanTransfer(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Read any number of vouchers from the stdin and create a transaction for
+redeeming the best one. Vouchers are cumulative, so only the valid voucher with
+the greatest amount is used. Invalid vouchers are ignored.
+
+If vouchers for several payment channels are given, a transaction is created
+for each channel. Use as-batch to combine them into a single transaction.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
+	)
+	fl.Parse(args)
+
+	vouchers, err := readVouchers(input)
+	if err != nil {
+		return err
+	}
+	verifier, err := tendermintVoucherVerifier(*tmAddrFl)
+	if err != nil {
+		return err
+	}
+
+	best := bestVouchers(verifier, vouchers)
+	if len(best) == 0 {
+		return errors.New("no valid voucher")
+	}
+	for _, v := range best {
+		tx := &bnsd.Tx{
+			Sum: &bnsd.Tx_PaychanTransferMsg{
+				PaychanTransferMsg: v,
+			},
+		}
+		if _, err := writeTx(output, tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readVouchers returns all vouchers that can be read from given input. Each
+// voucher is a transaction with a payment channel transfer message.
+func readVouchers(input io.Reader) ([]*paychan.TransferMsg, error) {
+	var vouchers []*paychan.TransferMsg
+	for {
+		tx, _, err := readTx(input)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("cannot read transaction: %s", err)
+		}
+		msg, err := tx.GetMsg()
+		if err != nil {
+			return nil, fmt.Errorf("cannot extract message from the transaction: %s", err)
+		}
+		voucher, ok := msg.(*paychan.TransferMsg)
+		if !ok {
+			return nil, fmt.Errorf("voucher must be a payment channel transfer message, got %T", msg)
+		}
+		if err := voucher.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid voucher: %s", err)
+		}
+		vouchers = append(vouchers, voucher)
+	}
+	if len(vouchers) == 0 {
+		return nil, errors.New("no voucher provided")
+	}
+	return vouchers, nil
+}
+
+// voucherVerifier verifies vouchers against the state of their payment
+// channels. Each payment channel is loaded only once.
+type voucherVerifier struct {
+	chainID  string
+	db       weave.ReadOnlyKVStore
+	bucket   orm.ModelBucket
+	channels map[string]*paychan.PaymentChannel
+}
+
+func newVoucherVerifier(chainID string, db weave.ReadOnlyKVStore) *voucherVerifier {
+	return &voucherVerifier{
+		chainID:  chainID,
+		db:       db,
+		bucket:   paychan.NewPaymentChannelBucket(),
+		channels: make(map[string]*paychan.PaymentChannel),
+	}
+}
+
+// tendermintVoucherVerifier returns a voucher verifier that is using the
+// state of the chain served by the Tendermint node with given address.
+func tendermintVoucherVerifier(nodeURL string) (*voucherVerifier, error) {
+	genesis, err := fetchGenesis(nodeURL)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch genesis: %s", err)
+	}
+	return newVoucherVerifier(genesis.ChainID, tendermintStore(nodeURL)), nil
+}
+
+// Verify returns an error if given voucher cannot be redeemed.
+func (v *voucherVerifier) Verify(voucher *paychan.TransferMsg) error {
+	key := string(voucher.Payment.ChannelID)
+	pc, ok := v.channels[key]
+	if !ok {
+		var channel paychan.PaymentChannel
+		if err := v.bucket.One(v.db, voucher.Payment.ChannelID, &channel); err != nil {
+			return fmt.Errorf("cannot load payment channel: %s", err)
+		}
+		pc = &channel
+		v.channels[key] = pc
+	}
+	return paychan.VerifyTransfer(v.chainID, pc, voucher)
+}
+
+// bestVouchers returns the valid voucher with the greatest amount for every
+// payment channel, in the order the channels appear in given vouchers.
+func bestVouchers(verifier *voucherVerifier, vouchers []*paychan.TransferMsg) []*paychan.TransferMsg {
+	var best []*paychan.TransferMsg
+	index := make(map[string]int)
+	for _, v := range vouchers {
+		if err := verifier.Verify(v); err != nil {
+			continue
+		}
+		key := string(v.Payment.ChannelID)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(best)
+			best = append(best, v)
+			continue
+		}
+		// All valid vouchers of a channel use the same ticker.
+		if v.Payment.Amount.Compare(*best[i].Payment.Amount) > 0 {
+			best[i] = v
+		}
+	}
+	return best
+}
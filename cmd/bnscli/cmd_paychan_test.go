@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/paychan"
+)
+
+func TestCmdPaychanCreateHappyPath(t *testing.T) {
+	key := mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex)))
+	priv, err := decodePrivateKey(key)
+	assert.Nil(t, err)
+
+	var output bytes.Buffer
+	args := []string{
+		"-key", key,
+		"-dst", "seq:test/bnscli/2",
+		"-amount", "10 IOV",
+		"-memo", "a memo",
+	}
+	if err := cmdPaychanCreate(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a payment channel transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot read created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*paychan.CreateMsg)
+
+	assert.Equal(t, priv.PublicKey().Address(), msg.Source)
+	assert.Equal(t, priv.PublicKey(), msg.SourcePubkey)
+	assert.Equal(t, coin.NewCoinp(10, 0, "IOV"), msg.Total)
+	assert.Equal(t, "a memo", msg.Memo)
+	assert.Nil(t, msg.Validate())
+}
+
+func TestCmdPaychanVoucherHappyPath(t *testing.T) {
+	key := mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex)))
+	priv, err := decodePrivateKey(key)
+	assert.Nil(t, err)
+
+	var output bytes.Buffer
+	args := []string{
+		"-key", key,
+		"-chain-id", "test-chain",
+		"-channel", "3",
+		"-amount", "2 IOV",
+	}
+	if err := cmdPaychanVoucher(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a voucher: %s", err)
+	}
+
+	vouchers, err := readVouchers(&output)
+	if err != nil {
+		t.Fatalf("cannot read created voucher: %s", err)
+	}
+	if len(vouchers) != 1 {
+		t.Fatalf("want one voucher, got %d", len(vouchers))
+	}
+	v := vouchers[0]
+	assert.Equal(t, "test-chain", v.Payment.ChainID)
+	assert.Equal(t, sequenceID(3), v.Payment.ChannelID)
+	assert.Equal(t, coin.NewCoinp(2, 0, "IOV"), v.Payment.Amount)
+
+	raw, err := v.Payment.Marshal()
+	assert.Nil(t, err)
+	if !priv.PublicKey().Verify(raw, v.Signature) {
+		t.Fatal("invalid voucher signature")
+	}
+}
+
+func TestBestVouchers(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "paychan")
+
+	src := weavetest.NewKey()
+	bucket := paychan.NewPaymentChannelBucket()
+	channels := make([][]byte, 2)
+	for i := range channels {
+		pc := &paychan.PaymentChannel{
+			Metadata:     &weave.Metadata{Schema: 1},
+			Source:       src.PublicKey().Address(),
+			SourcePubkey: src.PublicKey(),
+			Destination:  weavetest.NewCondition().Address(),
+			Total:        coin.NewCoinp(10, 0, "IOV"),
+			Timeout:      weave.AsUnixTime(nextWeek()),
+			Transferred:  coin.NewCoinp(1, 0, "IOV"),
+			// Channel address is derived from the ID that is
+			// not known before the channel is stored.
+			Address: weave.NewCondition("paychan", "seq", sequenceID(uint64(i+1))).Address(),
+		}
+		key, err := bucket.Put(db, nil, pc)
+		assert.Nil(t, err)
+		channels[i] = key
+	}
+
+	voucher := func(key crypto.Signer, channelID []byte, amount int64) *paychan.TransferMsg {
+		payment := &paychan.Payment{
+			ChainID:   "test-chain",
+			ChannelID: channelID,
+			Amount:    coin.NewCoinp(amount, 0, "IOV"),
+		}
+		raw, err := payment.Marshal()
+		assert.Nil(t, err)
+		sig, err := key.Sign(raw)
+		assert.Nil(t, err)
+		return &paychan.TransferMsg{
+			Metadata:  &weave.Metadata{Schema: 1},
+			Payment:   payment,
+			Signature: sig,
+		}
+	}
+
+	vouchers := []*paychan.TransferMsg{
+		voucher(src, channels[0], 3),
+		voucher(src, channels[1], 2),
+		voucher(src, channels[0], 5),
+		// Signed by a different key.
+		voucher(weavetest.NewKey(), channels[0], 8),
+		// Greater than the channel total.
+		voucher(src, channels[1], 11),
+		voucher(src, channels[0], 4),
+		// Channel does not exist.
+		voucher(src, sequenceID(99), 4),
+	}
+
+	verifier := newVoucherVerifier("test-chain", db)
+	best := bestVouchers(verifier, vouchers)
+	assert.Equal(t, []*paychan.TransferMsg{vouchers[2], vouchers[1]}, best)
+
+	if err := verifier.Verify(vouchers[3]); err == nil {
+		t.Fatal("voucher signed with a different key must not be valid")
+	}
+	if err := newVoucherVerifier("other-chain", db).Verify(vouchers[0]); err == nil {
+		t.Fatal("voucher for a different chain must not be valid")
+	}
+}
@@ -20,14 +20,14 @@ Create a transaction for updating qualityscore extension configuration.
 	}
 	var (
 		ownerFl = flAddress(fl, "owner", "", "A new configuration owner.")
-		cFl     = flFraction(fl, "c", "0", "")
-		kFl     = flFraction(fl, "k", "0", "")
-		kpFl    = flFraction(fl, "kp", "0", "")
-		q0Fl    = flFraction(fl, "q0", "0", "")
-		xFl     = flFraction(fl, "x", "0", "")
-		xInfFl  = flFraction(fl, "xinf", "0", "")
-		xSupFl  = flFraction(fl, "xsup", "0", "")
-		deltaFl = flFraction(fl, "delta", "0", "")
+		cFl     = flFraction(fl, "c", "0", "Minimal quality score.")
+		kFl     = flFraction(fl, "k", "0", "Rate of the score growth when the load is above the target.")
+		kpFl    = flFraction(fl, "kp", "0", "Rate of the score decrease when the load is below the target.")
+		q0Fl    = flFraction(fl, "q0", "0", "Quality score when the load is equal to the target.")
+		xFl     = flFraction(fl, "x", "0", "Target load, as the number of transactions per block.")
+		xInfFl  = flFraction(fl, "xinf", "0", "Lower limit of the load.")
+		xSupFl  = flFraction(fl, "xsup", "0", "Upper limit of the load. Zero means no limit.")
+		deltaFl = flFraction(fl, "delta", "0", "Weight of the last block load in the moving average of the load.")
 	)
 	fl.Parse(args)
 
@@ -9,6 +9,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
@@ -16,16 +17,9 @@ import (
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/cmd/bnsd/client"
-	"github.com/iov-one/weave/cmd/bnsd/x/account"
-	"github.com/iov-one/weave/cmd/bnsd/x/preregistration"
-	"github.com/iov-one/weave/cmd/bnsd/x/termdeposit"
-	"github.com/iov-one/weave/cmd/bnsd/x/username"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/orm"
-	"github.com/iov-one/weave/x/cash"
-	"github.com/iov-one/weave/x/distribution"
-	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/gov"
-	"github.com/iov-one/weave/x/multisig"
 )
 
 func cmdQuery(input io.Reader, output io.Writer, args []string) error {
@@ -33,6 +27,10 @@ func cmdQuery(input io.Reader, output io.Writer, args []string) error {
 	fl.Usage = func() {
 		fmt.Fprint(flag.CommandLine.Output(), `
 Execute a ABCI query and print JSON encoded result.
+
+Results can be paginated using the limit flag. If there are more results
+available, the cursor of the next page is printed to the stderr. Use it with
+the cursor flag to continue the query.
 `)
 		fl.PrintDefaults()
 	}
@@ -42,6 +40,8 @@ Execute a ABCI query and print JSON encoded result.
 		pathFl        = fl.String("path", "", "Path to be queried. Must be one of the supported.")
 		dataFl        = fl.String("data", "", "individual query data. Format depends on the queried entity. Use 'id/version' for electoraterules, electorates")
 		prefixQueryFl = fl.String("prefix", "false", "If true, use prefix queries instead of the exact match with provided data. [true/false]")
+		limitFl       = fl.Int("limit", 0, "Optional maximum number of results returned. Zero means the default limit of the queried entity.")
+		cursorFl      = fl.String("cursor", "", "Optional hex encoded cursor returned by the previous query, used to fetch the next page of results.")
 	)
 	fl.Parse(args)
 
@@ -66,10 +66,18 @@ Execute a ABCI query and print JSON encoded result.
 			return fmt.Errorf("can not encode data: %s", err)
 		}
 	}
-	queryPath := *pathFl
+	cursor, err := hex.DecodeString(*cursorFl)
+	if err != nil {
+		return fmt.Errorf("invalid cursor: %s", err)
+	}
+	if *limitFl < 0 {
+		return errors.New("limit must not be negative")
+	}
+	var mod string
 	if prefixQuery || *dataFl == "" {
-		queryPath += "?" + weave.PrefixQueryMod
+		mod = weave.PrefixQueryMod
 	}
+	queryPath := weave.QueryPath(*pathFl, mod, weave.QueryPage{Cursor: cursor, Limit: *limitFl})
 
 	bnsClient := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
 	resp, err := bnsClient.AbciQuery(queryPath, data)
@@ -79,12 +87,9 @@ Execute a ABCI query and print JSON encoded result.
 
 	result := make([]keyval, 0, len(resp.Models))
 	for i, m := range resp.Models {
-		var obj model
-		if len(m.Value) != 0 {
-			obj = conf.newObj()
-			if err := obj.Unmarshal(m.Value); err != nil {
-				return fmt.Errorf("failed to unmarshal model %d: %s", i, err)
-			}
+		obj, err := decodeModel(m)
+		if err != nil {
+			return fmt.Errorf("failed to decode model %d: %s", i, err)
 		}
 		key, err := conf.decKey(m.Key)
 		if err != nil {
@@ -96,8 +101,15 @@ Execute a ABCI query and print JSON encoded result.
 	if err != nil {
 		return fmt.Errorf("cannot JSON serialize: %s", err)
 	}
-	_, err = output.Write(pretty)
-	return err
+	if _, err := output.Write(pretty); err != nil {
+		return err
+	}
+	if resp.HasMore() {
+		// Cursor is not part of the result so that the output can
+		// always be processed as a list of models.
+		fmt.Fprintf(os.Stderr, "\nmore results available, use -cursor %x\n", resp.Cursor)
+	}
+	return nil
 }
 
 type keyval struct {
@@ -105,12 +117,33 @@ type keyval struct {
 	Value model `json:",omitempty"`
 }
 
+// queryModels holds the model types that bnsd extensions register for their
+// buckets. It is used to decode query results.
+var queryModels = bnsd.QueryRouter(coin.Coin{})
+
+// decodeModel returns the model of a query result, decoded using the type
+// registered for the bucket that the model belongs to. Nil is returned for an
+// empty value.
+func decodeModel(m weave.Model) (model, error) {
+	if len(m.Value) == 0 {
+		return nil, nil
+	}
+	obj, ok := queryModels.NewModel(m.Key)
+	if !ok {
+		return nil, fmt.Errorf("no model type registered for %x key", m.Key)
+	}
+	if err := obj.Unmarshal(m.Value); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal %T: %s", obj, err)
+	}
+	if p, ok := obj.(*gov.Proposal); ok {
+		return extendProposal(p)
+	}
+	return obj, nil
+}
+
 // queries contains a mapping of query path to that query specifics. Each query
-// returns a custom model type and may use different ID encoding pattern.
+// may use different ID encoding pattern.
 var queries = map[string]struct {
-	// newObj returns a new instance of the model that the result of the
-	// ABCI query should be extracted into.
-	newObj func() model
 	// decKey is used to decode key value returned by the ABCI query and
 	// transform it into human readable form.
 	decKey func([]byte) (string, error)
@@ -120,107 +153,86 @@ var queries = map[string]struct {
 	encID func(string) ([]byte, error)
 }{
 	"/proposals": {
-		newObj: func() model { return &extendedProposal{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
 	"/proposals/author": {
-		newObj: func() model { return &extendedProposal{} },
 		decKey: sequenceKey,
 		encID:  addressID,
 	},
 	"/proposals/electorate": {
-		newObj: func() model { return &extendedProposal{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
 	"/electionrules": {
-		newObj: func() model { return &gov.ElectionRule{} },
 		decKey: refKey,
 		encID:  refID,
 	},
 	"/electorates": {
-		newObj: func() model { return &gov.Electorate{} },
 		decKey: refKey,
 		encID:  refID,
 	},
 	"/electorates/elector": {
-		newObj: func() model { return &gov.Electorate{} },
 		decKey: refKey,
 		encID:  addressID,
 	},
 	"/votes": {
-		newObj: func() model { return &gov.Vote{} },
 		decKey: rawKey,
 		encID:  addressID,
 	},
 	"/votes/proposals": {
-		newObj: func() model { return &gov.Vote{} },
 		decKey: rawKey,
 		encID:  numericID,
 	},
 	"/votes/electors": {
-		newObj: func() model { return &gov.Vote{} },
 		decKey: rawKey,
 		encID:  addressID,
 	},
 	"/usernames": {
-		newObj: func() model { return &username.Token{} },
 		decKey: rawKey,
 		encID:  addressID,
 	},
 	"/usernames/owner": {
-		newObj: func() model { return &username.Token{} },
 		decKey: rawKey,
 		encID:  addressID,
 	},
 	"/wallets": {
-		newObj: func() model { return &cash.Set{} },
 		decKey: rawKey,
 		encID:  addressID,
 	},
 	"/escrows": {
-		newObj: func() model { return &escrow.Escrow{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
 	"/revenues": {
-		newObj: func() model { return &distribution.Revenue{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
 	"/contracts": {
-		newObj: func() model { return &multisig.Contract{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
 	"/accounts": {
-		newObj: func() model { return &account.Account{} },
 		decKey: strKey,
 		encID:  strID,
 	},
 	"/domains": {
-		newObj: func() model { return &account.Domain{} },
 		decKey: strKey,
 		encID:  strID,
 	},
 	"/depositcontracts": {
-		newObj: func() model { return &termdeposit.DepositContract{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
 	"/deposits": {
-		newObj: func() model { return &termdeposit.Deposit{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
 	"/deposits/contract": {
-		newObj: func() model { return &termdeposit.Deposit{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
 	"/preregistrationrecords": {
-		newObj: func() model { return &preregistration.Record{} },
 		decKey: rawKey,
 		encID:  strID,
 	},
@@ -326,15 +338,12 @@ type extendedProposal struct {
 	Option interface{} `json:"executed_when_accepted"`
 }
 
-// Unmarshal implements protobuf unmarshaler interface.
-func (p *extendedProposal) Unmarshal(raw []byte) error {
-	if err := p.Proposal.Unmarshal(raw); err != nil {
-		return fmt.Errorf("cannot unmarshal proposal: %s", err)
-	}
+// extendProposal returns given proposal together with the deserialized
+// RawOption.
+func extendProposal(p *gov.Proposal) (*extendedProposal, error) {
 	var opts bnsd.ProposalOptions
-	if err := opts.Unmarshal(p.Proposal.RawOption); err != nil {
-		return fmt.Errorf("cannot unmarshal proposal option: %s", err)
+	if err := opts.Unmarshal(p.RawOption); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal proposal option: %s", err)
 	}
-	p.Option = opts.GetOption()
-	return nil
+	return &extendedProposal{Proposal: *p, Option: opts.GetOption()}, nil
 }
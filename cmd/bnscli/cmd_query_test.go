@@ -0,0 +1,65 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/cmd/bnsd/x/username"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/gov"
+)
+
+func TestQueryPathsAreRegistered(t *testing.T) {
+	for path := range queries {
+		if queryModels.Handler(path) == nil {
+			t.Errorf("%s query is not registered by bnsd", path)
+		}
+	}
+}
+
+func TestDecodeModel(t *testing.T) {
+	token := username.Token{
+		Metadata: &weave.Metadata{Schema: 1},
+		Owner:    weavetest.NewCondition().Address(),
+	}
+	rawToken, err := token.Marshal()
+	assert.Nil(t, err)
+
+	obj, err := decodeModel(weave.Model{Key: []byte("tokens:alice*iov"), Value: rawToken})
+	assert.Nil(t, err)
+	assert.Equal(t, &token, obj)
+
+	opts := bnsd.ProposalOptions{
+		Option: &bnsd.ProposalOptions_CashSendMsg{
+			CashSendMsg: &cash.SendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Amount:   coin.NewCoinp(1, 0, "IOV"),
+			},
+		},
+	}
+	rawOpts, err := opts.Marshal()
+	assert.Nil(t, err)
+	proposal := gov.Proposal{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Title:     "a proposal",
+		RawOption: rawOpts,
+	}
+	rawProposal, err := proposal.Marshal()
+	assert.Nil(t, err)
+
+	obj, err = decodeModel(weave.Model{Key: append([]byte("proposal:"), weavetest.SequenceID(1)...), Value: rawProposal})
+	assert.Nil(t, err)
+	assert.Equal(t, &extendedProposal{Proposal: proposal, Option: opts.GetOption()}, obj)
+
+	obj, err = decodeModel(weave.Model{Key: []byte("tokens:bob*iov")})
+	assert.Nil(t, err)
+	assert.Equal(t, nil, obj)
+
+	if _, err := decodeModel(weave.Model{Key: []byte("unknown:1"), Value: rawToken}); err == nil {
+		t.Fatal("a model of an unknown bucket must not be decoded")
+	}
+}
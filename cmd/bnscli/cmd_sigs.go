@@ -13,6 +13,7 @@ import (
 	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/x/sigs"
+	"golang.org/x/crypto/ed25519"
 )
 
 func cmdSignTransaction(
@@ -77,13 +78,31 @@ func decodePrivateKey(filepath string) (*crypto.PrivateKey, error) {
 	if err != nil {
 		return nil, fmt.Errorf("cannot read %q file: %s", filepath, err)
 	}
-	if len(data) != 64 {
+	// Key algorithm is recognized by the length of the file content.
+	switch len(data) {
+	case ed25519.PrivateKeySize:
+		key := &crypto.PrivateKey{
+			Priv: &crypto.PrivateKey_Ed25519{Ed25519: data},
+		}
+		return key, nil
+	case 32:
+		return crypto.PrivKeySecp256k1FromBytes(data)
+	default:
 		return nil, errors.New("invalid key length")
 	}
-	key := &crypto.PrivateKey{
-		Priv: &crypto.PrivateKey_Ed25519{Ed25519: data},
+}
+
+// encodePrivateKey returns the raw representation of the private key, as
+// stored in the private key file.
+func encodePrivateKey(key *crypto.PrivateKey) ([]byte, error) {
+	switch k := key.GetPriv().(type) {
+	case *crypto.PrivateKey_Ed25519:
+		return k.Ed25519, nil
+	case *crypto.PrivateKey_Secp256K1:
+		return k.Secp256K1, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", k)
 	}
-	return key, nil
 }
 
 func fetchGenesis(serverURL string) (*genesis, error) {
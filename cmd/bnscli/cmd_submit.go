@@ -4,15 +4,19 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/cmd/bnsd/client"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x/aswap"
 	"github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/paychan"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 func cmdSubmitTransaction(input io.Reader, output io.Writer, args []string) error {
@@ -44,6 +48,15 @@ Make sure to collect enough signatures before submitting the transaction.
 
 	resp := bnsClient.BroadcastTx(tx)
 	if err := resp.IsError(); err != nil {
+		if resp.Response != nil {
+			conflict := fmtUniqueIndexConflict(resp.Response.CheckTx.Tags)
+			if conflict == "" {
+				conflict = fmtUniqueIndexConflict(resp.Response.DeliverTx.Tags)
+			}
+			if conflict != "" {
+				return fmt.Errorf("cannot broadcast transaction: %s\n%s", err, conflict)
+			}
+		}
 		return fmt.Errorf("cannot broadcast transaction: %s", err)
 	}
 
@@ -119,6 +132,42 @@ var formatters = map[string]func([]byte) (string, error){
 	paychan.CreateMsg{}.Path():           fmtSequence,
 }
 
+// fmtUniqueIndexConflict returns a human readable description of the entity
+// that a transaction conflicts with, as described by given response tags. An
+// empty string is returned if the tags do not describe a unique index
+// collision.
+func fmtUniqueIndexConflict(tags []common.KVPair) string {
+	var index, value, key []byte
+	for _, t := range tags {
+		switch string(t.Key) {
+		case orm.UniqueIndexTag:
+			index = t.Value
+		case orm.UniqueValueTag:
+			value = t.Value
+		case orm.UniqueKeyTag:
+			key = t.Value
+		}
+	}
+	if index == nil {
+		return ""
+	}
+	return fmt.Sprintf("conflict: unique index %q value %s is already used by %s", index, fmtBytes(value), fmtBytes(key))
+}
+
+// fmtBytes returns a quoted string if given value is a printable text.
+// Otherwise a hex representation is returned.
+func fmtBytes(b []byte) string {
+	if !utf8.Valid(b) {
+		return fmt.Sprintf("%x", b)
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) {
+			return fmt.Sprintf("%x", b)
+		}
+	}
+	return fmt.Sprintf("%q", b)
+}
+
 func fmtSequence(raw []byte) (string, error) {
 	n, err := fromSequence(raw)
 	if err != nil {
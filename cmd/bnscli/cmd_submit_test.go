@@ -7,10 +7,12 @@ import (
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 // TestCmdSubmitTxHappyPath will set fees, sign the tx, and submit it... ensuring the
@@ -155,3 +157,40 @@ func batchResp(t testing.TB, responses ...[]byte) []byte {
 	}
 	return b
 }
+
+func TestFmtUniqueIndexConflict(t *testing.T) {
+	cases := map[string]struct {
+		Tags []common.KVPair
+		Want string
+	}{
+		"no tags": {
+			Tags: nil,
+			Want: "",
+		},
+		"other tags": {
+			Tags: []common.KVPair{{Key: []byte(weave.PriorityTag), Value: []byte("1")}},
+			Want: "",
+		},
+		"printable values": {
+			Tags: []common.KVPair{
+				{Key: []byte(orm.UniqueIndexTag), Value: []byte(orm.PrimaryIndex)},
+				{Key: []byte(orm.UniqueValueTag), Value: []byte("alice*iov")},
+				{Key: []byte(orm.UniqueKeyTag), Value: []byte("alice*iov")},
+			},
+			Want: `conflict: unique index "primary" value "alice*iov" is already used by "alice*iov"`,
+		},
+		"binary values": {
+			Tags: []common.KVPair{
+				{Key: []byte(orm.UniqueIndexTag), Value: []byte("escrow_source")},
+				{Key: []byte(orm.UniqueValueTag), Value: []byte{0xff, 1}},
+				{Key: []byte(orm.UniqueKeyTag), Value: weavetest.SequenceID(2)},
+			},
+			Want: `conflict: unique index "escrow_source" value ff01 is already used by 0000000000000002`,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, tc.Want, fmtUniqueIndexConflict(tc.Tags))
+		})
+	}
+}
@@ -54,6 +54,10 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"mnemonic":                             cmdMnemonic,
 	"msgfee-update-configuration":          cmdMsgFeeUpdateConfiguration,
 	"multisig":                             cmdMultisig,
+	"paychan-create":                       cmdPaychanCreate,
+	"paychan-transfer":                     cmdPaychanTransfer,
+	"paychan-verify-voucher":               cmdPaychanVerifyVoucher,
+	"paychan-voucher":                      cmdPaychanVoucher,
 	"preregistration-register":             cmdPreregistrationRegister,
 	"preregistration-update-configuration": cmdPreregistrationUpdateConfiguration,
 	"qualityscore-update-configuration":    cmdQualityscoreUpdateConfiguration,
@@ -36,7 +36,10 @@ import (
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/paychan"
 	"github.com/iov-one/weave/x/sigs"
+	"github.com/iov-one/weave/x/slashing"
+	"github.com/iov-one/weave/x/staking"
 	"github.com/iov-one/weave/x/txfee"
 	"github.com/iov-one/weave/x/utils"
 	"github.com/iov-one/weave/x/validators"
@@ -57,10 +60,13 @@ func Chain(authFn x.Authenticator, minFee coin.Coin) app.Decorators {
 		utils.NewKeyTagger(),
 		// on CheckTx, bad tx don't affect state
 		utils.NewSavepoint().OnCheck(),
-		sigs.NewDecorator(),
+		sigs.NewDecorator().AllowReplacement(),
 		multisig.NewDecorator(authFn),
 		// cash.NewDynamicFeeDecorator embeds utils.NewSavepoint().OnDeliver()
 		cash.NewDynamicFeeDecorator(authFn, ctrl),
+		// qualityscore decorator must be after the dynamic fee and
+		// before all decorators that declare a required fee.
+		qualityscore.NewDecorator(),
 		msgfee.NewAntispamFeeDecorator(minFee),
 		msgfee.NewFeeDecorator(),
 		preregistration.NewZeroFeeDecorator(),
@@ -86,7 +92,7 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 
 	migration.RegisterRoutes(r, authFn)
 	cash.RegisterRoutes(r, authFn, ctrl)
-	escrow.RegisterRoutes(r, authFn, ctrl)
+	escrow.RegisterRoutes(r, authFn, ctrl, scheduler)
 	multisig.RegisterRoutes(r, authFn)
 	//TODO: Possibly revisit passing the bucket later to have more control over types?
 	// or implement a check
@@ -94,7 +100,7 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 	validators.RegisterRoutes(r, authFn)
 	distribution.RegisterRoutes(r, authFn, ctrl)
 	sigs.RegisterRoutes(r, authFn)
-	aswap.RegisterRoutes(r, authFn, ctrl)
+	aswap.RegisterRoutes(r, authFn, ctrl, scheduler)
 	gov.RegisterRoutes(r, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl), scheduler)
 	username.RegisterRoutes(r, authFn)
 	msgfee.RegisterRoutes(r, authFn)
@@ -104,6 +110,10 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 	preregistration.RegisterRoutes(r, authFn)
 	termdeposit.RegisterRoutes(r, authFn, ctrl)
 	qualityscore.RegisterRoutes(r, authFn)
+	paychan.RegisterRoutes(r, authFn, ctrl)
+	slashing.RegisterRoutes(r, authFn)
+	staking.RegisterRoutes(r, authFn, ctrl, scheduler)
+	cron.RegisterRoutes(r, authFn)
 	return r
 }
 
@@ -133,6 +143,9 @@ func QueryRouter(minFee coin.Coin) weave.QueryRouter {
 		gconf.RegisterQuery,
 		preregistration.RegisterQuery,
 		msgfee.RegisterQuery,
+		paychan.RegisterQuery,
+		slashing.RegisterQuery,
+		staking.RegisterQuery,
 	)
 	return r
 }
@@ -151,14 +164,16 @@ func Stack(issuer weave.Address, minFee coin.Coin) weave.Handler {
 // fee).
 func CronStack() weave.Handler {
 	rt := app.NewRouter()
+	scheduler := cron.NewScheduler(CronTaskMarshaler)
 
 	authFn := cron.Authenticator{}
 
 	// Cron is using custom router as not the same handlers are registered.
 	gov.RegisterCronRoutes(rt, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl))
 	distribution.RegisterRoutes(rt, authFn, ctrl)
-	escrow.RegisterRoutes(rt, authFn, ctrl)
-	aswap.RegisterRoutes(rt, authFn, ctrl)
+	escrow.RegisterRoutes(rt, authFn, ctrl, scheduler)
+	aswap.RegisterRoutes(rt, authFn, ctrl, scheduler)
+	staking.RegisterCronRoutes(rt, ctrl)
 
 	decorators := app.ChainDecorators(
 		utils.NewLogging(),
@@ -181,22 +196,41 @@ func Application(
 	options *server.Options,
 ) (app.BaseApp, error) {
 	ctx := context.Background()
-	kv, err := CommitKVStore(dbPath)
+	var storeOpts []iavl.CommitStoreOption
+	if options.Pruning != nil {
+		storeOpts = append(storeOpts, iavl.WithPruning(*options.Pruning))
+	}
+	if options.CacheSize > 0 {
+		storeOpts = append(storeOpts, iavl.WithCacheSize(options.CacheSize))
+	}
+	if options.Backend != "" {
+		storeOpts = append(storeOpts, iavl.WithBackend(options.Backend))
+	}
+	kv, err := CommitKVStore(dbPath, storeOpts...)
 	if err != nil {
 		return app.BaseApp{}, errors.Wrap(err, "cannot create store")
 	}
 	store := app.NewStoreApp(name, kv, QueryRouter(options.MinFee), ctx)
+	if options.Bech32Prefix != "" {
+		store = store.WithBech32Prefix(options.Bech32Prefix)
+	}
 	ticker := cron.NewTicker(CronStack(), CronTaskMarshaler)
-	base := app.NewBaseApp(store, tx, h, ticker, options.Debug)
+	pending := sigs.NewPendingTxs()
+	base := app.NewBaseApp(store, tx, h, ticker, options.Debug).
+		WithEndBlocker(slashing.NewEndBlocker().WithPowerSlasher(staking.NewPowerSlasher())).
+		WithCheckContext(func(ctx weave.Context) weave.Context {
+			return sigs.WithPendingTxs(ctx, pending)
+		})
 	return base, nil
 }
 
 // CommitKVStore returns an initialized KVStore that persists
-// the data to the named path.
-func CommitKVStore(dbPath string) (weave.CommitKVStore, error) {
+// the data to the named path. Options can be used to configure
+// caching and pruning of the store.
+func CommitKVStore(dbPath string, opts ...iavl.CommitStoreOption) (weave.CommitKVStore, error) {
 	// memory backed case, just for testing
 	if dbPath == "" {
-		return iavl.MockCommitStore(), nil
+		return iavl.MockCommitStore(opts...), nil
 	}
 
 	// Expand the path fully
@@ -211,5 +245,5 @@ func CommitKVStore(dbPath string) (weave.CommitKVStore, error) {
 	// Split the database name into it's components (dir, name)
 	dir := filepath.Dir(path)
 	name := filepath.Base(path)
-	return iavl.NewCommitStore(dir, name), nil
+	return iavl.NewCommitStore(dir, name, opts...), nil
 }
@@ -17,13 +17,17 @@ import (
 	migration "github.com/iov-one/weave/migration"
 	aswap "github.com/iov-one/weave/x/aswap"
 	cash "github.com/iov-one/weave/x/cash"
+	cron "github.com/iov-one/weave/x/cron"
 	currency "github.com/iov-one/weave/x/currency"
 	distribution "github.com/iov-one/weave/x/distribution"
 	escrow "github.com/iov-one/weave/x/escrow"
 	gov "github.com/iov-one/weave/x/gov"
 	msgfee "github.com/iov-one/weave/x/msgfee"
 	multisig "github.com/iov-one/weave/x/multisig"
+	paychan "github.com/iov-one/weave/x/paychan"
 	sigs "github.com/iov-one/weave/x/sigs"
+	slashing "github.com/iov-one/weave/x/slashing"
+	staking "github.com/iov-one/weave/x/staking"
 	txfee "github.com/iov-one/weave/x/txfee"
 	validators "github.com/iov-one/weave/x/validators"
 	io "io"
@@ -44,12 +48,13 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // Tx contains the message.
 //
 // When extending Tx, follow the rules:
-// - range 1-50 is reserved for middlewares,
-// - range 51-inf is reserved for different message types,
-// - keep the same numbers for the same message types in both bnsd and other
-//   applications. For example, FeeInfo field is used by both and indexed at
-//   first position. Skip unused fields (leave index unused or comment out for
-//   clarity).
+//   - range 1-50 is reserved for middlewares,
+//   - range 51-inf is reserved for different message types,
+//   - keep the same numbers for the same message types in both bnsd and other
+//     applications. For example, FeeInfo field is used by both and indexed at
+//     first position. Skip unused fields (leave index unused or comment out for
+//     clarity).
+//
 // When there is a gap in message sequence numbers - that most likely means some
 // old fields got deprecated. This is done to maintain binary compatibility.
 type Tx struct {
@@ -114,6 +119,15 @@ type Tx struct {
 	//	*Tx_QualityscoreUpdateConfigurationMsg
 	//	*Tx_PreregistrationUpdateConfigurationMsg
 	//	*Tx_MsgfeeUpdateConfigurationMsg
+	//	*Tx_PaychanCreateMsg
+	//	*Tx_PaychanTransferMsg
+	//	*Tx_PaychanCloseMsg
+	//	*Tx_SlashingUnjailMsg
+	//	*Tx_SlashingUpdateConfigurationMsg
+	//	*Tx_StakingBondMsg
+	//	*Tx_StakingUnbondMsg
+	//	*Tx_StakingUpdateConfigurationMsg
+	//	*Tx_CronCancelTaskMsg
 	Sum isTx_Sum `protobuf_oneof:"sum"`
 }
 
@@ -312,6 +326,33 @@ type Tx_PreregistrationUpdateConfigurationMsg struct {
 type Tx_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type Tx_PaychanCreateMsg struct {
+	PaychanCreateMsg *paychan.CreateMsg `protobuf:"bytes,106,opt,name=paychan_create_msg,json=paychanCreateMsg,proto3,oneof"`
+}
+type Tx_PaychanTransferMsg struct {
+	PaychanTransferMsg *paychan.TransferMsg `protobuf:"bytes,107,opt,name=paychan_transfer_msg,json=paychanTransferMsg,proto3,oneof"`
+}
+type Tx_PaychanCloseMsg struct {
+	PaychanCloseMsg *paychan.CloseMsg `protobuf:"bytes,108,opt,name=paychan_close_msg,json=paychanCloseMsg,proto3,oneof"`
+}
+type Tx_SlashingUnjailMsg struct {
+	SlashingUnjailMsg *slashing.UnjailMsg `protobuf:"bytes,109,opt,name=slashing_unjail_msg,json=slashingUnjailMsg,proto3,oneof"`
+}
+type Tx_SlashingUpdateConfigurationMsg struct {
+	SlashingUpdateConfigurationMsg *slashing.UpdateConfigurationMsg `protobuf:"bytes,110,opt,name=slashing_update_configuration_msg,json=slashingUpdateConfigurationMsg,proto3,oneof"`
+}
+type Tx_StakingBondMsg struct {
+	StakingBondMsg *staking.BondMsg `protobuf:"bytes,111,opt,name=staking_bond_msg,json=stakingBondMsg,proto3,oneof"`
+}
+type Tx_StakingUnbondMsg struct {
+	StakingUnbondMsg *staking.UnbondMsg `protobuf:"bytes,112,opt,name=staking_unbond_msg,json=stakingUnbondMsg,proto3,oneof"`
+}
+type Tx_StakingUpdateConfigurationMsg struct {
+	StakingUpdateConfigurationMsg *staking.UpdateConfigurationMsg `protobuf:"bytes,114,opt,name=staking_update_configuration_msg,json=stakingUpdateConfigurationMsg,proto3,oneof"`
+}
+type Tx_CronCancelTaskMsg struct {
+	CronCancelTaskMsg *cron.CancelTaskMsg `protobuf:"bytes,115,opt,name=cron_cancel_task_msg,json=cronCancelTaskMsg,proto3,oneof"`
+}
 
 func (*Tx_CashSendMsg) isTx_Sum()                           {}
 func (*Tx_EscrowCreateMsg) isTx_Sum()                       {}
@@ -365,6 +406,15 @@ func (*Tx_TermdepositUpdateConfigurationMsg) isTx_Sum()     {}
 func (*Tx_QualityscoreUpdateConfigurationMsg) isTx_Sum()    {}
 func (*Tx_PreregistrationUpdateConfigurationMsg) isTx_Sum() {}
 func (*Tx_MsgfeeUpdateConfigurationMsg) isTx_Sum()          {}
+func (*Tx_PaychanCreateMsg) isTx_Sum()                      {}
+func (*Tx_PaychanTransferMsg) isTx_Sum()                    {}
+func (*Tx_PaychanCloseMsg) isTx_Sum()                       {}
+func (*Tx_SlashingUnjailMsg) isTx_Sum()                     {}
+func (*Tx_SlashingUpdateConfigurationMsg) isTx_Sum()        {}
+func (*Tx_StakingBondMsg) isTx_Sum()                        {}
+func (*Tx_StakingUnbondMsg) isTx_Sum()                      {}
+func (*Tx_StakingUpdateConfigurationMsg) isTx_Sum()         {}
+func (*Tx_CronCancelTaskMsg) isTx_Sum()                     {}
 
 func (m *Tx) GetSum() isTx_Sum {
 	if m != nil {
@@ -758,6 +808,69 @@ func (m *Tx) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfigurationMsg {
 	return nil
 }
 
+func (m *Tx) GetPaychanCreateMsg() *paychan.CreateMsg {
+	if x, ok := m.GetSum().(*Tx_PaychanCreateMsg); ok {
+		return x.PaychanCreateMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetPaychanTransferMsg() *paychan.TransferMsg {
+	if x, ok := m.GetSum().(*Tx_PaychanTransferMsg); ok {
+		return x.PaychanTransferMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetPaychanCloseMsg() *paychan.CloseMsg {
+	if x, ok := m.GetSum().(*Tx_PaychanCloseMsg); ok {
+		return x.PaychanCloseMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetSlashingUnjailMsg() *slashing.UnjailMsg {
+	if x, ok := m.GetSum().(*Tx_SlashingUnjailMsg); ok {
+		return x.SlashingUnjailMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetSlashingUpdateConfigurationMsg() *slashing.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_SlashingUpdateConfigurationMsg); ok {
+		return x.SlashingUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetStakingBondMsg() *staking.BondMsg {
+	if x, ok := m.GetSum().(*Tx_StakingBondMsg); ok {
+		return x.StakingBondMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetStakingUnbondMsg() *staking.UnbondMsg {
+	if x, ok := m.GetSum().(*Tx_StakingUnbondMsg); ok {
+		return x.StakingUnbondMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetStakingUpdateConfigurationMsg() *staking.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_StakingUpdateConfigurationMsg); ok {
+		return x.StakingUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCronCancelTaskMsg() *cron.CancelTaskMsg {
+	if x, ok := m.GetSum().(*Tx_CronCancelTaskMsg); ok {
+		return x.CronCancelTaskMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Tx_OneofMarshaler, _Tx_OneofUnmarshaler, _Tx_OneofSizer, []interface{}{
@@ -813,6 +926,15 @@ func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fun
 		(*Tx_QualityscoreUpdateConfigurationMsg)(nil),
 		(*Tx_PreregistrationUpdateConfigurationMsg)(nil),
 		(*Tx_MsgfeeUpdateConfigurationMsg)(nil),
+		(*Tx_PaychanCreateMsg)(nil),
+		(*Tx_PaychanTransferMsg)(nil),
+		(*Tx_PaychanCloseMsg)(nil),
+		(*Tx_SlashingUnjailMsg)(nil),
+		(*Tx_SlashingUpdateConfigurationMsg)(nil),
+		(*Tx_StakingBondMsg)(nil),
+		(*Tx_StakingUnbondMsg)(nil),
+		(*Tx_StakingUpdateConfigurationMsg)(nil),
+		(*Tx_CronCancelTaskMsg)(nil),
 	}
 }
 
@@ -1080,6 +1202,51 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *Tx_PaychanCreateMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PaychanCreateMsg); err != nil {
+			return err
+		}
+	case *Tx_PaychanTransferMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PaychanTransferMsg); err != nil {
+			return err
+		}
+	case *Tx_PaychanCloseMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PaychanCloseMsg); err != nil {
+			return err
+		}
+	case *Tx_SlashingUnjailMsg:
+		_ = b.EncodeVarint(109<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SlashingUnjailMsg); err != nil {
+			return err
+		}
+	case *Tx_SlashingUpdateConfigurationMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SlashingUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *Tx_StakingBondMsg:
+		_ = b.EncodeVarint(111<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.StakingBondMsg); err != nil {
+			return err
+		}
+	case *Tx_StakingUnbondMsg:
+		_ = b.EncodeVarint(112<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.StakingUnbondMsg); err != nil {
+			return err
+		}
+	case *Tx_StakingUpdateConfigurationMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.StakingUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *Tx_CronCancelTaskMsg:
+		_ = b.EncodeVarint(115<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CronCancelTaskMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Tx.Sum has unexpected type %T", x)
@@ -1506,6 +1673,78 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
+	case 106: // sum.paychan_create_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(paychan.CreateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_PaychanCreateMsg{msg}
+		return true, err
+	case 107: // sum.paychan_transfer_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(paychan.TransferMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_PaychanTransferMsg{msg}
+		return true, err
+	case 108: // sum.paychan_close_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(paychan.CloseMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_PaychanCloseMsg{msg}
+		return true, err
+	case 109: // sum.slashing_unjail_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(slashing.UnjailMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_SlashingUnjailMsg{msg}
+		return true, err
+	case 110: // sum.slashing_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(slashing.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_SlashingUpdateConfigurationMsg{msg}
+		return true, err
+	case 111: // sum.staking_bond_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(staking.BondMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_StakingBondMsg{msg}
+		return true, err
+	case 112: // sum.staking_unbond_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(staking.UnbondMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_StakingUnbondMsg{msg}
+		return true, err
+	case 114: // sum.staking_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(staking.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_StakingUpdateConfigurationMsg{msg}
+		return true, err
+	case 115: // sum.cron_cancel_task_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cron.CancelTaskMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CronCancelTaskMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1775,6 +2014,51 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_PaychanCreateMsg:
+		s := proto.Size(x.PaychanCreateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_PaychanTransferMsg:
+		s := proto.Size(x.PaychanTransferMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_PaychanCloseMsg:
+		s := proto.Size(x.PaychanCloseMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_SlashingUnjailMsg:
+		s := proto.Size(x.SlashingUnjailMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_SlashingUpdateConfigurationMsg:
+		s := proto.Size(x.SlashingUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_StakingBondMsg:
+		s := proto.Size(x.StakingBondMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_StakingUnbondMsg:
+		s := proto.Size(x.StakingUnbondMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_StakingUpdateConfigurationMsg:
+		s := proto.Size(x.StakingUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CronCancelTaskMsg:
+		s := proto.Size(x.CronCancelTaskMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1870,6 +2154,15 @@ type ExecuteBatchMsg_Union struct {
 	//	*ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg
 	//	*ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg
 	//	*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg
+	//	*ExecuteBatchMsg_Union_PaychanCreateMsg
+	//	*ExecuteBatchMsg_Union_PaychanTransferMsg
+	//	*ExecuteBatchMsg_Union_PaychanCloseMsg
+	//	*ExecuteBatchMsg_Union_SlashingUnjailMsg
+	//	*ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg
+	//	*ExecuteBatchMsg_Union_StakingBondMsg
+	//	*ExecuteBatchMsg_Union_StakingUnbondMsg
+	//	*ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg
+	//	*ExecuteBatchMsg_Union_CronCancelTaskMsg
 	Sum isExecuteBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -2035,6 +2328,33 @@ type ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg struct {
 type ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type ExecuteBatchMsg_Union_PaychanCreateMsg struct {
+	PaychanCreateMsg *paychan.CreateMsg `protobuf:"bytes,106,opt,name=paychan_create_msg,json=paychanCreateMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_PaychanTransferMsg struct {
+	PaychanTransferMsg *paychan.TransferMsg `protobuf:"bytes,107,opt,name=paychan_transfer_msg,json=paychanTransferMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_PaychanCloseMsg struct {
+	PaychanCloseMsg *paychan.CloseMsg `protobuf:"bytes,108,opt,name=paychan_close_msg,json=paychanCloseMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_SlashingUnjailMsg struct {
+	SlashingUnjailMsg *slashing.UnjailMsg `protobuf:"bytes,109,opt,name=slashing_unjail_msg,json=slashingUnjailMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg struct {
+	SlashingUpdateConfigurationMsg *slashing.UpdateConfigurationMsg `protobuf:"bytes,110,opt,name=slashing_update_configuration_msg,json=slashingUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_StakingBondMsg struct {
+	StakingBondMsg *staking.BondMsg `protobuf:"bytes,111,opt,name=staking_bond_msg,json=stakingBondMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_StakingUnbondMsg struct {
+	StakingUnbondMsg *staking.UnbondMsg `protobuf:"bytes,112,opt,name=staking_unbond_msg,json=stakingUnbondMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg struct {
+	StakingUpdateConfigurationMsg *staking.UpdateConfigurationMsg `protobuf:"bytes,114,opt,name=staking_update_configuration_msg,json=stakingUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CronCancelTaskMsg struct {
+	CronCancelTaskMsg *cron.CancelTaskMsg `protobuf:"bytes,115,opt,name=cron_cancel_task_msg,json=cronCancelTaskMsg,proto3,oneof"`
+}
 
 func (*ExecuteBatchMsg_Union_CashSendMsg) isExecuteBatchMsg_Union_Sum()                           {}
 func (*ExecuteBatchMsg_Union_EscrowCreateMsg) isExecuteBatchMsg_Union_Sum()                       {}
@@ -2077,6 +2397,15 @@ func (*ExecuteBatchMsg_Union_TermdepositUpdateConfigurationMsg) isExecuteBatchMs
 func (*ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()    {}
 func (*ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum() {}
 func (*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()          {}
+func (*ExecuteBatchMsg_Union_PaychanCreateMsg) isExecuteBatchMsg_Union_Sum()                      {}
+func (*ExecuteBatchMsg_Union_PaychanTransferMsg) isExecuteBatchMsg_Union_Sum()                    {}
+func (*ExecuteBatchMsg_Union_PaychanCloseMsg) isExecuteBatchMsg_Union_Sum()                       {}
+func (*ExecuteBatchMsg_Union_SlashingUnjailMsg) isExecuteBatchMsg_Union_Sum()                     {}
+func (*ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()        {}
+func (*ExecuteBatchMsg_Union_StakingBondMsg) isExecuteBatchMsg_Union_Sum()                        {}
+func (*ExecuteBatchMsg_Union_StakingUnbondMsg) isExecuteBatchMsg_Union_Sum()                      {}
+func (*ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()         {}
+func (*ExecuteBatchMsg_Union_CronCancelTaskMsg) isExecuteBatchMsg_Union_Sum()                     {}
 
 func (m *ExecuteBatchMsg_Union) GetSum() isExecuteBatchMsg_Union_Sum {
 	if m != nil {
@@ -2372,6 +2701,69 @@ func (m *ExecuteBatchMsg_Union) GetMsgfeeUpdateConfigurationMsg() *msgfee.Update
 	return nil
 }
 
+func (m *ExecuteBatchMsg_Union) GetPaychanCreateMsg() *paychan.CreateMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_PaychanCreateMsg); ok {
+		return x.PaychanCreateMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetPaychanTransferMsg() *paychan.TransferMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_PaychanTransferMsg); ok {
+		return x.PaychanTransferMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetPaychanCloseMsg() *paychan.CloseMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_PaychanCloseMsg); ok {
+		return x.PaychanCloseMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetSlashingUnjailMsg() *slashing.UnjailMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_SlashingUnjailMsg); ok {
+		return x.SlashingUnjailMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetSlashingUpdateConfigurationMsg() *slashing.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg); ok {
+		return x.SlashingUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetStakingBondMsg() *staking.BondMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_StakingBondMsg); ok {
+		return x.StakingBondMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetStakingUnbondMsg() *staking.UnbondMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_StakingUnbondMsg); ok {
+		return x.StakingUnbondMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetStakingUpdateConfigurationMsg() *staking.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg); ok {
+		return x.StakingUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCronCancelTaskMsg() *cron.CancelTaskMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CronCancelTaskMsg); ok {
+		return x.CronCancelTaskMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ExecuteBatchMsg_Union_OneofMarshaler, _ExecuteBatchMsg_Union_OneofUnmarshaler, _ExecuteBatchMsg_Union_OneofSizer, []interface{}{
@@ -2416,6 +2808,15 @@ func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto
 		(*ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg)(nil),
 		(*ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg)(nil),
 		(*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ExecuteBatchMsg_Union_PaychanCreateMsg)(nil),
+		(*ExecuteBatchMsg_Union_PaychanTransferMsg)(nil),
+		(*ExecuteBatchMsg_Union_PaychanCloseMsg)(nil),
+		(*ExecuteBatchMsg_Union_SlashingUnjailMsg)(nil),
+		(*ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg)(nil),
+		(*ExecuteBatchMsg_Union_StakingBondMsg)(nil),
+		(*ExecuteBatchMsg_Union_StakingUnbondMsg)(nil),
+		(*ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg)(nil),
+		(*ExecuteBatchMsg_Union_CronCancelTaskMsg)(nil),
 	}
 }
 
@@ -2628,6 +3029,51 @@ func _ExecuteBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.Buffer) e
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *ExecuteBatchMsg_Union_PaychanCreateMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PaychanCreateMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_PaychanTransferMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PaychanTransferMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_PaychanCloseMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PaychanCloseMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_SlashingUnjailMsg:
+		_ = b.EncodeVarint(109<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SlashingUnjailMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SlashingUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_StakingBondMsg:
+		_ = b.EncodeVarint(111<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.StakingBondMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_StakingUnbondMsg:
+		_ = b.EncodeVarint(112<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.StakingUnbondMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.StakingUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CronCancelTaskMsg:
+		_ = b.EncodeVarint(115<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CronCancelTaskMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteBatchMsg_Union.Sum has unexpected type %T", x)
@@ -2966,6 +3412,78 @@ func _ExecuteBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wire int, b
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
+	case 106: // sum.paychan_create_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(paychan.CreateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_PaychanCreateMsg{msg}
+		return true, err
+	case 107: // sum.paychan_transfer_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(paychan.TransferMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_PaychanTransferMsg{msg}
+		return true, err
+	case 108: // sum.paychan_close_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(paychan.CloseMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_PaychanCloseMsg{msg}
+		return true, err
+	case 109: // sum.slashing_unjail_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(slashing.UnjailMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_SlashingUnjailMsg{msg}
+		return true, err
+	case 110: // sum.slashing_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(slashing.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg{msg}
+		return true, err
+	case 111: // sum.staking_bond_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(staking.BondMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_StakingBondMsg{msg}
+		return true, err
+	case 112: // sum.staking_unbond_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(staking.UnbondMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_StakingUnbondMsg{msg}
+		return true, err
+	case 114: // sum.staking_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(staking.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg{msg}
+		return true, err
+	case 115: // sum.cron_cancel_task_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cron.CancelTaskMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CronCancelTaskMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -3180,18 +3698,63 @@ func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-// ProposalOptions are possible items that can be enacted by a governance vote
-// Trimmed down somewhat arbitrary to what is believed to be reasonable
-type ProposalOptions struct {
-	// Types that are valid to be assigned to Option:
-	//	*ProposalOptions_CashSendMsg
+	case *ExecuteBatchMsg_Union_PaychanCreateMsg:
+		s := proto.Size(x.PaychanCreateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_PaychanTransferMsg:
+		s := proto.Size(x.PaychanTransferMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_PaychanCloseMsg:
+		s := proto.Size(x.PaychanCloseMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_SlashingUnjailMsg:
+		s := proto.Size(x.SlashingUnjailMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg:
+		s := proto.Size(x.SlashingUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_StakingBondMsg:
+		s := proto.Size(x.StakingBondMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_StakingUnbondMsg:
+		s := proto.Size(x.StakingUnbondMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg:
+		s := proto.Size(x.StakingUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CronCancelTaskMsg:
+		s := proto.Size(x.CronCancelTaskMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// ProposalOptions are possible items that can be enacted by a governance vote
+// Trimmed down somewhat arbitrary to what is believed to be reasonable
+type ProposalOptions struct {
+	// Types that are valid to be assigned to Option:
+	//	*ProposalOptions_CashSendMsg
 	//	*ProposalOptions_EscrowReleaseMsg
 	//	*ProposalOptions_UpdateEscrowPartiesMsg
 	//	*ProposalOptions_MultisigUpdateMsg
@@ -3234,6 +3797,8 @@ type ProposalOptions struct {
 	//	*ProposalOptions_QualityscoreUpdateConfigurationMsg
 	//	*ProposalOptions_PreregistrationUpdateConfigurationMsg
 	//	*ProposalOptions_MsgfeeUpdateConfigurationMsg
+	//	*ProposalOptions_SlashingUpdateConfigurationMsg
+	//	*ProposalOptions_StakingUpdateConfigurationMsg
 	Option isProposalOptions_Option `protobuf_oneof:"option"`
 }
 
@@ -3405,6 +3970,12 @@ type ProposalOptions_PreregistrationUpdateConfigurationMsg struct {
 type ProposalOptions_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type ProposalOptions_SlashingUpdateConfigurationMsg struct {
+	SlashingUpdateConfigurationMsg *slashing.UpdateConfigurationMsg `protobuf:"bytes,110,opt,name=slashing_update_configuration_msg,json=slashingUpdateConfigurationMsg,proto3,oneof"`
+}
+type ProposalOptions_StakingUpdateConfigurationMsg struct {
+	StakingUpdateConfigurationMsg *staking.UpdateConfigurationMsg `protobuf:"bytes,114,opt,name=staking_update_configuration_msg,json=stakingUpdateConfigurationMsg,proto3,oneof"`
+}
 
 func (*ProposalOptions_CashSendMsg) isProposalOptions_Option()                           {}
 func (*ProposalOptions_EscrowReleaseMsg) isProposalOptions_Option()                      {}
@@ -3449,6 +4020,8 @@ func (*ProposalOptions_TermdepositUpdateConfigurationMsg) isProposalOptions_Opti
 func (*ProposalOptions_QualityscoreUpdateConfigurationMsg) isProposalOptions_Option()    {}
 func (*ProposalOptions_PreregistrationUpdateConfigurationMsg) isProposalOptions_Option() {}
 func (*ProposalOptions_MsgfeeUpdateConfigurationMsg) isProposalOptions_Option()          {}
+func (*ProposalOptions_SlashingUpdateConfigurationMsg) isProposalOptions_Option()        {}
+func (*ProposalOptions_StakingUpdateConfigurationMsg) isProposalOptions_Option()         {}
 
 func (m *ProposalOptions) GetOption() isProposalOptions_Option {
 	if m != nil {
@@ -3758,6 +4331,20 @@ func (m *ProposalOptions) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfig
 	return nil
 }
 
+func (m *ProposalOptions) GetSlashingUpdateConfigurationMsg() *slashing.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_SlashingUpdateConfigurationMsg); ok {
+		return x.SlashingUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetStakingUpdateConfigurationMsg() *staking.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_StakingUpdateConfigurationMsg); ok {
+		return x.StakingUpdateConfigurationMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ProposalOptions_OneofMarshaler, _ProposalOptions_OneofUnmarshaler, _ProposalOptions_OneofSizer, []interface{}{
@@ -3804,6 +4391,8 @@ func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffe
 		(*ProposalOptions_QualityscoreUpdateConfigurationMsg)(nil),
 		(*ProposalOptions_PreregistrationUpdateConfigurationMsg)(nil),
 		(*ProposalOptions_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ProposalOptions_SlashingUpdateConfigurationMsg)(nil),
+		(*ProposalOptions_StakingUpdateConfigurationMsg)(nil),
 	}
 }
 
@@ -4026,6 +4615,16 @@ func _ProposalOptions_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *ProposalOptions_SlashingUpdateConfigurationMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SlashingUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_StakingUpdateConfigurationMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.StakingUpdateConfigurationMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ProposalOptions.Option has unexpected type %T", x)
@@ -4380,6 +4979,22 @@ func _ProposalOptions_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.Option = &ProposalOptions_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
+	case 110: // option.slashing_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(slashing.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_SlashingUpdateConfigurationMsg{msg}
+		return true, err
+	case 114: // option.staking_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(staking.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_StakingUpdateConfigurationMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -4604,6 +5219,16 @@ func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ProposalOptions_SlashingUpdateConfigurationMsg:
+		s := proto.Size(x.SlashingUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_StakingUpdateConfigurationMsg:
+		s := proto.Size(x.StakingUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -4697,6 +5322,8 @@ type ExecuteProposalBatchMsg_Union struct {
 	//	*ExecuteProposalBatchMsg_Union_QualityscoreUpdateConfigurationMsg
 	//	*ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg
 	//	*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg
+	//	*ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg
+	//	*ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg
 	Sum isExecuteProposalBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -4859,23 +5486,32 @@ type ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg struct
 type ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg struct {
 	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,105,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
 }
+type ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg struct {
+	SlashingUpdateConfigurationMsg *slashing.UpdateConfigurationMsg `protobuf:"bytes,110,opt,name=slashing_update_configuration_msg,json=slashingUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg struct {
+	StakingUpdateConfigurationMsg *staking.UpdateConfigurationMsg `protobuf:"bytes,114,opt,name=staking_update_configuration_msg,json=stakingUpdateConfigurationMsg,proto3,oneof"`
+}
 
-func (*ExecuteProposalBatchMsg_Union_SendMsg) isExecuteProposalBatchMsg_Union_Sum()                  {}
-func (*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) isExecuteProposalBatchMsg_Union_Sum()         {}
-func (*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) isExecuteProposalBatchMsg_Union_Sum()        {}
-func (*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {}
-func (*ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_SendMsg) isExecuteProposalBatchMsg_Union_Sum()                {}
+func (*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) isExecuteProposalBatchMsg_Union_Sum()       {}
+func (*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) isExecuteProposalBatchMsg_Union_Sum()      {}
+func (*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 func (*ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
 func (*ExecuteProposalBatchMsg_Union_UsernameUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
-func (*ExecuteProposalBatchMsg_Union_DistributionCreateMsg) isExecuteProposalBatchMsg_Union_Sum()    {}
-func (*ExecuteProposalBatchMsg_Union_DistributionMsg) isExecuteProposalBatchMsg_Union_Sum()          {}
-func (*ExecuteProposalBatchMsg_Union_DistributionResetMsg) isExecuteProposalBatchMsg_Union_Sum()     {}
-func (*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_DistributionCreateMsg) isExecuteProposalBatchMsg_Union_Sum()  {}
+func (*ExecuteProposalBatchMsg_Union_DistributionMsg) isExecuteProposalBatchMsg_Union_Sum()        {}
+func (*ExecuteProposalBatchMsg_Union_DistributionResetMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 func (*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
 func (*ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) isExecuteProposalBatchMsg_Union_Sum() {}
@@ -4883,12 +5519,14 @@ func (*ExecuteProposalBatchMsg_Union_DatamigrationExecuteMigrationMsg) isExecute
 }
 func (*ExecuteProposalBatchMsg_Union_AccountUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
-func (*ExecuteProposalBatchMsg_Union_AccountRegisterDomainMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_AccountRegisterDomainMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 func (*ExecuteProposalBatchMsg_Union_AccountReplaceAccountMsgFeesMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
-func (*ExecuteProposalBatchMsg_Union_AccountTransferDomainMsg) isExecuteProposalBatchMsg_Union_Sum() {}
-func (*ExecuteProposalBatchMsg_Union_AccountRenewDomainMsg) isExecuteProposalBatchMsg_Union_Sum()    {}
-func (*ExecuteProposalBatchMsg_Union_AccountDeleteDomainMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
+func (*ExecuteProposalBatchMsg_Union_AccountTransferDomainMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_AccountRenewDomainMsg) isExecuteProposalBatchMsg_Union_Sum()  {}
+func (*ExecuteProposalBatchMsg_Union_AccountDeleteDomainMsg) isExecuteProposalBatchMsg_Union_Sum() {}
 func (*ExecuteProposalBatchMsg_Union_AccountRegisterAccountMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
 func (*ExecuteProposalBatchMsg_Union_AccountTransferAccountMsg) isExecuteProposalBatchMsg_Union_Sum() {
@@ -4919,6 +5557,10 @@ func (*ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg) isEx
 }
 func (*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
+func (*ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 
 func (m *ExecuteProposalBatchMsg_Union) GetSum() isExecuteProposalBatchMsg_Union_Sum {
 	if m != nil {
@@ -5207,6 +5849,20 @@ func (m *ExecuteProposalBatchMsg_Union) GetMsgfeeUpdateConfigurationMsg() *msgfe
 	return nil
 }
 
+func (m *ExecuteProposalBatchMsg_Union) GetSlashingUpdateConfigurationMsg() *slashing.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg); ok {
+		return x.SlashingUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetStakingUpdateConfigurationMsg() *staking.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg); ok {
+		return x.StakingUpdateConfigurationMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ExecuteProposalBatchMsg_Union_OneofMarshaler, _ExecuteProposalBatchMsg_Union_OneofUnmarshaler, _ExecuteProposalBatchMsg_Union_OneofSizer, []interface{}{
@@ -5250,6 +5906,8 @@ func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message,
 		(*ExecuteProposalBatchMsg_Union_QualityscoreUpdateConfigurationMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg)(nil),
 	}
 }
 
@@ -5457,6 +6115,16 @@ func _ExecuteProposalBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.B
 		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
 			return err
 		}
+	case *ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SlashingUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.StakingUpdateConfigurationMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteProposalBatchMsg_Union.Sum has unexpected type %T", x)
@@ -5787,6 +6455,22 @@ func _ExecuteProposalBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wir
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg{msg}
 		return true, err
+	case 110: // sum.slashing_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(slashing.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg{msg}
+		return true, err
+	case 114: // sum.staking_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(staking.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -5996,6 +6680,16 @@ func _ExecuteProposalBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg:
+		s := proto.Size(x.SlashingUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg:
+		s := proto.Size(x.StakingUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -6024,7 +6718,9 @@ type CronTask struct {
 	//	*CronTask_EscrowReturnMsg
 	//	*CronTask_DistributionDistributeMsg
 	//	*CronTask_AswapReleaseMsg
+	//	*CronTask_AswapReturnMsg
 	//	*CronTask_GovTallyMsg
+	//	*CronTask_StakingReleaseUnbondingMsg
 	Sum isCronTask_Sum `protobuf_oneof:"sum"`
 }
 
@@ -6079,15 +6775,23 @@ type CronTask_DistributionDistributeMsg struct {
 type CronTask_AswapReleaseMsg struct {
 	AswapReleaseMsg *aswap.ReleaseMsg `protobuf:"bytes,71,opt,name=aswap_release_msg,json=aswapReleaseMsg,proto3,oneof"`
 }
+type CronTask_AswapReturnMsg struct {
+	AswapReturnMsg *aswap.ReturnMsg `protobuf:"bytes,72,opt,name=aswap_return_msg,json=aswapReturnMsg,proto3,oneof"`
+}
 type CronTask_GovTallyMsg struct {
 	GovTallyMsg *gov.TallyMsg `protobuf:"bytes,76,opt,name=gov_tally_msg,json=govTallyMsg,proto3,oneof"`
 }
+type CronTask_StakingReleaseUnbondingMsg struct {
+	StakingReleaseUnbondingMsg *staking.ReleaseUnbondingMsg `protobuf:"bytes,113,opt,name=staking_release_unbonding_msg,json=stakingReleaseUnbondingMsg,proto3,oneof"`
+}
 
-func (*CronTask_EscrowReleaseMsg) isCronTask_Sum()          {}
-func (*CronTask_EscrowReturnMsg) isCronTask_Sum()           {}
-func (*CronTask_DistributionDistributeMsg) isCronTask_Sum() {}
-func (*CronTask_AswapReleaseMsg) isCronTask_Sum()           {}
-func (*CronTask_GovTallyMsg) isCronTask_Sum()               {}
+func (*CronTask_EscrowReleaseMsg) isCronTask_Sum()           {}
+func (*CronTask_EscrowReturnMsg) isCronTask_Sum()            {}
+func (*CronTask_DistributionDistributeMsg) isCronTask_Sum()  {}
+func (*CronTask_AswapReleaseMsg) isCronTask_Sum()            {}
+func (*CronTask_AswapReturnMsg) isCronTask_Sum()             {}
+func (*CronTask_GovTallyMsg) isCronTask_Sum()                {}
+func (*CronTask_StakingReleaseUnbondingMsg) isCronTask_Sum() {}
 
 func (m *CronTask) GetSum() isCronTask_Sum {
 	if m != nil {
@@ -6131,6 +6835,13 @@ func (m *CronTask) GetAswapReleaseMsg() *aswap.ReleaseMsg {
 	return nil
 }
 
+func (m *CronTask) GetAswapReturnMsg() *aswap.ReturnMsg {
+	if x, ok := m.GetSum().(*CronTask_AswapReturnMsg); ok {
+		return x.AswapReturnMsg
+	}
+	return nil
+}
+
 func (m *CronTask) GetGovTallyMsg() *gov.TallyMsg {
 	if x, ok := m.GetSum().(*CronTask_GovTallyMsg); ok {
 		return x.GovTallyMsg
@@ -6138,6 +6849,13 @@ func (m *CronTask) GetGovTallyMsg() *gov.TallyMsg {
 	return nil
 }
 
+func (m *CronTask) GetStakingReleaseUnbondingMsg() *staking.ReleaseUnbondingMsg {
+	if x, ok := m.GetSum().(*CronTask_StakingReleaseUnbondingMsg); ok {
+		return x.StakingReleaseUnbondingMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _CronTask_OneofMarshaler, _CronTask_OneofUnmarshaler, _CronTask_OneofSizer, []interface{}{
@@ -6145,7 +6863,9 @@ func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) erro
 		(*CronTask_EscrowReturnMsg)(nil),
 		(*CronTask_DistributionDistributeMsg)(nil),
 		(*CronTask_AswapReleaseMsg)(nil),
+		(*CronTask_AswapReturnMsg)(nil),
 		(*CronTask_GovTallyMsg)(nil),
+		(*CronTask_StakingReleaseUnbondingMsg)(nil),
 	}
 }
 
@@ -6173,11 +6893,21 @@ func _CronTask_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.AswapReleaseMsg); err != nil {
 			return err
 		}
+	case *CronTask_AswapReturnMsg:
+		_ = b.EncodeVarint(72<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AswapReturnMsg); err != nil {
+			return err
+		}
 	case *CronTask_GovTallyMsg:
 		_ = b.EncodeVarint(76<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.GovTallyMsg); err != nil {
 			return err
 		}
+	case *CronTask_StakingReleaseUnbondingMsg:
+		_ = b.EncodeVarint(113<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.StakingReleaseUnbondingMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("CronTask.Sum has unexpected type %T", x)
@@ -6220,6 +6950,14 @@ func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Sum = &CronTask_AswapReleaseMsg{msg}
 		return true, err
+	case 72: // sum.aswap_return_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(aswap.ReturnMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_AswapReturnMsg{msg}
+		return true, err
 	case 76: // sum.gov_tally_msg
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -6228,6 +6966,14 @@ func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Sum = &CronTask_GovTallyMsg{msg}
 		return true, err
+	case 113: // sum.staking_release_unbonding_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(staking.ReleaseUnbondingMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_StakingReleaseUnbondingMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -6257,11 +7003,21 @@ func _CronTask_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *CronTask_AswapReturnMsg:
+		s := proto.Size(x.AswapReturnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *CronTask_GovTallyMsg:
 		s := proto.Size(x.GovTallyMsg)
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *CronTask_StakingReleaseUnbondingMsg:
+		s := proto.Size(x.StakingReleaseUnbondingMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -6282,137 +7038,155 @@ func init() {
 func init() { proto.RegisterFile("cmd/bnsd/app/codec.proto", fileDescriptor_a8efb1d2ea3c411d) }
 
 var fileDescriptor_a8efb1d2ea3c411d = []byte{
-	// 2079 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xdb, 0x72, 0x1c, 0x47,
-	0x19, 0x96, 0x22, 0x27, 0xa8, 0xda, 0x47, 0xb5, 0x6d, 0x69, 0xb5, 0x92, 0x56, 0xb2, 0x64, 0x3b,
-	0x2e, 0xaa, 0x98, 0xa5, 0x6c, 0xce, 0x24, 0x18, 0x6b, 0x25, 0xe3, 0x04, 0x7c, 0xc8, 0x4a, 0x0a,
-	0x01, 0x3b, 0xd9, 0xb4, 0x66, 0x7a, 0x47, 0x83, 0x77, 0xa7, 0x37, 0x73, 0x58, 0xad, 0xa8, 0xe2,
-	0x86, 0xe2, 0x01, 0x78, 0x05, 0x9e, 0x03, 0x1e, 0x20, 0x97, 0xb9, 0xe4, 0xca, 0x45, 0xd9, 0xaf,
-	0xc0, 0x15, 0x57, 0x54, 0x9f, 0x66, 0xfe, 0xee, 0x9d, 0xb1, 0x81, 0x50, 0x65, 0x48, 0xfa, 0xca,
-	0x3b, 0xfd, 0x7d, 0xf3, 0x7d, 0x7d, 0xfc, 0xa7, 0xff, 0x9e, 0xb1, 0x50, 0xc3, 0x1f, 0x06, 0xed,
-	0xc3, 0x38, 0x0d, 0xda, 0x64, 0x34, 0x6a, 0xfb, 0x2c, 0xa0, 0xbe, 0x37, 0x4a, 0x58, 0xc6, 0xf0,
-	0x29, 0x5e, 0xda, 0x6c, 0x15, 0xf8, 0xa4, 0x4d, 0x7c, 0x9f, 0xe5, 0x71, 0x06, 0x59, 0xcd, 0xeb,
-	0x00, 0x1f, 0x25, 0x34, 0xa1, 0x61, 0x94, 0x66, 0x09, 0xc9, 0x22, 0x16, 0x1b, 0xbc, 0x2d, 0xc0,
-	0xfb, 0x2c, 0x27, 0x83, 0x28, 0x3b, 0x49, 0x7d, 0x96, 0x50, 0x83, 0xb4, 0x09, 0x48, 0x19, 0x4d,
-	0x86, 0x01, 0x1d, 0xb1, 0x34, 0x32, 0x0d, 0xd7, 0x01, 0x27, 0x4f, 0x69, 0x12, 0x93, 0xa1, 0x29,
-	0xb2, 0x1c, 0x90, 0x8c, 0x0c, 0xa3, 0xb0, 0xa2, 0x12, 0x97, 0x42, 0x16, 0x32, 0xf1, 0xb3, 0xcd,
-	0x7f, 0xa9, 0xd2, 0xcb, 0xd5, 0xe4, 0x8b, 0x93, 0x36, 0x49, 0x8f, 0x89, 0xd1, 0x29, 0x4d, 0x3c,
-	0x69, 0xfb, 0x24, 0x3d, 0x32, 0xca, 0x16, 0x27, 0x6d, 0x3f, 0x4f, 0x12, 0x1a, 0xfb, 0x27, 0x46,
-	0x79, 0x73, 0xd2, 0x0e, 0x78, 0x67, 0x44, 0x87, 0xf9, 0x74, 0x4d, 0x26, 0x6d, 0x9a, 0xfa, 0x09,
-	0x3b, 0x36, 0x4a, 0x17, 0x26, 0xed, 0x90, 0x8d, 0x6d, 0xe2, 0x30, 0x0d, 0xfb, 0x94, 0xda, 0x96,
-	0xc3, 0x7c, 0x90, 0x45, 0x69, 0x14, 0xda, 0xd5, 0x4b, 0xa3, 0x30, 0xb5, 0xdb, 0x91, 0x4d, 0x6c,
-	0x81, 0xc6, 0xa4, 0x3d, 0x26, 0x83, 0x28, 0x20, 0x19, 0x4b, 0x0c, 0xfa, 0xe6, 0xb3, 0x6b, 0xe8,
-	0x8d, 0xfd, 0x09, 0xbe, 0x82, 0x4e, 0xf5, 0x29, 0x4d, 0x1b, 0xb3, 0x1b, 0xb3, 0x37, 0x4e, 0xdf,
-	0x3c, 0xeb, 0xf1, 0x56, 0x7b, 0x77, 0x29, 0x7d, 0x2f, 0xee, 0xb3, 0xae, 0x80, 0xf0, 0x4d, 0x84,
-	0xd2, 0x28, 0x8c, 0x49, 0x96, 0x27, 0x34, 0x6d, 0xbc, 0xb1, 0x31, 0x77, 0xe3, 0xf4, 0x4d, 0xec,
-	0x71, 0x7f, 0x6f, 0x2f, 0x0b, 0xf6, 0x34, 0xd4, 0x05, 0x2c, 0xdc, 0x44, 0xf3, 0xba, 0xe2, 0x8d,
-	0x53, 0x1b, 0x73, 0x37, 0xce, 0x74, 0x8b, 0x6b, 0x7c, 0x0b, 0x9d, 0xe5, 0x2e, 0xbd, 0x94, 0xc6,
-	0x41, 0x6f, 0x98, 0x86, 0x8d, 0x5b, 0xd0, 0x7b, 0x8f, 0xc6, 0xc1, 0xfd, 0x34, 0xbc, 0x37, 0xd3,
-	0x3d, 0xcd, 0xaf, 0xd5, 0x25, 0xbe, 0x8d, 0x16, 0x64, 0x47, 0xf6, 0xfc, 0x84, 0x92, 0x8c, 0x8a,
-	0x1b, 0xbf, 0x23, 0x6e, 0x5c, 0xf0, 0x24, 0xe2, 0x75, 0x04, 0x22, 0x6f, 0x3e, 0x2f, 0xcb, 0x8a,
-	0x22, 0xbc, 0x8d, 0xb0, 0x12, 0x48, 0xe8, 0x80, 0x92, 0x54, 0x2a, 0x7c, 0x57, 0x28, 0x60, 0xad,
-	0xd0, 0x95, 0x90, 0x94, 0xb8, 0x20, 0x0b, 0xcb, 0x32, 0x50, 0x89, 0x84, 0x66, 0x79, 0x12, 0x0b,
-	0x89, 0xef, 0x99, 0x95, 0xe8, 0x0a, 0xc4, 0xa8, 0x44, 0x51, 0x84, 0x0f, 0xd0, 0xb2, 0x12, 0xc8,
-	0x47, 0x01, 0x6f, 0xc5, 0x88, 0x24, 0x59, 0x44, 0x53, 0x21, 0xf4, 0x7d, 0x21, 0xd4, 0xd0, 0x42,
-	0x07, 0x82, 0xf1, 0x48, 0x12, 0xa4, 0xde, 0xa2, 0x84, 0x6c, 0x04, 0xef, 0xa2, 0x8b, 0xba, 0x77,
-	0x61, 0xf7, 0xfc, 0x40, 0x08, 0x5e, 0xf4, 0x34, 0x66, 0x74, 0xd0, 0x82, 0x2e, 0x2d, 0xbb, 0x08,
-	0xca, 0xa8, 0xfa, 0x71, 0x99, 0x1f, 0xda, 0x32, 0xd2, 0xdf, 0x92, 0x29, 0x0a, 0x79, 0x23, 0xcb,
-	0x39, 0xd7, 0x23, 0xa3, 0xd1, 0xe0, 0xa4, 0x17, 0x44, 0xfd, 0xbe, 0x10, 0xfb, 0x91, 0x6a, 0x64,
-	0xc9, 0xf0, 0xee, 0x70, 0xc6, 0x4e, 0xd4, 0xef, 0xab, 0x46, 0x96, 0x10, 0x44, 0x78, 0xed, 0xf4,
-	0xf2, 0x83, 0x8d, 0xfc, 0xb1, 0xaa, 0x9d, 0xc6, 0xcc, 0x46, 0xea, 0xd2, 0xb2, 0x91, 0x1d, 0xb4,
-	0x40, 0x27, 0xd4, 0xcf, 0x33, 0xda, 0x3b, 0x24, 0x99, 0x7f, 0x24, 0x44, 0xde, 0x11, 0x22, 0x97,
-	0x3d, 0x1e, 0x6f, 0xbc, 0x5d, 0x09, 0x6f, 0x73, 0x54, 0x8f, 0xa3, 0x59, 0x84, 0x1f, 0xa3, 0x15,
-	0x1d, 0x93, 0x7a, 0x32, 0x14, 0xd2, 0xa4, 0x97, 0xb1, 0xa7, 0x54, 0x4e, 0x89, 0x77, 0x85, 0x5c,
-	0xd3, 0xd3, 0x1c, 0xaf, 0xab, 0x38, 0xfb, 0x9c, 0x22, 0x35, 0x1b, 0x1a, 0xb4, 0x31, 0x43, 0x3c,
-	0x4b, 0x48, 0x9c, 0xf6, 0x0d, 0xf1, 0x9f, 0xd8, 0xe2, 0xfb, 0x8a, 0x53, 0x25, 0x6e, 0x63, 0xf8,
-	0x29, 0xba, 0x52, 0x88, 0xfb, 0x47, 0x24, 0x0e, 0xa9, 0x92, 0xce, 0x48, 0x12, 0xd2, 0x4c, 0xce,
-	0xc4, 0xdb, 0xc2, 0x62, 0xbd, 0xb4, 0xe8, 0x08, 0xa6, 0x10, 0xd9, 0x97, 0x3c, 0xe9, 0xb3, 0xa6,
-	0x19, 0x95, 0x04, 0x3c, 0x04, 0x66, 0x6a, 0x42, 0xf9, 0x2c, 0xee, 0x47, 0x61, 0x2e, 0xe3, 0xb0,
-	0x30, 0xfb, 0xa9, 0x30, 0xdb, 0x28, 0xcd, 0xe4, 0x4c, 0xea, 0x40, 0xa2, 0x74, 0x6b, 0x69, 0x4a,
-	0x35, 0x03, 0x7f, 0x80, 0x96, 0x60, 0x20, 0x86, 0xb3, 0x64, 0x5b, 0x98, 0x2c, 0x79, 0x10, 0x37,
-	0x66, 0xca, 0x65, 0x88, 0x94, 0xb3, 0xe5, 0x1e, 0xba, 0x60, 0x48, 0x72, 0xad, 0x8e, 0xd0, 0x5a,
-	0x31, 0xb5, 0x76, 0xf4, 0x85, 0x8e, 0x3f, 0x10, 0xe5, 0x4a, 0x0f, 0xd0, 0xa2, 0xa1, 0x94, 0xd0,
-	0x94, 0x66, 0x42, 0x6f, 0x47, 0xe8, 0x2d, 0x9a, 0x7a, 0x5d, 0x0e, 0x4b, 0xa9, 0x4b, 0x10, 0xd0,
-	0xe5, 0xf8, 0x13, 0xb4, 0x5a, 0x3c, 0xcf, 0x7a, 0xf9, 0x28, 0x4c, 0x48, 0x40, 0x7b, 0xa9, 0x7f,
-	0x44, 0x87, 0x44, 0xa8, 0xee, 0xaa, 0x5a, 0x16, 0x24, 0xef, 0x40, 0x92, 0xf6, 0x04, 0x47, 0x4a,
-	0x2f, 0x17, 0xa8, 0x0d, 0xe2, 0x77, 0xd0, 0x05, 0xf1, 0x58, 0x84, 0xbd, 0x78, 0x57, 0x68, 0x5e,
-	0xf0, 0x04, 0x60, 0x74, 0xdf, 0x39, 0x51, 0x54, 0xf6, 0xdb, 0x6d, 0xb4, 0x20, 0xef, 0x86, 0xc1,
-	0xf6, 0x67, 0x2a, 0x52, 0xca, 0xdb, 0x8d, 0x58, 0x7b, 0x5e, 0x94, 0x81, 0x50, 0x5b, 0xd8, 0x83,
-	0x48, 0x7b, 0xcf, 0xb0, 0x87, 0x81, 0xf6, 0x9c, 0xba, 0x5d, 0xc7, 0xd9, 0x87, 0x68, 0x29, 0x64,
-	0x63, 0x5d, 0xf5, 0x51, 0xc2, 0x46, 0x2c, 0x25, 0x03, 0x21, 0xf2, 0x9e, 0xea, 0xed, 0x90, 0x8d,
-	0x55, 0x0b, 0x1e, 0x29, 0x58, 0xf5, 0x76, 0xc8, 0xc6, 0x53, 0xe5, 0x5a, 0x30, 0xa0, 0x03, 0x6a,
-	0x0b, 0xbe, 0x0f, 0x04, 0x77, 0x04, 0x3e, 0x2d, 0x38, 0x55, 0x8e, 0xbf, 0x8d, 0xce, 0x70, 0xc1,
-	0x31, 0x53, 0x5d, 0xfb, 0x73, 0xa1, 0x72, 0x46, 0xa8, 0x7c, 0xc8, 0x74, 0xb7, 0xa2, 0x90, 0x8d,
-	0xd5, 0x15, 0x0f, 0xab, 0xfc, 0x0e, 0xb5, 0x8e, 0xe8, 0x80, 0xfa, 0x19, 0x4b, 0xf4, 0xc8, 0xdc,
-	0x57, 0x61, 0x95, 0xdf, 0x2e, 0x57, 0xc7, 0x6e, 0x41, 0x50, 0x61, 0x35, 0x64, 0xe3, 0x0a, 0x04,
-	0x3f, 0x41, 0xab, 0xb6, 0xac, 0x98, 0x9e, 0xf9, 0x40, 0x2a, 0x3f, 0x50, 0xe1, 0xc6, 0x52, 0xe6,
-	0x53, 0x31, 0x1f, 0x28, 0xed, 0x86, 0xa9, 0x5d, 0x62, 0xf8, 0x7d, 0xb4, 0x28, 0xb7, 0x35, 0x3d,
-	0x35, 0xdb, 0x7b, 0xfc, 0x37, 0xd7, 0x7d, 0x24, 0x74, 0x2f, 0x79, 0x12, 0xf6, 0xf6, 0xc4, 0xac,
-	0xbe, 0x4b, 0x95, 0x22, 0x96, 0xc5, 0xb0, 0x14, 0xa7, 0x68, 0xcb, 0xd8, 0xf2, 0xf5, 0x74, 0x1c,
-	0x2f, 0x4b, 0xb8, 0xf0, 0x07, 0x42, 0x78, 0xd3, 0x33, 0xb8, 0x3a, 0xa8, 0xdf, 0xd7, 0x05, 0xd2,
-	0x66, 0xc3, 0x20, 0x55, 0x70, 0xf0, 0x6f, 0xd0, 0x86, 0xda, 0x0e, 0xd7, 0x47, 0xb0, 0xae, 0x0a,
-	0x97, 0x8a, 0x58, 0x1f, 0xc0, 0xd6, 0x14, 0xa3, 0x26, 0x7e, 0x3d, 0x46, 0x2b, 0xda, 0xab, 0x78,
-	0xa8, 0x04, 0x6c, 0x48, 0x22, 0x69, 0xb3, 0xa7, 0x46, 0x42, 0xdb, 0xe8, 0x07, 0xc7, 0x8e, 0xa0,
-	0xa8, 0x91, 0x50, 0xe0, 0x14, 0x86, 0x13, 0x74, 0xb5, 0x14, 0x1f, 0x0d, 0x88, 0x4f, 0x7b, 0xfa,
-	0x5a, 0x0d, 0x8b, 0x8c, 0xfd, 0xfb, 0xc2, 0xe5, 0x0a, 0x70, 0x11, 0xe4, 0x3b, 0xf2, 0x52, 0x8e,
-	0x86, 0x8a, 0xfe, 0xeb, 0x85, 0x59, 0x35, 0x05, 0x36, 0xa8, 0x78, 0x90, 0x81, 0x06, 0x1d, 0x58,
-	0x0d, 0xd2, 0x0f, 0xab, 0xaa, 0x06, 0x4d, 0x61, 0xb8, 0x8b, 0x1a, 0x65, 0x83, 0x62, 0x7a, 0x0c,
-	0x95, 0x3f, 0x54, 0xe1, 0xbe, 0x6c, 0x44, 0x4c, 0x8f, 0xa1, 0xec, 0xe5, 0xa2, 0xea, 0x10, 0xe0,
-	0x6b, 0x4c, 0x6b, 0xaa, 0xa5, 0x0e, 0x44, 0x7f, 0xa9, 0xd6, 0x98, 0x16, 0x95, 0x8b, 0x1a, 0xaa,
-	0x2e, 0x2a, 0xc8, 0x42, 0x78, 0xac, 0x9e, 0x1a, 0x58, 0xd0, 0xf9, 0x8d, 0x8f, 0x54, 0xac, 0xb6,
-	0x47, 0xb6, 0xec, 0x51, 0x1e, 0xab, 0xad, 0xa1, 0x2d, 0x41, 0xa8, 0x5f, 0xf4, 0x33, 0xd4, 0xff,
-	0x95, 0xa5, 0xaf, 0x3b, 0xb3, 0x52, 0x7f, 0x1a, 0xc4, 0x9f, 0xa1, 0xad, 0xba, 0xb9, 0x03, 0xb7,
-	0x0d, 0xbf, 0x7e, 0xe9, 0xd4, 0x31, 0x36, 0x0e, 0xd5, 0x53, 0x07, 0x6c, 0x1d, 0x3e, 0x42, 0x4d,
-	0x6b, 0x24, 0x60, 0x83, 0x1e, 0x0b, 0xa7, 0x65, 0x6b, 0x28, 0x8c, 0xe6, 0x2c, 0x19, 0x63, 0x01,
-	0x1a, 0x03, 0xe6, 0x4d, 0x7f, 0x90, 0xa7, 0x47, 0x70, 0x88, 0x9f, 0x58, 0xf3, 0xe6, 0x2e, 0x27,
-	0x54, 0xcd, 0x1b, 0x13, 0x80, 0xf3, 0x46, 0xce, 0x45, 0x58, 0xd9, 0x8f, 0xad, 0x79, 0x23, 0xe6,
-	0x9c, 0x51, 0xd7, 0x45, 0x38, 0x1b, 0xab, 0xfb, 0x9d, 0x04, 0x41, 0x21, 0xea, 0xd3, 0x24, 0x8b,
-	0xfa, 0x91, 0xaf, 0x83, 0xff, 0x27, 0x56, 0xbf, 0xdf, 0x09, 0x02, 0x25, 0xd2, 0x29, 0x99, 0x66,
-	0xbf, 0xd7, 0x51, 0xf0, 0x6f, 0xd1, 0xf5, 0x9a, 0x7e, 0xb7, 0x5d, 0x7b, 0xc2, 0xf5, 0x6a, 0xf5,
-	0x18, 0x4c, 0x19, 0x6f, 0x56, 0x0d, 0x87, 0xe5, 0xfd, 0x29, 0x5a, 0xb5, 0x8e, 0x16, 0xca, 0xe5,
-	0xc2, 0x1d, 0x3f, 0x15, 0x8e, 0xab, 0x9e, 0x45, 0x2a, 0x96, 0x8b, 0x74, 0x6a, 0x5a, 0x30, 0x40,
-	0x31, 0x41, 0x6b, 0x22, 0xf5, 0xac, 0x0d, 0xe5, 0x44, 0x59, 0x88, 0x54, 0xb4, 0x36, 0x8e, 0x37,
-	0x39, 0x5c, 0x13, 0xc4, 0x03, 0xd4, 0x12, 0x69, 0x78, 0xbd, 0xc7, 0xa1, 0xf0, 0x58, 0xf3, 0x04,
-	0xad, 0xde, 0x64, 0x45, 0xe0, 0x35, 0x2e, 0xbf, 0x43, 0x6f, 0x83, 0x83, 0x13, 0xbd, 0xd1, 0x29,
-	0x2e, 0x59, 0x9c, 0x25, 0xc4, 0x97, 0xd3, 0xcf, 0x17, 0x76, 0xd7, 0x3c, 0xc0, 0x57, 0x1b, 0x9f,
-	0x1d, 0x79, 0xd5, 0x51, 0x6c, 0x69, 0xbb, 0x05, 0x78, 0x75, 0x34, 0xbe, 0xd3, 0x86, 0xf6, 0xfa,
-	0x5f, 0x6e, 0x17, 0xa8, 0x25, 0x04, 0xed, 0x94, 0x82, 0x5a, 0x42, 0x00, 0x29, 0x01, 0x1c, 0xa2,
-	0x75, 0x28, 0xa9, 0xf7, 0x8d, 0x50, 0x9a, 0x0a, 0xe9, 0x96, 0x21, 0xad, 0xb6, 0x8c, 0x86, 0xc3,
-	0x2a, 0x20, 0x4c, 0xe1, 0x78, 0x8c, 0xae, 0x42, 0xa3, 0xda, 0x61, 0xea, 0x0b, 0xb7, 0x2d, 0xc3,
-	0xad, 0x76, 0xb0, 0xae, 0x00, 0x56, 0xcd, 0x90, 0x9d, 0xa0, 0x6b, 0xf0, 0x40, 0xac, 0xde, 0x38,
-	0x54, 0x0b, 0x0b, 0xb2, 0xeb, 0x9d, 0x37, 0x21, 0xad, 0xc6, 0xfa, 0xf7, 0xb3, 0xe8, 0x86, 0xbd,
-	0xb2, 0x6a, 0xed, 0x8f, 0x84, 0xfd, 0xdb, 0x53, 0xab, 0xac, 0xb6, 0x06, 0xd7, 0x2c, 0x66, 0x4d,
-	0x25, 0x42, 0xb4, 0xae, 0xb6, 0x82, 0xb5, 0xd6, 0x91, 0x1a, 0x60, 0xb5, 0x27, 0xac, 0x75, 0x5c,
-	0x95, 0x84, 0x6a, 0x7c, 0xfb, 0x4d, 0x34, 0x97, 0xe6, 0xc3, 0xcd, 0xbf, 0xb7, 0xd0, 0x79, 0x2b,
-	0x95, 0xc7, 0xef, 0xa2, 0xf9, 0x21, 0x4d, 0x53, 0x12, 0x8a, 0x13, 0xaf, 0x39, 0xf1, 0x50, 0xac,
-	0xca, 0xf9, 0xbd, 0x83, 0x38, 0x62, 0xf1, 0xf6, 0xa9, 0xcf, 0x9f, 0xad, 0xcf, 0x74, 0x8b, 0x5b,
-	0x9a, 0x7f, 0x6a, 0xa1, 0x37, 0x05, 0xe2, 0xce, 0xb0, 0xdc, 0x19, 0xd6, 0x6b, 0x3c, 0xc3, 0x72,
-	0xc7, 0x4f, 0xee, 0xf8, 0xe9, 0x35, 0x1f, 0x3f, 0xb9, 0xc4, 0xde, 0x25, 0xf6, 0x2e, 0xb1, 0x77,
-	0x89, 0xbd, 0x4b, 0xec, 0x5d, 0x62, 0xff, 0xca, 0xc4, 0xde, 0xa5, 0xdd, 0x2e, 0xed, 0x76, 0x69,
-	0xf7, 0xd7, 0x23, 0xed, 0xfe, 0xcb, 0x06, 0x3a, 0xaf, 0x5f, 0x74, 0x3d, 0x1c, 0x71, 0x30, 0xfd,
-	0xcf, 0xb2, 0xe5, 0xff, 0x46, 0xb2, 0x7b, 0x80, 0x96, 0xf5, 0x8b, 0x2d, 0x29, 0xf5, 0x6f, 0xe6,
-	0xaa, 0xf2, 0xe6, 0x5d, 0x41, 0xa8, 0xc9, 0x55, 0xbf, 0xb2, 0x49, 0xe6, 0x13, 0xd4, 0xd4, 0xfb,
-	0xf0, 0xe2, 0x7d, 0xa7, 0xfd, 0xc5, 0xc4, 0x9a, 0x71, 0x7a, 0xa2, 0x87, 0x1d, 0x7c, 0x39, 0xb1,
-	0x44, 0xab, 0x21, 0x97, 0xc2, 0xba, 0x14, 0xf6, 0xab, 0xfe, 0x05, 0xc5, 0xff, 0xe5, 0x0b, 0xfb,
-	0x43, 0xd4, 0x02, 0x5f, 0x4e, 0x64, 0x74, 0xc2, 0xf7, 0x04, 0x29, 0x1b, 0x94, 0x83, 0xf7, 0x50,
-	0xed, 0xd5, 0xca, 0x0f, 0x28, 0xf6, 0xe9, 0x24, 0xeb, 0x16, 0x24, 0xb5, 0x57, 0x2b, 0x3e, 0xa3,
-	0x98, 0x42, 0xdd, 0xd9, 0x81, 0x3b, 0x3b, 0x70, 0x67, 0x07, 0xee, 0xec, 0xc0, 0x9d, 0x1d, 0xb8,
-	0xb3, 0x03, 0x77, 0x76, 0xe0, 0xce, 0x0e, 0xdc, 0xd9, 0xc1, 0xd7, 0xfe, 0xec, 0x60, 0x1e, 0xbd,
-	0xc5, 0xc4, 0x59, 0xc1, 0xe6, 0x1f, 0xd6, 0xd1, 0x52, 0x4d, 0x3a, 0x89, 0x77, 0xa7, 0xde, 0xde,
-	0x6f, 0xbd, 0x34, 0xff, 0x7c, 0xe5, 0x5b, 0xfc, 0x6f, 0xa2, 0xf9, 0x57, 0x1d, 0x49, 0x7c, 0x23,
-	0x75, 0xc7, 0x11, 0x5f, 0xee, 0x38, 0xc2, 0x65, 0xfa, 0x2e, 0xd3, 0x7f, 0xcd, 0x99, 0xbe, 0xcb,
-	0xc4, 0x5d, 0x26, 0xee, 0x32, 0x71, 0x97, 0x89, 0xbb, 0x4c, 0xdc, 0x65, 0xe2, 0x2e, 0x13, 0x77,
-	0x99, 0xb8, 0xcb, 0xc4, 0x5d, 0x26, 0xee, 0x32, 0xf1, 0x97, 0xbd, 0xc5, 0xff, 0xf3, 0x1c, 0x9a,
-	0xef, 0x24, 0x2c, 0xde, 0x27, 0xe9, 0x53, 0xfc, 0x00, 0x9d, 0x23, 0x79, 0x76, 0x44, 0xe3, 0x8c,
-	0xc7, 0x02, 0x96, 0xc8, 0xec, 0xfb, 0xcc, 0xf6, 0xf5, 0x7f, 0x3c, 0x5b, 0xdf, 0x0c, 0xa3, 0xec,
-	0x28, 0x3f, 0xf4, 0x7c, 0x36, 0x6c, 0x47, 0x6c, 0xfc, 0x2d, 0x16, 0xd3, 0xf6, 0x31, 0x25, 0x63,
-	0xea, 0x75, 0x58, 0x1c, 0x44, 0x62, 0x43, 0x6b, 0xdd, 0xfd, 0xbf, 0xf1, 0x19, 0xfb, 0xc7, 0x68,
-	0xc5, 0xc8, 0x31, 0x8a, 0x0b, 0xfa, 0xaf, 0x27, 0x2e, 0xcb, 0x10, 0x35, 0xc0, 0x2f, 0xff, 0x1f,
-	0xa0, 0x6f, 0xa1, 0xb3, 0x7c, 0xfb, 0x9f, 0x91, 0xc1, 0xe0, 0x44, 0xdc, 0xfc, 0x0b, 0x75, 0x40,
-	0xc1, 0x77, 0xfb, 0xfb, 0xbc, 0x54, 0x7d, 0x33, 0x11, 0xb2, 0xb1, 0xbe, 0x54, 0xa3, 0xb7, 0xdd,
-	0xf8, 0xfc, 0x79, 0x6b, 0xf6, 0x8b, 0xe7, 0xad, 0xd9, 0xbf, 0x3d, 0x6f, 0xcd, 0xfe, 0xf1, 0x45,
-	0x6b, 0xe6, 0x8b, 0x17, 0xad, 0x99, 0xbf, 0xbe, 0x68, 0xcd, 0x1c, 0xbe, 0x25, 0xfe, 0xf8, 0xc7,
-	0xad, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x84, 0x8a, 0x61, 0x0f, 0x46, 0x00, 0x00,
+	// 2353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5b, 0x73, 0x1b, 0xb7,
+	0x15, 0xb6, 0x62, 0x25, 0xd5, 0xc0, 0x37, 0x09, 0xd6, 0x85, 0xa2, 0x24, 0x4a, 0x96, 0x6c, 0xc7,
+	0xd3, 0x4e, 0x97, 0x1d, 0xbb, 0xf7, 0x3a, 0x75, 0x4d, 0x4a, 0xaa, 0x93, 0xd6, 0x97, 0x50, 0x92,
+	0x9b, 0xd6, 0x4e, 0x18, 0x68, 0x17, 0x5c, 0xad, 0x45, 0x2e, 0xe8, 0xbd, 0x50, 0x54, 0x67, 0xfa,
+	0xd2, 0x5f, 0xd0, 0xb7, 0x4e, 0x7f, 0x45, 0xff, 0x46, 0x1e, 0xf3, 0xd8, 0x87, 0x4c, 0xda, 0xb1,
+	0xff, 0x45, 0xa7, 0x0f, 0x1d, 0x00, 0x07, 0xbb, 0xc0, 0x72, 0x37, 0xe9, 0x6d, 0xe2, 0x34, 0xc1,
+	0x93, 0xb5, 0xe7, 0x3b, 0xf8, 0x0e, 0x80, 0x83, 0x3d, 0x0b, 0x7c, 0x03, 0x13, 0xd5, 0xdc, 0x81,
+	0xd7, 0x3c, 0x0c, 0x63, 0xaf, 0x49, 0x86, 0xc3, 0xa6, 0xcb, 0x3c, 0xea, 0x3a, 0xc3, 0x88, 0x25,
+	0x0c, 0x4f, 0x73, 0x6b, 0xbd, 0x91, 0xe1, 0xe3, 0x26, 0x71, 0x5d, 0x96, 0x86, 0x89, 0xee, 0x55,
+	0xbf, 0xae, 0xe1, 0xc3, 0x88, 0x46, 0xd4, 0x0f, 0xe2, 0x24, 0x22, 0x49, 0xc0, 0x42, 0xc3, 0x6f,
+	0x4b, 0xf3, 0x7b, 0x9e, 0x92, 0x7e, 0x90, 0x9c, 0xc6, 0x2e, 0x8b, 0xa8, 0xe1, 0xb4, 0xa9, 0x39,
+	0x25, 0x34, 0x1a, 0x78, 0x74, 0xc8, 0xe2, 0xc0, 0x0c, 0xb8, 0xae, 0xf9, 0xa4, 0x31, 0x8d, 0x42,
+	0x32, 0x30, 0x49, 0x96, 0x3d, 0x92, 0x90, 0x41, 0xe0, 0x97, 0x74, 0x62, 0xde, 0x67, 0x3e, 0x13,
+	0x7f, 0x36, 0xf9, 0x5f, 0x60, 0x5d, 0x28, 0x77, 0xbe, 0x3c, 0x6e, 0x92, 0xf8, 0x84, 0x18, 0x93,
+	0x52, 0xc7, 0xe3, 0xa6, 0x4b, 0xe2, 0xa3, 0x09, 0x5b, 0x54, 0x68, 0xbc, 0x38, 0x6e, 0xba, 0x69,
+	0x14, 0xd1, 0xd0, 0x3d, 0x35, 0xec, 0xf5, 0x71, 0xd3, 0xe3, 0x13, 0x14, 0x1c, 0xa6, 0x93, 0xbd,
+	0x1b, 0x37, 0x69, 0xec, 0x46, 0xec, 0xc4, 0xb0, 0xce, 0x8d, 0x9b, 0x3e, 0x1b, 0x15, 0x1d, 0x07,
+	0xb1, 0xdf, 0xa3, 0xb4, 0x18, 0x72, 0x90, 0xf6, 0x93, 0x20, 0x0e, 0x7c, 0xc3, 0xbe, 0x30, 0x6e,
+	0x0e, 0xc9, 0xa9, 0x7b, 0x44, 0xc2, 0x62, 0xaf, 0xe3, 0xc0, 0x8f, 0x8b, 0x14, 0x71, 0x9f, 0xc4,
+	0x47, 0x41, 0x38, 0x41, 0x11, 0x27, 0xe4, 0xb8, 0x68, 0xbe, 0x3c, 0x6e, 0x26, 0xe3, 0x62, 0x37,
+	0x6a, 0xe3, 0xe6, 0x88, 0xf4, 0x03, 0x8f, 0x24, 0x2c, 0x32, 0xd8, 0x37, 0xff, 0xf4, 0x2d, 0xf4,
+	0xda, 0xfe, 0x18, 0x5f, 0x41, 0xd3, 0x3d, 0x4a, 0xe3, 0xda, 0xd4, 0xc6, 0xd4, 0x8d, 0x73, 0x37,
+	0x2f, 0x38, 0x7c, 0x3e, 0x9d, 0x5d, 0x4a, 0xdf, 0x0e, 0x7b, 0xac, 0x23, 0x20, 0x7c, 0x13, 0xa1,
+	0x38, 0xf0, 0x43, 0x92, 0xa4, 0x11, 0x8d, 0x6b, 0xaf, 0x6d, 0x9c, 0xbd, 0x71, 0xee, 0x26, 0x76,
+	0x78, 0x77, 0x9d, 0xbd, 0xc4, 0xdb, 0x53, 0x50, 0x47, 0xf3, 0xc2, 0x75, 0x34, 0xa3, 0x86, 0x5f,
+	0x9b, 0xde, 0x38, 0x7b, 0xe3, 0x7c, 0x27, 0x7b, 0xc6, 0xb7, 0xd0, 0x05, 0x1e, 0xa5, 0x1b, 0xd3,
+	0xd0, 0xeb, 0x0e, 0x62, 0xbf, 0x76, 0x4b, 0x8f, 0xbd, 0x47, 0x43, 0xef, 0x7e, 0xec, 0xdf, 0x3b,
+	0xd3, 0x39, 0xc7, 0x9f, 0xe1, 0x11, 0xdf, 0x41, 0x73, 0x32, 0x1d, 0x5d, 0x37, 0xa2, 0x24, 0xa1,
+	0xa2, 0xe1, 0x77, 0x45, 0xc3, 0x39, 0x47, 0x22, 0x4e, 0x5b, 0x20, 0xb2, 0xf1, 0x25, 0x69, 0xcb,
+	0x4c, 0xb8, 0x85, 0x30, 0x10, 0x44, 0xb4, 0x4f, 0x49, 0x2c, 0x19, 0xbe, 0x27, 0x18, 0xb0, 0x62,
+	0xe8, 0x48, 0x48, 0x52, 0xcc, 0x4a, 0x63, 0x6e, 0xd3, 0x3a, 0x11, 0xd1, 0x24, 0x8d, 0x42, 0x41,
+	0xf1, 0x7d, 0xb3, 0x13, 0x1d, 0x81, 0x18, 0x9d, 0xc8, 0x4c, 0xf8, 0x00, 0x2d, 0x03, 0x41, 0x3a,
+	0xf4, 0xf8, 0x28, 0x86, 0x24, 0x4a, 0x02, 0x1a, 0x0b, 0xa2, 0x1f, 0x08, 0xa2, 0x9a, 0x22, 0x3a,
+	0x10, 0x1e, 0x8f, 0xa4, 0x83, 0xe4, 0x5b, 0x94, 0x50, 0x11, 0xc1, 0x3b, 0xe8, 0xb2, 0x9a, 0x5d,
+	0x7d, 0x7a, 0x7e, 0x28, 0x08, 0x2f, 0x3b, 0x0a, 0x33, 0x26, 0x68, 0x4e, 0x59, 0xf3, 0x29, 0xd2,
+	0x69, 0xa0, 0x7f, 0x9c, 0xe6, 0x47, 0x45, 0x1a, 0x19, 0xbf, 0x40, 0x93, 0x19, 0xf9, 0x20, 0xf3,
+	0x35, 0xd7, 0x25, 0xc3, 0x61, 0xff, 0xb4, 0xeb, 0x05, 0xbd, 0x9e, 0x20, 0xfb, 0x31, 0x0c, 0x32,
+	0xf7, 0x70, 0xee, 0x72, 0x8f, 0xed, 0xa0, 0xd7, 0x83, 0x41, 0xe6, 0x90, 0x8e, 0xf0, 0xde, 0xa9,
+	0x97, 0x58, 0x1f, 0xe4, 0x4f, 0xa0, 0x77, 0x0a, 0x33, 0x07, 0xa9, 0xac, 0xf9, 0x20, 0xdb, 0x68,
+	0x8e, 0x8e, 0xa9, 0x9b, 0x26, 0xb4, 0x7b, 0x48, 0x12, 0xf7, 0x48, 0x90, 0xdc, 0x16, 0x24, 0x0b,
+	0x0e, 0xaf, 0x64, 0xce, 0x8e, 0x84, 0x5b, 0x1c, 0x55, 0x79, 0x34, 0x4d, 0xf8, 0x09, 0x5a, 0x51,
+	0xd5, 0xae, 0x2b, 0x8b, 0x2c, 0x8d, 0xba, 0x09, 0x3b, 0xa6, 0x72, 0x49, 0xbc, 0x25, 0xe8, 0xea,
+	0x8e, 0xf2, 0x71, 0x3a, 0xe0, 0xb3, 0xcf, 0x5d, 0x24, 0x67, 0x4d, 0x81, 0x45, 0xcc, 0x20, 0x4f,
+	0x22, 0x12, 0xc6, 0x3d, 0x83, 0xfc, 0xa7, 0x45, 0xf2, 0x7d, 0xf0, 0x29, 0x23, 0x2f, 0x62, 0xf8,
+	0x18, 0x5d, 0xc9, 0xc8, 0x79, 0x15, 0xf2, 0x29, 0x50, 0x27, 0x24, 0xf2, 0x69, 0x22, 0x57, 0xe2,
+	0x1d, 0x11, 0x62, 0x3d, 0x0f, 0xd1, 0x16, 0x9e, 0x82, 0x64, 0x5f, 0xfa, 0xc9, 0x38, 0x6b, 0xca,
+	0xa3, 0xd4, 0x01, 0x0f, 0xb4, 0x60, 0xb0, 0xa0, 0x5c, 0x16, 0xf6, 0x02, 0x3f, 0x95, 0x15, 0x5e,
+	0x04, 0xfb, 0x99, 0x08, 0xb6, 0x91, 0x07, 0x93, 0x2b, 0xa9, 0xad, 0x3b, 0xca, 0x68, 0x0d, 0xe5,
+	0x52, 0xee, 0x81, 0xdf, 0x45, 0x4b, 0x7a, 0x39, 0xd7, 0x57, 0x49, 0x4b, 0x04, 0x59, 0x72, 0x74,
+	0xdc, 0x58, 0x29, 0x0b, 0x3a, 0x92, 0xaf, 0x96, 0x7b, 0x68, 0xd6, 0xa0, 0xe4, 0x5c, 0x6d, 0xc1,
+	0xb5, 0x62, 0x72, 0x6d, 0xab, 0x07, 0x55, 0x7f, 0x74, 0x94, 0x33, 0x3d, 0x40, 0x8b, 0x06, 0x53,
+	0x44, 0x63, 0x9a, 0x08, 0xbe, 0x6d, 0xc1, 0xb7, 0x68, 0xf2, 0x75, 0x38, 0x2c, 0xa9, 0xe6, 0x75,
+	0x40, 0xd9, 0xf1, 0x07, 0x68, 0x35, 0xfb, 0x52, 0x76, 0xd3, 0xa1, 0x1f, 0x11, 0x8f, 0x76, 0x63,
+	0xf7, 0x88, 0x0e, 0x88, 0x60, 0xdd, 0x81, 0x5e, 0x66, 0x4e, 0xce, 0x81, 0x74, 0xda, 0x13, 0x3e,
+	0x92, 0x7a, 0x39, 0x43, 0x8b, 0x20, 0xbe, 0x8d, 0x66, 0xc5, 0x07, 0x57, 0x9f, 0xc5, 0x5d, 0xc1,
+	0x39, 0xeb, 0x08, 0xc0, 0x98, 0xbe, 0x8b, 0xc2, 0x94, 0xcf, 0xdb, 0x1d, 0x34, 0x27, 0x5b, 0xeb,
+	0xc5, 0xf6, 0xe7, 0x50, 0x29, 0x65, 0x73, 0xa3, 0xd6, 0x5e, 0x12, 0xb6, 0xdc, 0x94, 0x87, 0xd7,
+	0x2a, 0xed, 0x3d, 0x23, 0xbc, 0x5e, 0x68, 0x2f, 0x42, 0x73, 0xb0, 0xe0, 0x87, 0x68, 0xc9, 0x67,
+	0x23, 0xd5, 0xf5, 0x61, 0xc4, 0x86, 0x2c, 0x26, 0x7d, 0x41, 0xf2, 0x36, 0xcc, 0xb6, 0xcf, 0x46,
+	0x30, 0x82, 0x47, 0x00, 0xc3, 0x6c, 0xfb, 0x6c, 0x34, 0x61, 0x57, 0x84, 0x1e, 0xed, 0xd3, 0x22,
+	0xe1, 0x3b, 0x1a, 0xe1, 0xb6, 0xc0, 0x27, 0x09, 0x27, 0xec, 0xf8, 0x3b, 0xe8, 0x3c, 0x27, 0x1c,
+	0x31, 0x98, 0xda, 0x5f, 0x08, 0x96, 0xf3, 0x82, 0xe5, 0x31, 0x53, 0xd3, 0x8a, 0x7c, 0x36, 0x7a,
+	0xcc, 0xb2, 0xb2, 0xca, 0x5b, 0xc0, 0x7b, 0x44, 0xfb, 0xd4, 0x4d, 0x58, 0xa4, 0x32, 0x73, 0x1f,
+	0xca, 0x2a, 0x6f, 0x2e, 0xdf, 0x8e, 0x9d, 0xcc, 0x01, 0xca, 0xaa, 0xcf, 0x46, 0x25, 0x08, 0x7e,
+	0x8a, 0x56, 0x8b, 0xb4, 0x62, 0x79, 0xa6, 0x7d, 0xc9, 0xfc, 0x00, 0xca, 0x4d, 0x81, 0x99, 0x2f,
+	0xc5, 0xb4, 0x0f, 0xdc, 0x35, 0x93, 0x3b, 0xc7, 0xf0, 0x3b, 0x68, 0x51, 0x6e, 0x8e, 0xba, 0xb0,
+	0xda, 0xbb, 0x3d, 0x2a, 0x79, 0x1f, 0x09, 0xde, 0x79, 0x47, 0xc2, 0xce, 0x9e, 0x58, 0xd5, 0xbb,
+	0x14, 0x18, 0xb1, 0x34, 0xeb, 0x56, 0x1c, 0xa3, 0x2d, 0x63, 0x33, 0xd9, 0x55, 0x75, 0x3c, 0xb7,
+	0x70, 0xe2, 0x77, 0x05, 0xf1, 0xa6, 0x63, 0xf8, 0xaa, 0xa2, 0x7e, 0x5f, 0x19, 0x64, 0x98, 0x0d,
+	0xc3, 0xa9, 0xc4, 0x07, 0x3f, 0x43, 0x1b, 0xb0, 0xd1, 0xae, 0xae, 0x60, 0x1d, 0x28, 0x97, 0xe0,
+	0x58, 0x5d, 0xc0, 0xd6, 0xc0, 0xa3, 0xa2, 0x7e, 0x3d, 0x41, 0x2b, 0x2a, 0x56, 0xf6, 0x51, 0xf1,
+	0xd8, 0x80, 0x04, 0x32, 0xcc, 0x1e, 0x64, 0x42, 0x85, 0x51, 0x1f, 0x8e, 0x6d, 0xe1, 0x02, 0x99,
+	0x00, 0x70, 0x02, 0xc3, 0x11, 0xba, 0x9a, 0x93, 0x0f, 0xfb, 0xc4, 0xa5, 0x5d, 0xf5, 0x0c, 0x69,
+	0x91, 0xb5, 0x7f, 0x5f, 0x44, 0xb9, 0xa2, 0x45, 0x11, 0xce, 0x77, 0xe5, 0xa3, 0xcc, 0x06, 0x54,
+	0xff, 0xf5, 0x2c, 0x58, 0xb9, 0x8b, 0x3e, 0xa0, 0xec, 0x43, 0xa6, 0x0d, 0xe8, 0xa0, 0x30, 0x20,
+	0xf5, 0xb1, 0x2a, 0x1b, 0xd0, 0x04, 0x86, 0x3b, 0xa8, 0x96, 0x0f, 0x28, 0xa4, 0x27, 0x3a, 0xf3,
+	0x63, 0x28, 0xf7, 0xf9, 0x20, 0x42, 0x7a, 0xa2, 0xd3, 0x2e, 0x64, 0x5d, 0xd7, 0x01, 0xfe, 0x8e,
+	0x29, 0x4e, 0x78, 0xd5, 0x35, 0xd2, 0x5f, 0xc1, 0x3b, 0xa6, 0x48, 0xe5, 0x4b, 0xad, 0xb3, 0x2e,
+	0x02, 0x54, 0x40, 0x78, 0xad, 0x9e, 0x48, 0xac, 0x36, 0xf9, 0xb5, 0xf7, 0xa0, 0x56, 0x17, 0x33,
+	0x9b, 0xcf, 0x28, 0xaf, 0xd5, 0x85, 0xd4, 0xe6, 0xa0, 0xce, 0x9f, 0xcd, 0xb3, 0xce, 0xff, 0xeb,
+	0x02, 0xbf, 0x9a, 0xcc, 0x52, 0xfe, 0x49, 0x10, 0x3f, 0x47, 0x5b, 0x55, 0x6b, 0x47, 0xdf, 0x36,
+	0xfc, 0xe6, 0x33, 0x97, 0x8e, 0xb1, 0x71, 0x28, 0x5f, 0x3a, 0xb9, 0x0b, 0x7e, 0x0f, 0xd5, 0x0b,
+	0x99, 0xd0, 0x07, 0xf4, 0x44, 0x44, 0x5a, 0x2e, 0xa4, 0xc2, 0x18, 0xce, 0x92, 0x91, 0x0b, 0x6d,
+	0x30, 0xda, 0xba, 0xe9, 0xf5, 0xd3, 0xf8, 0x48, 0x4f, 0xf1, 0xd3, 0xc2, 0xba, 0xd9, 0xe5, 0x0e,
+	0x65, 0xeb, 0xc6, 0x04, 0xf4, 0x75, 0x23, 0xd7, 0xa2, 0xde, 0xd9, 0xf7, 0x0b, 0xeb, 0x46, 0xac,
+	0x39, 0xa3, 0xaf, 0x8b, 0xfa, 0x6a, 0x2c, 0x9f, 0x77, 0xe2, 0x79, 0x19, 0xa9, 0x4b, 0xa3, 0x24,
+	0xe8, 0x05, 0xae, 0x2a, 0xfe, 0x1f, 0x14, 0xe6, 0xfd, 0xae, 0xe7, 0x01, 0x49, 0x3b, 0xf7, 0x34,
+	0xe7, 0xbd, 0xca, 0x05, 0xff, 0x16, 0x5d, 0xaf, 0x98, 0xf7, 0x62, 0xd4, 0xae, 0x88, 0x7a, 0xb5,
+	0x3c, 0x07, 0x13, 0x81, 0x37, 0xcb, 0xd2, 0x51, 0x88, 0xfd, 0x21, 0x5a, 0x2d, 0x88, 0x16, 0xf9,
+	0xeb, 0xc2, 0x23, 0x7e, 0x28, 0x22, 0xae, 0x3a, 0x05, 0xa7, 0xec, 0x75, 0x91, 0x91, 0xea, 0x05,
+	0x58, 0x43, 0x31, 0x41, 0x6b, 0xe2, 0xe8, 0x59, 0x59, 0xca, 0x09, 0x84, 0xe0, 0x5e, 0xd5, 0x75,
+	0xbc, 0xce, 0xe1, 0x72, 0x14, 0x7b, 0xa8, 0x21, 0x8e, 0xe1, 0xd5, 0x31, 0x0e, 0x45, 0x8c, 0x35,
+	0x47, 0xb8, 0x55, 0x07, 0x59, 0x11, 0x78, 0x45, 0x94, 0xdf, 0xa1, 0x37, 0x35, 0x49, 0x46, 0x6d,
+	0x74, 0xb2, 0x47, 0x16, 0x26, 0x11, 0x71, 0xe5, 0xf2, 0x73, 0x45, 0xb8, 0x6b, 0x8e, 0xe6, 0x0f,
+	0x1b, 0x9f, 0x6d, 0xf9, 0xd4, 0x06, 0x6f, 0x19, 0x76, 0x4b, 0xf3, 0xab, 0x72, 0xe3, 0x3b, 0x6d,
+	0x3d, 0xbc, 0xfa, 0x97, 0x87, 0xf3, 0xe0, 0x15, 0xd2, 0xc3, 0x01, 0x03, 0xbc, 0x42, 0x1a, 0x92,
+	0x03, 0xd8, 0x47, 0xeb, 0x3a, 0xa5, 0xda, 0x37, 0xea, 0xd4, 0x54, 0x50, 0x37, 0x0c, 0x6a, 0xd8,
+	0x32, 0x1a, 0x11, 0x56, 0x35, 0x87, 0x09, 0x1c, 0x8f, 0xd0, 0x55, 0x3d, 0x50, 0x65, 0x9a, 0x7a,
+	0x22, 0xda, 0x96, 0x11, 0xad, 0x32, 0x59, 0x57, 0x34, 0xaf, 0x8a, 0x94, 0x9d, 0xa2, 0x6b, 0xba,
+	0xd4, 0x56, 0x1d, 0xd8, 0x87, 0x17, 0x4b, 0xf7, 0xae, 0x8e, 0xbc, 0xa9, 0xbb, 0x55, 0x84, 0xfe,
+	0xfd, 0x14, 0xba, 0x51, 0x7c, 0xb3, 0x2a, 0xc3, 0x1f, 0x89, 0xf0, 0x6f, 0x4e, 0xbc, 0x65, 0x95,
+	0x3d, 0xb8, 0x56, 0xf0, 0xac, 0xe8, 0x84, 0x8f, 0xd6, 0x61, 0x2b, 0x58, 0x19, 0x3a, 0x80, 0x04,
+	0x4b, 0xbf, 0xea, 0x88, 0xab, 0xd2, 0xa1, 0x22, 0x50, 0x0b, 0x61, 0x90, 0xd8, 0xf4, 0xb3, 0xcb,
+	0x33, 0x50, 0x7a, 0x00, 0x32, 0x4e, 0x2f, 0xb3, 0x60, 0xd4, 0xcf, 0x7d, 0xf3, 0x8a, 0x23, 0xfb,
+	0xa2, 0x72, 0x96, 0x63, 0xd8, 0xb5, 0x2a, 0x16, 0xf5, 0xb1, 0x84, 0x5d, 0x2b, 0x98, 0x35, 0x2b,
+	0x3f, 0x09, 0x65, 0xbd, 0xe9, 0x33, 0x38, 0x09, 0xf5, 0xe1, 0x24, 0x94, 0x75, 0x86, 0x23, 0x70,
+	0x12, 0x52, 0x7d, 0x01, 0x13, 0xd7, 0x3d, 0x94, 0x0c, 0xd8, 0x4d, 0xc3, 0x67, 0x24, 0x90, 0xc7,
+	0x8e, 0x01, 0xe8, 0x1e, 0x0a, 0x73, 0x0e, 0x04, 0x06, 0xba, 0x87, 0xb2, 0x66, 0x46, 0x7e, 0x16,
+	0xcf, 0x69, 0xaa, 0x12, 0x10, 0xc2, 0x59, 0x3c, 0x27, 0xad, 0x3c, 0x8b, 0x67, 0x11, 0xca, 0x93,
+	0x70, 0x1b, 0xcd, 0x82, 0x48, 0xd9, 0x3d, 0x64, 0xa0, 0xf3, 0x31, 0x38, 0xbf, 0x01, 0xe0, 0xb4,
+	0x98, 0x92, 0xfa, 0x2e, 0x82, 0x09, 0x2c, 0x3c, 0x85, 0xaa, 0x75, 0x1a, 0x66, 0xed, 0x87, 0x90,
+	0x42, 0xd5, 0xfe, 0x20, 0x3c, 0xcc, 0x18, 0x54, 0xb4, 0xcc, 0xc6, 0x77, 0xee, 0x19, 0x47, 0xd5,
+	0x78, 0x23, 0xd8, 0xb9, 0x67, 0x8c, 0x95, 0x3b, 0x77, 0x45, 0x5f, 0x3e, 0xda, 0x5d, 0x34, 0xef,
+	0x46, 0x2c, 0xec, 0xba, 0x24, 0x74, 0x69, 0xbf, 0x9b, 0x90, 0xf8, 0x58, 0xf0, 0xc7, 0x90, 0x24,
+	0x0e, 0x3a, 0x6d, 0x01, 0xee, 0x93, 0xf8, 0x18, 0x92, 0xc4, 0xad, 0x86, 0xb1, 0xf5, 0x3a, 0x3a,
+	0x1b, 0xa7, 0x83, 0xcd, 0x3f, 0x5f, 0x45, 0x97, 0x0a, 0x2a, 0x14, 0x7e, 0x0b, 0xcd, 0x0c, 0x68,
+	0x1c, 0x13, 0x5f, 0x88, 0xb5, 0x67, 0xc5, 0x7e, 0xae, 0x4c, 0xae, 0x72, 0x0e, 0xc2, 0x80, 0x85,
+	0xad, 0xe9, 0x8f, 0x3e, 0x5d, 0x3f, 0xd3, 0xc9, 0x9a, 0xd4, 0x3f, 0xd9, 0x42, 0xaf, 0x0b, 0xc4,
+	0xca, 0xaf, 0x56, 0x7e, 0x7d, 0x85, 0xf2, 0xab, 0x55, 0x4e, 0xad, 0x72, 0xfa, 0x8a, 0x95, 0x53,
+	0xab, 0x49, 0x59, 0x4d, 0xca, 0x6a, 0x52, 0x56, 0x93, 0xb2, 0x9a, 0x94, 0xd5, 0xa4, 0x3e, 0x57,
+	0x93, 0xb2, 0x8a, 0x91, 0x55, 0x8c, 0xac, 0x62, 0x64, 0x15, 0x23, 0xab, 0x18, 0x59, 0xc5, 0xe8,
+	0x4b, 0xac, 0x18, 0xfd, 0x71, 0x13, 0x5d, 0x52, 0xd7, 0x0b, 0x1e, 0x0e, 0x79, 0x90, 0xf8, 0x3f,
+	0x13, 0x7a, 0xfe, 0x17, 0x3a, 0xcd, 0x01, 0x5a, 0x86, 0xf9, 0x03, 0xaa, 0x7f, 0x53, 0x66, 0x91,
+	0x8d, 0x77, 0x84, 0x43, 0x85, 0xcc, 0xf2, 0x95, 0xd5, 0x47, 0x9e, 0xa2, 0xba, 0x3a, 0x42, 0x66,
+	0xb7, 0x4c, 0x8a, 0xf7, 0xd4, 0xd6, 0x0c, 0xe1, 0x4f, 0xa5, 0x5d, 0xbb, 0xaf, 0xb6, 0x44, 0xcb,
+	0x21, 0xab, 0xbe, 0x58, 0xf5, 0xe5, 0xab, 0x7e, 0x6f, 0xed, 0xff, 0xf2, 0x9a, 0xd4, 0x21, 0x6a,
+	0x68, 0xf7, 0xd5, 0x12, 0x3a, 0xe6, 0xdb, 0xd9, 0x98, 0xf5, 0xf3, 0xe4, 0x3d, 0x84, 0x63, 0x46,
+	0x7e, 0x6d, 0x6d, 0x9f, 0x8e, 0x93, 0x4e, 0xe6, 0x04, 0xc7, 0x8c, 0xec, 0xf2, 0xda, 0x04, 0x6a,
+	0x65, 0x2f, 0x2b, 0x7b, 0x59, 0xd9, 0xcb, 0xca, 0x5e, 0x56, 0xf6, 0xb2, 0xb2, 0x97, 0x95, 0xbd,
+	0xac, 0xec, 0x65, 0x65, 0xaf, 0xaf, 0xbd, 0xec, 0xf5, 0x05, 0x0b, 0x3c, 0x5f, 0xa0, 0xbc, 0xd2,
+	0x9a, 0x41, 0x6f, 0x30, 0x21, 0x83, 0x6c, 0xfe, 0x75, 0x03, 0x2d, 0x55, 0x9c, 0x94, 0xf1, 0xce,
+	0xc4, 0x9d, 0x9a, 0xad, 0xcf, 0x3c, 0x5a, 0x57, 0xdc, 0xad, 0xf9, 0xc7, 0xba, 0xba, 0x5b, 0xf3,
+	0x4d, 0x34, 0xf3, 0x79, 0x6a, 0xcb, 0x37, 0x62, 0xab, 0xb4, 0xfc, 0x77, 0x4a, 0x8b, 0x15, 0x31,
+	0xac, 0x88, 0xf1, 0x8a, 0x45, 0x0c, 0x2b, 0x32, 0x58, 0x91, 0xc1, 0x8a, 0x0c, 0x56, 0x64, 0xb0,
+	0x22, 0x83, 0x15, 0x19, 0xac, 0xc8, 0x60, 0x45, 0x06, 0x2b, 0x32, 0x58, 0x91, 0xc1, 0x8a, 0x0c,
+	0x5f, 0x37, 0x91, 0x01, 0xee, 0x5e, 0x7c, 0x32, 0x8d, 0x66, 0xda, 0x11, 0x0b, 0xf9, 0x95, 0x0c,
+	0xfc, 0x00, 0x5d, 0x24, 0x69, 0x72, 0x44, 0xc3, 0x84, 0x97, 0x39, 0x16, 0x49, 0x61, 0xe1, 0x7c,
+	0xeb, 0xfa, 0xdf, 0x3f, 0x5d, 0xdf, 0xf4, 0x83, 0xe4, 0x28, 0x3d, 0x74, 0x5c, 0x36, 0x68, 0x06,
+	0x6c, 0xf4, 0x6d, 0x16, 0xd2, 0xe6, 0x09, 0x25, 0x23, 0xea, 0xb4, 0x59, 0xe8, 0x05, 0x62, 0xaf,
+	0x5e, 0x68, 0xfd, 0xe5, 0xf8, 0x7f, 0x33, 0xef, 0xa3, 0x15, 0xe3, 0xf8, 0x94, 0x3d, 0xd0, 0x7f,
+	0xfd, 0x4c, 0xb6, 0xac, 0xa3, 0x06, 0xf8, 0xaa, 0x7f, 0x2c, 0xe4, 0x16, 0xba, 0xc0, 0xcf, 0x45,
+	0x09, 0xe9, 0xf7, 0x4f, 0x45, 0xd3, 0x5f, 0x82, 0x72, 0xc3, 0x8f, 0x41, 0xfb, 0xdc, 0x2a, 0xdb,
+	0x9d, 0xf3, 0xd9, 0x48, 0x3d, 0xf2, 0x6f, 0x97, 0x5a, 0x67, 0xaa, 0xd7, 0xf2, 0xde, 0x11, 0xb7,
+	0x70, 0x92, 0xe7, 0xf0, 0xed, 0x52, 0x8b, 0x0c, 0xba, 0x7b, 0xa0, 0x9c, 0xe0, 0xdb, 0x05, 0x70,
+	0x09, 0x0a, 0xcb, 0xab, 0x55, 0xfb, 0xe8, 0x45, 0x63, 0xea, 0xe3, 0x17, 0x8d, 0xa9, 0xbf, 0xbd,
+	0x68, 0x4c, 0xfd, 0xe1, 0x65, 0xe3, 0xcc, 0xc7, 0x2f, 0x1b, 0x67, 0xfe, 0xf2, 0xb2, 0x71, 0xe6,
+	0xf0, 0x0d, 0xf1, 0x4b, 0x5e, 0xb7, 0xfe, 0x39, 0x00, 0x04, 0xe8, 0x34, 0xa9, 0x36, 0x4e, 0x00,
+	0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -7302,6 +8076,150 @@ func (m *Tx_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *Tx_PaychanCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PaychanCreateMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PaychanCreateMsg.Size()))
+		n55, err := m.PaychanCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	return i, nil
+}
+func (m *Tx_PaychanTransferMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PaychanTransferMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PaychanTransferMsg.Size()))
+		n56, err := m.PaychanTransferMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	return i, nil
+}
+func (m *Tx_PaychanCloseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PaychanCloseMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PaychanCloseMsg.Size()))
+		n57, err := m.PaychanCloseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
+	}
+	return i, nil
+}
+func (m *Tx_SlashingUnjailMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SlashingUnjailMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SlashingUnjailMsg.Size()))
+		n58, err := m.SlashingUnjailMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
+	}
+	return i, nil
+}
+func (m *Tx_SlashingUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SlashingUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SlashingUpdateConfigurationMsg.Size()))
+		n59, err := m.SlashingUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
+	}
+	return i, nil
+}
+func (m *Tx_StakingBondMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.StakingBondMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StakingBondMsg.Size()))
+		n60, err := m.StakingBondMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
+	}
+	return i, nil
+}
+func (m *Tx_StakingUnbondMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.StakingUnbondMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StakingUnbondMsg.Size()))
+		n61, err := m.StakingUnbondMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n61
+	}
+	return i, nil
+}
+func (m *Tx_StakingUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.StakingUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StakingUpdateConfigurationMsg.Size()))
+		n62, err := m.StakingUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
+	}
+	return i, nil
+}
+func (m *Tx_CronCancelTaskMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CronCancelTaskMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CronCancelTaskMsg.Size()))
+		n63, err := m.CronCancelTaskMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
+	}
+	return i, nil
+}
 func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7348,11 +8266,11 @@ func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Sum != nil {
-		nn55, err := m.Sum.MarshalTo(dAtA[i:])
+		nn64, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn55
+		i += nn64
 	}
 	return i, nil
 }
@@ -7365,11 +8283,11 @@ func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n56, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		n65, err := m.CashSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n65
 	}
 	return i, nil
 }
@@ -7381,11 +8299,11 @@ func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowCreateMsg.Size()))
-		n57, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
+		n66, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n66
 	}
 	return i, nil
 }
@@ -7397,11 +8315,11 @@ func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n58, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n67, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n67
 	}
 	return i, nil
 }
@@ -7413,11 +8331,11 @@ func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n59, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		n68, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n68
 	}
 	return i, nil
 }
@@ -7429,11 +8347,11 @@ func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdatePartiesMsg.Size()))
-		n60, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
+		n69, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n69
 	}
 	return i, nil
 }
@@ -7445,11 +8363,11 @@ func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n61, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		n70, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n70
 	}
 	return i, nil
 }
@@ -7461,11 +8379,11 @@ func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n62, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n71, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n71
 	}
 	return i, nil
 }
@@ -7477,11 +8395,11 @@ func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n63, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n72, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n72
 	}
 	return i, nil
 }
@@ -7493,11 +8411,11 @@ func (m *ExecuteBatchMsg_Union_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n64, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		n73, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n73
 	}
 	return i, nil
 }
@@ -7509,11 +8427,11 @@ func (m *ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n65, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n74, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n74
 	}
 	return i, nil
 }
@@ -7525,11 +8443,11 @@ func (m *ExecuteBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n66, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n75, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n75
 	}
 	return i, nil
 }
@@ -7541,11 +8459,11 @@ func (m *ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n67, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n76, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n76
 	}
 	return i, nil
 }
@@ -7557,11 +8475,11 @@ func (m *ExecuteBatchMsg_Union_UsernameUpdateConfigurationMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameUpdateConfigurationMsg.Size()))
-		n68, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n77, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n77
 	}
 	return i, nil
 }
@@ -7573,11 +8491,11 @@ func (m *ExecuteBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n69, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n78, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n78
 	}
 	return i, nil
 }
@@ -7589,11 +8507,11 @@ func (m *ExecuteBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n70, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n79, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n79
 	}
 	return i, nil
 }
@@ -7605,11 +8523,11 @@ func (m *ExecuteBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n71, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n80, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n80
 	}
 	return i, nil
 }
@@ -7621,11 +8539,11 @@ func (m *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n72, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		n81, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n81
 	}
 	return i, nil
 }
@@ -7637,11 +8555,11 @@ func (m *ExecuteBatchMsg_Union_DatamigrationExecuteMigrationMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DatamigrationExecuteMigrationMsg.Size()))
-		n73, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
+		n82, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n82
 	}
 	return i, nil
 }
@@ -7653,11 +8571,11 @@ func (m *ExecuteBatchMsg_Union_AccountUpdateConfigurationMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountUpdateConfigurationMsg.Size()))
-		n74, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n83, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n83
 	}
 	return i, nil
 }
@@ -7669,11 +8587,11 @@ func (m *ExecuteBatchMsg_Union_AccountRegisterDomainMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterDomainMsg.Size()))
-		n75, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
+		n84, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n84
 	}
 	return i, nil
 }
@@ -7685,11 +8603,11 @@ func (m *ExecuteBatchMsg_Union_AccountReplaceAccountMsgFeesMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountMsgFeesMsg.Size()))
-		n76, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
+		n85, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n85
 	}
 	return i, nil
 }
@@ -7701,11 +8619,11 @@ func (m *ExecuteBatchMsg_Union_AccountTransferDomainMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferDomainMsg.Size()))
-		n77, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
+		n86, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n77
+		i += n86
 	}
 	return i, nil
 }
@@ -7717,11 +8635,11 @@ func (m *ExecuteBatchMsg_Union_AccountRenewDomainMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewDomainMsg.Size()))
-		n78, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
+		n87, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n87
 	}
 	return i, nil
 }
@@ -7733,11 +8651,11 @@ func (m *ExecuteBatchMsg_Union_AccountDeleteDomainMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteDomainMsg.Size()))
-		n79, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
+		n88, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
+		i += n88
 	}
 	return i, nil
 }
@@ -7749,11 +8667,11 @@ func (m *ExecuteBatchMsg_Union_AccountRegisterAccountMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterAccountMsg.Size()))
-		n80, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
+		n89, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n80
+		i += n89
 	}
 	return i, nil
 }
@@ -7765,11 +8683,11 @@ func (m *ExecuteBatchMsg_Union_AccountTransferAccountMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferAccountMsg.Size()))
-		n81, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
+		n90, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n90
 	}
 	return i, nil
 }
@@ -7781,11 +8699,11 @@ func (m *ExecuteBatchMsg_Union_AccountReplaceAccountTargetsMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountTargetsMsg.Size()))
-		n82, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
+		n91, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n82
+		i += n91
 	}
 	return i, nil
 }
@@ -7797,11 +8715,11 @@ func (m *ExecuteBatchMsg_Union_AccountDeleteAccountMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountMsg.Size()))
-		n83, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
+		n92, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n83
+		i += n92
 	}
 	return i, nil
 }
@@ -7813,11 +8731,11 @@ func (m *ExecuteBatchMsg_Union_AccountFlushDomainMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountFlushDomainMsg.Size()))
-		n84, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
+		n93, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n84
+		i += n93
 	}
 	return i, nil
 }
@@ -7829,11 +8747,11 @@ func (m *ExecuteBatchMsg_Union_AccountRenewAccountMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewAccountMsg.Size()))
-		n85, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
+		n94, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n85
+		i += n94
 	}
 	return i, nil
 }
@@ -7845,11 +8763,11 @@ func (m *ExecuteBatchMsg_Union_AccountAddAccountCertificateMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountAddAccountCertificateMsg.Size()))
-		n86, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n95, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n86
+		i += n95
 	}
 	return i, nil
 }
@@ -7861,11 +8779,11 @@ func (m *ExecuteBatchMsg_Union_AccountDeleteAccountCertificateMsg) MarshalTo(dAt
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountCertificateMsg.Size()))
-		n87, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n96, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n87
+		i += n96
 	}
 	return i, nil
 }
@@ -7877,11 +8795,11 @@ func (m *ExecuteBatchMsg_Union_CashUpdateConfigurationMsg) MarshalTo(dAtA []byte
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashUpdateConfigurationMsg.Size()))
-		n88, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n97, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n88
+		i += n97
 	}
 	return i, nil
 }
@@ -7893,11 +8811,11 @@ func (m *ExecuteBatchMsg_Union_TxfeeUpdateConfigurationMsg) MarshalTo(dAtA []byt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TxfeeUpdateConfigurationMsg.Size()))
-		n89, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n98, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n89
+		i += n98
 	}
 	return i, nil
 }
@@ -7909,11 +8827,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositCreateDepositContractMsg) MarshalTo(dA
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositCreateDepositContractMsg.Size()))
-		n90, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
+		n99, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n90
+		i += n99
 	}
 	return i, nil
 }
@@ -7925,11 +8843,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositDepositMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositDepositMsg.Size()))
-		n91, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
+		n100, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n91
+		i += n100
 	}
 	return i, nil
 }
@@ -7941,11 +8859,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositReleaseDepositMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositReleaseDepositMsg.Size()))
-		n92, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
+		n101, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n92
+		i += n101
 	}
 	return i, nil
 }
@@ -7957,11 +8875,11 @@ func (m *ExecuteBatchMsg_Union_TermdepositUpdateConfigurationMsg) MarshalTo(dAtA
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositUpdateConfigurationMsg.Size()))
-		n93, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n102, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n93
+		i += n102
 	}
 	return i, nil
 }
@@ -7973,11 +8891,11 @@ func (m *ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg) MarshalTo(dAt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.QualityscoreUpdateConfigurationMsg.Size()))
-		n94, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n103, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n94
+		i += n103
 	}
 	return i, nil
 }
@@ -7989,11 +8907,11 @@ func (m *ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg) MarshalTo(
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.PreregistrationUpdateConfigurationMsg.Size()))
-		n95, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n104, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n95
+		i += n104
 	}
 	return i, nil
 }
@@ -8005,11 +8923,155 @@ func (m *ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
-		n96, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n105, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n96
+		i += n105
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_PaychanCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PaychanCreateMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PaychanCreateMsg.Size()))
+		n106, err := m.PaychanCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n106
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_PaychanTransferMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PaychanTransferMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PaychanTransferMsg.Size()))
+		n107, err := m.PaychanTransferMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n107
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_PaychanCloseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PaychanCloseMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PaychanCloseMsg.Size()))
+		n108, err := m.PaychanCloseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n108
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_SlashingUnjailMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SlashingUnjailMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SlashingUnjailMsg.Size()))
+		n109, err := m.SlashingUnjailMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n109
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SlashingUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SlashingUpdateConfigurationMsg.Size()))
+		n110, err := m.SlashingUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n110
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_StakingBondMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.StakingBondMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StakingBondMsg.Size()))
+		n111, err := m.StakingBondMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n111
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_StakingUnbondMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.StakingUnbondMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StakingUnbondMsg.Size()))
+		n112, err := m.StakingUnbondMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n112
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.StakingUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StakingUpdateConfigurationMsg.Size()))
+		n113, err := m.StakingUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n113
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CronCancelTaskMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CronCancelTaskMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CronCancelTaskMsg.Size()))
+		n114, err := m.CronCancelTaskMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n114
 	}
 	return i, nil
 }
@@ -8029,11 +9091,11 @@ func (m *ProposalOptions) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Option != nil {
-		nn97, err := m.Option.MarshalTo(dAtA[i:])
+		nn115, err := m.Option.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn97
+		i += nn115
 	}
 	return i, nil
 }
@@ -8046,11 +9108,11 @@ func (m *ProposalOptions_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n98, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		n116, err := m.CashSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n98
+		i += n116
 	}
 	return i, nil
 }
@@ -8062,11 +9124,11 @@ func (m *ProposalOptions_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n99, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n117, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n99
+		i += n117
 	}
 	return i, nil
 }
@@ -8078,11 +9140,11 @@ func (m *ProposalOptions_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n100, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		n118, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n100
+		i += n118
 	}
 	return i, nil
 }
@@ -8094,11 +9156,11 @@ func (m *ProposalOptions_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n101, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n119, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n101
+		i += n119
 	}
 	return i, nil
 }
@@ -8110,11 +9172,11 @@ func (m *ProposalOptions_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n102, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n120, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n102
+		i += n120
 	}
 	return i, nil
 }
@@ -8126,11 +9188,11 @@ func (m *ProposalOptions_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n103, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		n121, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n103
+		i += n121
 	}
 	return i, nil
 }
@@ -8142,11 +9204,11 @@ func (m *ProposalOptions_ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteProposalBatchMsg.Size()))
-		n104, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
+		n122, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n104
+		i += n122
 	}
 	return i, nil
 }
@@ -8158,11 +9220,11 @@ func (m *ProposalOptions_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n105, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n123, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n105
+		i += n123
 	}
 	return i, nil
 }
@@ -8174,11 +9236,11 @@ func (m *ProposalOptions_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n106, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n124, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n106
+		i += n124
 	}
 	return i, nil
 }
@@ -8190,11 +9252,11 @@ func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n107, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n125, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n107
+		i += n125
 	}
 	return i, nil
 }
@@ -8206,11 +9268,11 @@ func (m *ProposalOptions_UsernameUpdateConfigurationMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameUpdateConfigurationMsg.Size()))
-		n108, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n126, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n108
+		i += n126
 	}
 	return i, nil
 }
@@ -8222,11 +9284,11 @@ func (m *ProposalOptions_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n109, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n127, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n109
+		i += n127
 	}
 	return i, nil
 }
@@ -8238,11 +9300,11 @@ func (m *ProposalOptions_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n110, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n128, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n110
+		i += n128
 	}
 	return i, nil
 }
@@ -8254,11 +9316,11 @@ func (m *ProposalOptions_DistributionResetMsg) MarshalTo(dAtA []byte) (int, erro
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n111, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n129, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n111
+		i += n129
 	}
 	return i, nil
 }
@@ -8270,11 +9332,11 @@ func (m *ProposalOptions_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
-		n112, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		n130, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n112
+		i += n130
 	}
 	return i, nil
 }
@@ -8286,11 +9348,11 @@ func (m *ProposalOptions_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n113, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		n131, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n113
+		i += n131
 	}
 	return i, nil
 }
@@ -8302,11 +9364,11 @@ func (m *ProposalOptions_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n114, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		n132, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n114
+		i += n132
 	}
 	return i, nil
 }
@@ -8318,11 +9380,11 @@ func (m *ProposalOptions_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n115, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		n133, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n115
+		i += n133
 	}
 	return i, nil
 }
@@ -8334,11 +9396,11 @@ func (m *ProposalOptions_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n116, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		n134, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n116
+		i += n134
 	}
 	return i, nil
 }
@@ -8350,11 +9412,11 @@ func (m *ProposalOptions_DatamigrationExecuteMigrationMsg) MarshalTo(dAtA []byte
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DatamigrationExecuteMigrationMsg.Size()))
-		n117, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
+		n135, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n117
+		i += n135
 	}
 	return i, nil
 }
@@ -8366,11 +9428,11 @@ func (m *ProposalOptions_AccountUpdateConfigurationMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountUpdateConfigurationMsg.Size()))
-		n118, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n136, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n118
+		i += n136
 	}
 	return i, nil
 }
@@ -8382,11 +9444,11 @@ func (m *ProposalOptions_AccountRegisterDomainMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterDomainMsg.Size()))
-		n119, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
+		n137, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n119
+		i += n137
 	}
 	return i, nil
 }
@@ -8398,11 +9460,11 @@ func (m *ProposalOptions_AccountReplaceAccountMsgFeesMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountMsgFeesMsg.Size()))
-		n120, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
+		n138, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n120
+		i += n138
 	}
 	return i, nil
 }
@@ -8414,11 +9476,11 @@ func (m *ProposalOptions_AccountTransferDomainMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferDomainMsg.Size()))
-		n121, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
+		n139, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n121
+		i += n139
 	}
 	return i, nil
 }
@@ -8430,11 +9492,11 @@ func (m *ProposalOptions_AccountRenewDomainMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewDomainMsg.Size()))
-		n122, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
+		n140, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n122
+		i += n140
 	}
 	return i, nil
 }
@@ -8446,11 +9508,11 @@ func (m *ProposalOptions_AccountDeleteDomainMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteDomainMsg.Size()))
-		n123, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
+		n141, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n123
+		i += n141
 	}
 	return i, nil
 }
@@ -8462,11 +9524,11 @@ func (m *ProposalOptions_AccountRegisterAccountMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterAccountMsg.Size()))
-		n124, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
+		n142, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n124
+		i += n142
 	}
 	return i, nil
 }
@@ -8478,11 +9540,11 @@ func (m *ProposalOptions_AccountTransferAccountMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferAccountMsg.Size()))
-		n125, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
+		n143, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n125
+		i += n143
 	}
 	return i, nil
 }
@@ -8494,11 +9556,11 @@ func (m *ProposalOptions_AccountReplaceAccountTargetsMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountTargetsMsg.Size()))
-		n126, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
+		n144, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n126
+		i += n144
 	}
 	return i, nil
 }
@@ -8510,11 +9572,11 @@ func (m *ProposalOptions_AccountDeleteAccountMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountMsg.Size()))
-		n127, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
+		n145, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n127
+		i += n145
 	}
 	return i, nil
 }
@@ -8526,11 +9588,11 @@ func (m *ProposalOptions_AccountFlushDomainMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountFlushDomainMsg.Size()))
-		n128, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
+		n146, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n128
+		i += n146
 	}
 	return i, nil
 }
@@ -8542,11 +9604,11 @@ func (m *ProposalOptions_AccountRenewAccountMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewAccountMsg.Size()))
-		n129, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
+		n147, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n129
+		i += n147
 	}
 	return i, nil
 }
@@ -8558,11 +9620,11 @@ func (m *ProposalOptions_AccountAddAccountCertificateMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountAddAccountCertificateMsg.Size()))
-		n130, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n148, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n130
+		i += n148
 	}
 	return i, nil
 }
@@ -8574,11 +9636,11 @@ func (m *ProposalOptions_AccountDeleteAccountCertificateMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountCertificateMsg.Size()))
-		n131, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n149, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n131
+		i += n149
 	}
 	return i, nil
 }
@@ -8590,11 +9652,11 @@ func (m *ProposalOptions_CashUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashUpdateConfigurationMsg.Size()))
-		n132, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n150, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n132
+		i += n150
 	}
 	return i, nil
 }
@@ -8606,11 +9668,11 @@ func (m *ProposalOptions_TxfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TxfeeUpdateConfigurationMsg.Size()))
-		n133, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n151, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n133
+		i += n151
 	}
 	return i, nil
 }
@@ -8622,11 +9684,11 @@ func (m *ProposalOptions_TermdepositCreateDepositContractMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositCreateDepositContractMsg.Size()))
-		n134, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
+		n152, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n134
+		i += n152
 	}
 	return i, nil
 }
@@ -8638,11 +9700,11 @@ func (m *ProposalOptions_TermdepositDepositMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositDepositMsg.Size()))
-		n135, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
+		n153, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n135
+		i += n153
 	}
 	return i, nil
 }
@@ -8654,11 +9716,11 @@ func (m *ProposalOptions_TermdepositReleaseDepositMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositReleaseDepositMsg.Size()))
-		n136, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
+		n154, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n136
+		i += n154
 	}
 	return i, nil
 }
@@ -8670,11 +9732,11 @@ func (m *ProposalOptions_TermdepositUpdateConfigurationMsg) MarshalTo(dAtA []byt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositUpdateConfigurationMsg.Size()))
-		n137, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n155, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n137
+		i += n155
 	}
 	return i, nil
 }
@@ -8686,11 +9748,11 @@ func (m *ProposalOptions_QualityscoreUpdateConfigurationMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.QualityscoreUpdateConfigurationMsg.Size()))
-		n138, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n156, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n138
+		i += n156
 	}
 	return i, nil
 }
@@ -8702,11 +9764,11 @@ func (m *ProposalOptions_PreregistrationUpdateConfigurationMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.PreregistrationUpdateConfigurationMsg.Size()))
-		n139, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n157, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n139
+		i += n157
 	}
 	return i, nil
 }
@@ -8718,11 +9780,43 @@ func (m *ProposalOptions_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
-		n140, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n158, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n140
+		i += n158
+	}
+	return i, nil
+}
+func (m *ProposalOptions_SlashingUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SlashingUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SlashingUpdateConfigurationMsg.Size()))
+		n159, err := m.SlashingUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n159
+	}
+	return i, nil
+}
+func (m *ProposalOptions_StakingUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.StakingUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StakingUpdateConfigurationMsg.Size()))
+		n160, err := m.StakingUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n160
 	}
 	return i, nil
 }
@@ -8772,11 +9866,11 @@ func (m *ExecuteProposalBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Sum != nil {
-		nn141, err := m.Sum.MarshalTo(dAtA[i:])
+		nn161, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn141
+		i += nn161
 	}
 	return i, nil
 }
@@ -8789,11 +9883,11 @@ func (m *ExecuteProposalBatchMsg_Union_SendMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.SendMsg.Size()))
-		n142, err := m.SendMsg.MarshalTo(dAtA[i:])
+		n162, err := m.SendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n142
+		i += n162
 	}
 	return i, nil
 }
@@ -8805,11 +9899,11 @@ func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n143, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n163, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n143
+		i += n163
 	}
 	return i, nil
 }
@@ -8821,11 +9915,11 @@ func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n144, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		n164, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n144
+		i += n164
 	}
 	return i, nil
 }
@@ -8837,11 +9931,11 @@ func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n145, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n165, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n145
+		i += n165
 	}
 	return i, nil
 }
@@ -8853,11 +9947,11 @@ func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n146, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n166, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n146
+		i += n166
 	}
 	return i, nil
 }
@@ -8869,11 +9963,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n147, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n167, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n147
+		i += n167
 	}
 	return i, nil
 }
@@ -8885,11 +9979,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n148, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n168, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n148
+		i += n168
 	}
 	return i, nil
 }
@@ -8901,11 +9995,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n149, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n169, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n149
+		i += n169
 	}
 	return i, nil
 }
@@ -8917,11 +10011,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameUpdateConfigurationMsg) MarshalTo
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameUpdateConfigurationMsg.Size()))
-		n150, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n170, err := m.UsernameUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n150
+		i += n170
 	}
 	return i, nil
 }
@@ -8933,11 +10027,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n151, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n171, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n151
+		i += n171
 	}
 	return i, nil
 }
@@ -8949,11 +10043,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n152, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n172, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n152
+		i += n172
 	}
 	return i, nil
 }
@@ -8965,11 +10059,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n153, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n173, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n153
+		i += n173
 	}
 	return i, nil
 }
@@ -8981,11 +10075,11 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n154, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		n174, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n154
+		i += n174
 	}
 	return i, nil
 }
@@ -8997,11 +10091,11 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n155, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		n175, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n155
+		i += n175
 	}
 	return i, nil
 }
@@ -9013,11 +10107,11 @@ func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAt
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n156, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		n176, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n156
+		i += n176
 	}
 	return i, nil
 }
@@ -9029,11 +10123,11 @@ func (m *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n157, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		n177, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n157
+		i += n177
 	}
 	return i, nil
 }
@@ -9045,11 +10139,11 @@ func (m *ExecuteProposalBatchMsg_Union_DatamigrationExecuteMigrationMsg) Marshal
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DatamigrationExecuteMigrationMsg.Size()))
-		n158, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
+		n178, err := m.DatamigrationExecuteMigrationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n158
+		i += n178
 	}
 	return i, nil
 }
@@ -9061,11 +10155,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountUpdateConfigurationMsg) MarshalTo(
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountUpdateConfigurationMsg.Size()))
-		n159, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n179, err := m.AccountUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n159
+		i += n179
 	}
 	return i, nil
 }
@@ -9077,11 +10171,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRegisterDomainMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterDomainMsg.Size()))
-		n160, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
+		n180, err := m.AccountRegisterDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n160
+		i += n180
 	}
 	return i, nil
 }
@@ -9093,11 +10187,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountReplaceAccountMsgFeesMsg) MarshalT
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountMsgFeesMsg.Size()))
-		n161, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
+		n181, err := m.AccountReplaceAccountMsgFeesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n161
+		i += n181
 	}
 	return i, nil
 }
@@ -9109,11 +10203,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountTransferDomainMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferDomainMsg.Size()))
-		n162, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
+		n182, err := m.AccountTransferDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n162
+		i += n182
 	}
 	return i, nil
 }
@@ -9125,11 +10219,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRenewDomainMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewDomainMsg.Size()))
-		n163, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
+		n183, err := m.AccountRenewDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n163
+		i += n183
 	}
 	return i, nil
 }
@@ -9141,11 +10235,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountDeleteDomainMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteDomainMsg.Size()))
-		n164, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
+		n184, err := m.AccountDeleteDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n164
+		i += n184
 	}
 	return i, nil
 }
@@ -9157,11 +10251,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRegisterAccountMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRegisterAccountMsg.Size()))
-		n165, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
+		n185, err := m.AccountRegisterAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n165
+		i += n185
 	}
 	return i, nil
 }
@@ -9173,11 +10267,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountTransferAccountMsg) MarshalTo(dAtA
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountTransferAccountMsg.Size()))
-		n166, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
+		n186, err := m.AccountTransferAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n166
+		i += n186
 	}
 	return i, nil
 }
@@ -9189,11 +10283,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountReplaceAccountTargetsMsg) MarshalT
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountReplaceAccountTargetsMsg.Size()))
-		n167, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
+		n187, err := m.AccountReplaceAccountTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n167
+		i += n187
 	}
 	return i, nil
 }
@@ -9205,11 +10299,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountDeleteAccountMsg) MarshalTo(dAtA [
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountMsg.Size()))
-		n168, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
+		n188, err := m.AccountDeleteAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n168
+		i += n188
 	}
 	return i, nil
 }
@@ -9221,11 +10315,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountFlushDomainMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountFlushDomainMsg.Size()))
-		n169, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
+		n189, err := m.AccountFlushDomainMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n169
+		i += n189
 	}
 	return i, nil
 }
@@ -9237,11 +10331,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountRenewAccountMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountRenewAccountMsg.Size()))
-		n170, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
+		n190, err := m.AccountRenewAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n170
+		i += n190
 	}
 	return i, nil
 }
@@ -9253,11 +10347,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountAddAccountCertificateMsg) MarshalT
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountAddAccountCertificateMsg.Size()))
-		n171, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n191, err := m.AccountAddAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n171
+		i += n191
 	}
 	return i, nil
 }
@@ -9269,11 +10363,11 @@ func (m *ExecuteProposalBatchMsg_Union_AccountDeleteAccountCertificateMsg) Marsh
 		dAtA[i] = 0x5
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AccountDeleteAccountCertificateMsg.Size()))
-		n172, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
+		n192, err := m.AccountDeleteAccountCertificateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n172
+		i += n192
 	}
 	return i, nil
 }
@@ -9285,11 +10379,11 @@ func (m *ExecuteProposalBatchMsg_Union_CashUpdateConfigurationMsg) MarshalTo(dAt
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashUpdateConfigurationMsg.Size()))
-		n173, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n193, err := m.CashUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n173
+		i += n193
 	}
 	return i, nil
 }
@@ -9301,11 +10395,11 @@ func (m *ExecuteProposalBatchMsg_Union_TxfeeUpdateConfigurationMsg) MarshalTo(dA
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TxfeeUpdateConfigurationMsg.Size()))
-		n174, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n194, err := m.TxfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n174
+		i += n194
 	}
 	return i, nil
 }
@@ -9317,11 +10411,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositCreateDepositContractMsg) Mars
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositCreateDepositContractMsg.Size()))
-		n175, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
+		n195, err := m.TermdepositCreateDepositContractMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n175
+		i += n195
 	}
 	return i, nil
 }
@@ -9333,11 +10427,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositDepositMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositDepositMsg.Size()))
-		n176, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
+		n196, err := m.TermdepositDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n176
+		i += n196
 	}
 	return i, nil
 }
@@ -9349,11 +10443,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositReleaseDepositMsg) MarshalTo(d
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositReleaseDepositMsg.Size()))
-		n177, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
+		n197, err := m.TermdepositReleaseDepositMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n177
+		i += n197
 	}
 	return i, nil
 }
@@ -9365,11 +10459,11 @@ func (m *ExecuteProposalBatchMsg_Union_TermdepositUpdateConfigurationMsg) Marsha
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.TermdepositUpdateConfigurationMsg.Size()))
-		n178, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n198, err := m.TermdepositUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n178
+		i += n198
 	}
 	return i, nil
 }
@@ -9381,11 +10475,11 @@ func (m *ExecuteProposalBatchMsg_Union_QualityscoreUpdateConfigurationMsg) Marsh
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.QualityscoreUpdateConfigurationMsg.Size()))
-		n179, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n199, err := m.QualityscoreUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n179
+		i += n199
 	}
 	return i, nil
 }
@@ -9397,11 +10491,11 @@ func (m *ExecuteProposalBatchMsg_Union_PreregistrationUpdateConfigurationMsg) Ma
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.PreregistrationUpdateConfigurationMsg.Size()))
-		n180, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n200, err := m.PreregistrationUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n180
+		i += n200
 	}
 	return i, nil
 }
@@ -9413,11 +10507,43 @@ func (m *ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) MarshalTo(d
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
-		n181, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		n201, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n181
+		i += n201
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SlashingUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SlashingUpdateConfigurationMsg.Size()))
+		n202, err := m.SlashingUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n202
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.StakingUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StakingUpdateConfigurationMsg.Size()))
+		n203, err := m.StakingUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n203
 	}
 	return i, nil
 }
@@ -9445,11 +10571,11 @@ func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if m.Sum != nil {
-		nn182, err := m.Sum.MarshalTo(dAtA[i:])
+		nn204, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn182
+		i += nn204
 	}
 	return i, nil
 }
@@ -9462,11 +10588,11 @@ func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n183, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n205, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n183
+		i += n205
 	}
 	return i, nil
 }
@@ -9478,11 +10604,11 @@ func (m *CronTask_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n184, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		n206, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n184
+		i += n206
 	}
 	return i, nil
 }
@@ -9494,11 +10620,11 @@ func (m *CronTask_DistributionDistributeMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionDistributeMsg.Size()))
-		n185, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
+		n207, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n185
+		i += n207
 	}
 	return i, nil
 }
@@ -9510,11 +10636,27 @@ func (m *CronTask_AswapReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReleaseMsg.Size()))
-		n186, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
+		n208, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n186
+		i += n208
+	}
+	return i, nil
+}
+func (m *CronTask_AswapReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.AswapReturnMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReturnMsg.Size()))
+		n209, err := m.AswapReturnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n209
 	}
 	return i, nil
 }
@@ -9526,11 +10668,27 @@ func (m *CronTask_GovTallyMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovTallyMsg.Size()))
-		n187, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
+		n210, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n187
+		i += n210
+	}
+	return i, nil
+}
+func (m *CronTask_StakingReleaseUnbondingMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.StakingReleaseUnbondingMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StakingReleaseUnbondingMsg.Size()))
+		n211, err := m.StakingReleaseUnbondingMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n211
 	}
 	return i, nil
 }
@@ -10195,6 +11353,114 @@ func (m *Tx_MsgfeeUpdateConfigurationMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *Tx_PaychanCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PaychanCreateMsg != nil {
+		l = m.PaychanCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_PaychanTransferMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PaychanTransferMsg != nil {
+		l = m.PaychanTransferMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_PaychanCloseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PaychanCloseMsg != nil {
+		l = m.PaychanCloseMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_SlashingUnjailMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashingUnjailMsg != nil {
+		l = m.SlashingUnjailMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_SlashingUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashingUpdateConfigurationMsg != nil {
+		l = m.SlashingUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_StakingBondMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakingBondMsg != nil {
+		l = m.StakingBondMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_StakingUnbondMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakingUnbondMsg != nil {
+		l = m.StakingUnbondMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_StakingUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakingUpdateConfigurationMsg != nil {
+		l = m.StakingUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CronCancelTaskMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CronCancelTaskMsg != nil {
+		l = m.CronCancelTaskMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *ExecuteBatchMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10714,124 +11980,232 @@ func (m *ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) Size() (n int) {
 	}
 	return n
 }
-func (m *ProposalOptions) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_PaychanCreateMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Option != nil {
-		n += m.Option.Size()
+	if m.PaychanCreateMsg != nil {
+		l = m.PaychanCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-
-func (m *ProposalOptions_CashSendMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_PaychanTransferMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CashSendMsg != nil {
-		l = m.CashSendMsg.Size()
+	if m.PaychanTransferMsg != nil {
+		l = m.PaychanTransferMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_EscrowReleaseMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_PaychanCloseMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EscrowReleaseMsg != nil {
-		l = m.EscrowReleaseMsg.Size()
+	if m.PaychanCloseMsg != nil {
+		l = m.PaychanCloseMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_UpdateEscrowPartiesMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_SlashingUnjailMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UpdateEscrowPartiesMsg != nil {
-		l = m.UpdateEscrowPartiesMsg.Size()
+	if m.SlashingUnjailMsg != nil {
+		l = m.SlashingUnjailMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_MultisigUpdateMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MultisigUpdateMsg != nil {
-		l = m.MultisigUpdateMsg.Size()
+	if m.SlashingUpdateConfigurationMsg != nil {
+		l = m.SlashingUpdateConfigurationMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_ValidatorsApplyDiffMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_StakingBondMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ValidatorsApplyDiffMsg != nil {
-		l = m.ValidatorsApplyDiffMsg.Size()
+	if m.StakingBondMsg != nil {
+		l = m.StakingBondMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_CurrencyCreateMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_StakingUnbondMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CurrencyCreateMsg != nil {
-		l = m.CurrencyCreateMsg.Size()
+	if m.StakingUnbondMsg != nil {
+		l = m.StakingUnbondMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_ExecuteProposalBatchMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ExecuteProposalBatchMsg != nil {
-		l = m.ExecuteProposalBatchMsg.Size()
+	if m.StakingUpdateConfigurationMsg != nil {
+		l = m.StakingUpdateConfigurationMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_UsernameRegisterTokenMsg) Size() (n int) {
+func (m *ExecuteBatchMsg_Union_CronCancelTaskMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UsernameRegisterTokenMsg != nil {
-		l = m.UsernameRegisterTokenMsg.Size()
+	if m.CronCancelTaskMsg != nil {
+		l = m.CronCancelTaskMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_UsernameTransferTokenMsg) Size() (n int) {
+func (m *ProposalOptions) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UsernameTransferTokenMsg != nil {
-		l = m.UsernameTransferTokenMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
+	if m.Option != nil {
+		n += m.Option.Size()
+	}
+	return n
+}
+
+func (m *ProposalOptions_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_EscrowReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReleaseMsg != nil {
+		l = m.EscrowReleaseMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_UpdateEscrowPartiesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateEscrowPartiesMsg != nil {
+		l = m.UpdateEscrowPartiesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_ValidatorsApplyDiffMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsApplyDiffMsg != nil {
+		l = m.ValidatorsApplyDiffMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CurrencyCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyCreateMsg != nil {
+		l = m.CurrencyCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_ExecuteProposalBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecuteProposalBatchMsg != nil {
+		l = m.ExecuteProposalBatchMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_UsernameRegisterTokenMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameRegisterTokenMsg != nil {
+		l = m.UsernameRegisterTokenMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_UsernameTransferTokenMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameTransferTokenMsg != nil {
+		l = m.UsernameTransferTokenMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) Size() (n int) {
@@ -11242,6 +12616,30 @@ func (m *ProposalOptions_MsgfeeUpdateConfigurationMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *ProposalOptions_SlashingUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashingUpdateConfigurationMsg != nil {
+		l = m.SlashingUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_StakingUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakingUpdateConfigurationMsg != nil {
+		l = m.StakingUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *ExecuteProposalBatchMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11749,6 +13147,30 @@ func (m *ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) Size() (n i
 	}
 	return n
 }
+func (m *ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashingUpdateConfigurationMsg != nil {
+		l = m.SlashingUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakingUpdateConfigurationMsg != nil {
+		l = m.StakingUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *CronTask) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11815,6 +13237,18 @@ func (m *CronTask_AswapReleaseMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *CronTask_AswapReturnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AswapReturnMsg != nil {
+		l = m.AswapReturnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *CronTask_GovTallyMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11827,6 +13261,18 @@ func (m *CronTask_GovTallyMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *CronTask_StakingReleaseUnbondingMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakingReleaseUnbondingMsg != nil {
+		l = m.StakingReleaseUnbondingMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 
 func sovCodec(x uint64) (n int) {
 	for {
@@ -13792,62 +15238,44 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaychanCreateMsg", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &paychan.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteBatchMsg: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Sum = &Tx_PaychanCreateMsg{v}
+			iNdEx = postIndex
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaychanTransferMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13874,25 +15302,271 @@ func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, ExecuteBatchMsg_Union{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &paychan.TransferMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Tx_PaychanTransferMsg{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaychanCloseMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &paychan.CloseMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_PaychanCloseMsg{v}
+			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnjailMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &slashing.UnjailMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_SlashingUnjailMsg{v}
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &slashing.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_SlashingUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 111:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingBondMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &staking.BondMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_StakingBondMsg{v}
+			iNdEx = postIndex
+		case 112:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUnbondMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &staking.UnbondMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_StakingUnbondMsg{v}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &staking.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_StakingUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 115:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronCancelTaskMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cron.CancelTaskMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CronCancelTaskMsg{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -13903,7 +15577,7 @@ func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
+func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13913,28 +15587,430 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowCodec
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteBatchMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, ExecuteBatchMsg_Union{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Union: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.SendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashSendMsg{v}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowCreateMsg{v}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ReleaseMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowReleaseMsg{v}
+			iNdEx = postIndex
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ReturnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowReturnMsg{v}
+			iNdEx = postIndex
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.UpdatePartiesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg{v}
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigCreateMsg{v}
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.UpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigUpdateMsg{v}
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &validators.ApplyDiffMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg{v}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &currency.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Union: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 51:
+			m.Sum = &ExecuteBatchMsg_Union_CurrencyCreateMsg{v}
+			iNdEx = postIndex
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13961,15 +16037,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &username.RegisterTokenMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_CashSendMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameRegisterTokenMsg{v}
 			iNdEx = postIndex
-		case 52:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13996,15 +16072,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.CreateMsg{}
+			v := &username.TransferTokenMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameTransferTokenMsg{v}
 			iNdEx = postIndex
-		case 53:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14031,15 +16107,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReleaseMsg{}
+			v := &username.ChangeTokenTargetsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowReleaseMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg{v}
 			iNdEx = postIndex
-		case 54:
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14066,15 +16142,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReturnMsg{}
+			v := &username.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowReturnMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 55:
+		case 66:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14101,15 +16177,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.UpdatePartiesMsg{}
+			v := &distribution.CreateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionCreateMsg{v}
 			iNdEx = postIndex
-		case 56:
+		case 67:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14136,15 +16212,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.CreateMsg{}
+			v := &distribution.DistributeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MultisigCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 68:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14171,15 +16247,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &distribution.ResetMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MultisigUpdateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionResetMsg{v}
 			iNdEx = postIndex
-		case 58:
+		case 80:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14206,15 +16282,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &msgfee.SetMsgFeeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg{v}
 			iNdEx = postIndex
-		case 59:
+		case 81:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatamigrationExecuteMigrationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14241,15 +16317,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &currency.CreateMsg{}
+			v := &datamigration.ExecuteMigrationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_CurrencyCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_DatamigrationExecuteMigrationMsg{v}
 			iNdEx = postIndex
-		case 61:
+		case 82:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14276,15 +16352,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.RegisterTokenMsg{}
+			v := &account.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameRegisterTokenMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 62:
+		case 83:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14311,15 +16387,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.TransferTokenMsg{}
+			v := &account.RegisterDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameTransferTokenMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountRegisterDomainMsg{v}
 			iNdEx = postIndex
-		case 63:
+		case 84:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountMsgFeesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14346,15 +16422,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.ChangeTokenTargetsMsg{}
+			v := &account.ReplaceAccountMsgFeesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountReplaceAccountMsgFeesMsg{v}
 			iNdEx = postIndex
-		case 64:
+		case 85:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14381,15 +16457,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.UpdateConfigurationMsg{}
+			v := &account.TransferDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountTransferDomainMsg{v}
 			iNdEx = postIndex
-		case 66:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14416,15 +16492,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.CreateMsg{}
+			v := &account.RenewDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountRenewDomainMsg{v}
 			iNdEx = postIndex
-		case 67:
+		case 87:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14451,15 +16527,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.DistributeMsg{}
+			v := &account.DeleteDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteDomainMsg{v}
 			iNdEx = postIndex
-		case 68:
+		case 88:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14486,15 +16562,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.ResetMsg{}
+			v := &account.RegisterAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionResetMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountRegisterAccountMsg{v}
 			iNdEx = postIndex
-		case 80:
+		case 89:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14521,15 +16597,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.SetMsgFeeMsg{}
+			v := &account.TransferAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountTransferAccountMsg{v}
 			iNdEx = postIndex
-		case 81:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatamigrationExecuteMigrationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountTargetsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14556,15 +16632,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &datamigration.ExecuteMigrationMsg{}
+			v := &account.ReplaceAccountTargetsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DatamigrationExecuteMigrationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountReplaceAccountTargetsMsg{v}
 			iNdEx = postIndex
-		case 82:
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14591,15 +16667,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.UpdateConfigurationMsg{}
+			v := &account.DeleteAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteAccountMsg{v}
 			iNdEx = postIndex
-		case 83:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14626,15 +16702,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RegisterDomainMsg{}
+			v := &account.FlushDomainMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountRegisterDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountFlushDomainMsg{v}
 			iNdEx = postIndex
-		case 84:
+		case 93:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountMsgFeesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14661,15 +16737,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.ReplaceAccountMsgFeesMsg{}
+			v := &account.RenewAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountReplaceAccountMsgFeesMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountRenewAccountMsg{v}
 			iNdEx = postIndex
-		case 85:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14696,15 +16772,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.TransferDomainMsg{}
+			v := &account.AddAccountCertificateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountTransferDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountAddAccountCertificateMsg{v}
 			iNdEx = postIndex
-		case 86:
+		case 95:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14731,15 +16807,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RenewDomainMsg{}
+			v := &account.DeleteAccountCertificateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountRenewDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteAccountCertificateMsg{v}
 			iNdEx = postIndex
-		case 87:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14766,15 +16842,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteDomainMsg{}
+			v := &cash.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CashUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 88:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRegisterAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14801,15 +16877,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RegisterAccountMsg{}
+			v := &txfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountRegisterAccountMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TxfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 89:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountTransferAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14836,15 +16912,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.TransferAccountMsg{}
+			v := &termdeposit.CreateDepositContractMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountTransferAccountMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TermdepositCreateDepositContractMsg{v}
 			iNdEx = postIndex
-		case 90:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountReplaceAccountTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14871,15 +16947,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.ReplaceAccountTargetsMsg{}
+			v := &termdeposit.DepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountReplaceAccountTargetsMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TermdepositDepositMsg{v}
 			iNdEx = postIndex
-		case 91:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14906,15 +16982,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteAccountMsg{}
+			v := &termdeposit.ReleaseDepositMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteAccountMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TermdepositReleaseDepositMsg{v}
 			iNdEx = postIndex
-		case 92:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountFlushDomainMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14941,15 +17017,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.FlushDomainMsg{}
+			v := &termdeposit.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountFlushDomainMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_TermdepositUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 93:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRenewAccountMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14976,15 +17052,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.RenewAccountMsg{}
+			v := &qualityscore.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountRenewAccountMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 94:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15011,15 +17087,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.AddAccountCertificateMsg{}
+			v := &preregistration.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountAddAccountCertificateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 95:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDeleteAccountCertificateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15046,15 +17122,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &account.DeleteAccountCertificateMsg{}
+			v := &msgfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_AccountDeleteAccountCertificateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 97:
+		case 106:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaychanCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15081,15 +17157,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.UpdateConfigurationMsg{}
+			v := &paychan.CreateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_CashUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_PaychanCreateMsg{v}
 			iNdEx = postIndex
-		case 98:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaychanTransferMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15116,15 +17192,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &txfee.UpdateConfigurationMsg{}
+			v := &paychan.TransferMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_TxfeeUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_PaychanTransferMsg{v}
 			iNdEx = postIndex
-		case 99:
+		case 108:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositCreateDepositContractMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaychanCloseMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15151,15 +17227,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.CreateDepositContractMsg{}
+			v := &paychan.CloseMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_TermdepositCreateDepositContractMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_PaychanCloseMsg{v}
 			iNdEx = postIndex
-		case 100:
+		case 109:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnjailMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15186,15 +17262,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.DepositMsg{}
+			v := &slashing.UnjailMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_TermdepositDepositMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_SlashingUnjailMsg{v}
 			iNdEx = postIndex
-		case 101:
+		case 110:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositReleaseDepositMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15221,15 +17297,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.ReleaseDepositMsg{}
+			v := &slashing.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_TermdepositReleaseDepositMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_SlashingUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 102:
+		case 111:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermdepositUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingBondMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15256,15 +17332,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &termdeposit.UpdateConfigurationMsg{}
+			v := &staking.BondMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_TermdepositUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_StakingBondMsg{v}
 			iNdEx = postIndex
-		case 103:
+		case 112:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QualityscoreUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUnbondMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15291,15 +17367,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &qualityscore.UpdateConfigurationMsg{}
+			v := &staking.UnbondMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_QualityscoreUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_StakingUnbondMsg{v}
 			iNdEx = postIndex
-		case 104:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreregistrationUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15326,15 +17402,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &preregistration.UpdateConfigurationMsg{}
+			v := &staking.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_PreregistrationUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_StakingUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 105:
+		case 115:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CronCancelTaskMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15361,11 +17437,11 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.UpdateConfigurationMsg{}
+			v := &cron.CancelTaskMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CronCancelTaskMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16925,6 +19001,76 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			}
 			m.Option = &ProposalOptions_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &slashing.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_SlashingUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &staking.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_StakingUpdateConfigurationMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -18465,6 +20611,76 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &slashing.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_SlashingUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &staking.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_StakingUpdateConfigurationMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -18690,6 +20906,41 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &CronTask_AswapReleaseMsg{v}
 			iNdEx = postIndex
+		case 72:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapReturnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &aswap.ReturnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_AswapReturnMsg{v}
+			iNdEx = postIndex
 		case 76:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GovTallyMsg", wireType)
@@ -18725,6 +20976,41 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &CronTask_GovTallyMsg{v}
 			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingReleaseUnbondingMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &staking.ReleaseUnbondingMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_StakingReleaseUnbondingMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/staking"
 )
 
 // CronTaskMarshaler is a task marshaler implementation to be used by the bnsd
@@ -43,10 +44,18 @@ func (taskMarshaler) MarshalTask(auth []weave.Condition, msg weave.Msg) ([]byte,
 		t.Sum = &CronTask_AswapReleaseMsg{
 			AswapReleaseMsg: msg,
 		}
+	case *aswap.ReturnMsg:
+		t.Sum = &CronTask_AswapReturnMsg{
+			AswapReturnMsg: msg,
+		}
 	case *gov.TallyMsg:
 		t.Sum = &CronTask_GovTallyMsg{
 			GovTallyMsg: msg,
 		}
+	case *staking.ReleaseUnbondingMsg:
+		t.Sum = &CronTask_StakingReleaseUnbondingMsg{
+			StakingReleaseUnbondingMsg: msg,
+		}
 	}
 
 	raw, err := t.Marshal()
@@ -10,19 +10,29 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/cmd/bnsd/x/account"
+	"github.com/iov-one/weave/cmd/bnsd/x/preregistration"
+	"github.com/iov-one/weave/cmd/bnsd/x/qualityscore"
 	"github.com/iov-one/weave/cmd/bnsd/x/termdeposit"
 	"github.com/iov-one/weave/cmd/bnsd/x/username"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/commands/server"
 	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/datamigration"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x/aswap"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/paychan"
+	"github.com/iov-one/weave/x/sigs"
+	"github.com/iov-one/weave/x/slashing"
+	"github.com/iov-one/weave/x/staking"
 	"github.com/iov-one/weave/x/txfee"
 	"github.com/iov-one/weave/x/validators"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -95,23 +105,118 @@ func GenerateApp(options *server.Options) (abci.Application, error) {
 
 // DecorateApp adds initializers and Logger to an Application
 func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
-	application.WithInit(app.ChainInitializers(
+	exts := genesisExtensions()
+	inits := make([]weave.Initializer, len(exts))
+	for i, e := range exts {
+		inits[i] = e
+	}
+	application.WithInit(app.ChainInitializers(inits...))
+	application.WithLogger(logger)
+	return application
+}
+
+// GenesisExporter returns an exporter that writes the state of all
+// extensions initialized by DecorateApp in the genesis file format. Export
+// fails if any state that is not exported is stored.
+func GenesisExporter() weave.Exporter {
+	exts := genesisExtensions()
+	exps := make([]weave.Exporter, len(exts))
+	for i, e := range exts {
+		exps[i] = e
+	}
+	exps = append(exps, app.RequireExported(exportedPrefixes...))
+	return app.ChainExporters(exps...)
+}
+
+// exportedPrefixes are the key prefixes of all state that is written to the
+// genesis file by the extensions returned by genesisExtensions. Indexes and
+// aggregates are rebuilt when entities are loaded.
+var exportedPrefixes = []string{
+	// Generic state: gconf configurations, sequences, indexes, aggregates,
+	// chain ID and validator updates.
+	"_c:", "_s.", "_i.", "_x.", "_xc.", "_c.", "_wv:", "_1:",
+	// migration
+	"schema:",
+	// multisig
+	"contracts:",
+	// cash
+	"cash:",
+	// currency
+	"tokeninfo:",
+	// validators
+	"uvalid:",
+	// distribution
+	"revenue:",
+	// msgfee
+	"msgfee:",
+	// escrow
+	"esc:",
+	// gov
+	"electorate:", "electnrule:", "proposal:", "vote:", "resolution:",
+	// username
+	"tokens:",
+	// account
+	"domain:", "account:",
+	// termdeposit
+	"depcontr:", "deposit:",
+	// slashing
+	"signinfo:", "evidence:",
+	// staking
+	"delegation:", "stake:", "unbonding:",
+	// cron
+	"task:", "trs:", "_crontask:",
+	// aswap
+	"swap:",
+	// paychan
+	"paychan:",
+	// qualityscore
+	"_qualityscore:",
+	// preregistration
+	"records:",
+	// datamigration
+	"execmig:",
+	// sigs
+	"sigs:",
+}
+
+// genesisExtension is implemented by all extensions that keep their state in
+// the genesis file.
+type genesisExtension interface {
+	weave.Initializer
+	weave.Exporter
+}
+
+// genesisExtensions returns all extensions that are loaded from the genesis
+// file, in the order they must be initialized. Cron tasks are loaded before
+// extensions that schedule tasks, so that their task IDs are not reused.
+// Sequences are restored last, after all entities were loaded.
+func genesisExtensions() []genesisExtension {
+	scheduler := cron.NewScheduler(CronTaskMarshaler)
+	return []genesisExtension{
 		&migration.Initializer{},
+		&cron.Initializer{},
 		&multisig.Initializer{},
 		&cash.Initializer{},
 		&currency.Initializer{},
 		&validators.Initializer{},
 		&distribution.Initializer{},
 		&msgfee.Initializer{},
-		&escrow.Initializer{Minter: cash.NewController(cash.NewBucket())},
+		&escrow.Initializer{Minter: cash.NewController(cash.NewBucket()), Scheduler: scheduler},
+		&aswap.Initializer{Scheduler: scheduler},
+		&paychan.Initializer{},
 		&gov.Initializer{},
 		&username.Initializer{},
 		&account.Initializer{},
 		&txfee.Initializer{},
 		&termdeposit.Initializer{},
-	))
-	application.WithLogger(logger)
-	return application
+		&qualityscore.Initializer{},
+		&preregistration.Initializer{},
+		&datamigration.Initializer{},
+		&sigs.Initializer{},
+		&slashing.Initializer{},
+		&staking.Initializer{Scheduler: scheduler},
+		&orm.SequenceInitializer{},
+	}
 }
 
 // InlineApp will take a previously prepared CommitStore and return a complete Application
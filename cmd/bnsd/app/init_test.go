@@ -1,11 +1,22 @@
 package bnsd
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/paychan"
+	"github.com/iov-one/weave/x/sigs"
 )
 
 func TestGenInitOptions(t *testing.T) {
@@ -37,3 +48,194 @@ func TestGenInitOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisExportRoundTrip(t *testing.T) {
+	const genesis = `{
+		"cash": [
+			{"address": "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0", "coins": ["50000 ETH", "1234 FRNK"]}
+		],
+		"conf": {
+			"cash": {"collector_address": "seq:dist/revenue/1", "minimal_fee": "0.01 FRNK"},
+			"migration": {"admin": "seq:multisig/usage/1"},
+			"msgfee": {"owner": "seq:admin/admin/1", "fee_admin": "seq:admin/admin/1"},
+			"username": {
+				"owner": "seq:uname/admin/1",
+				"valid_username_name": "^[a-z0-9\\-_.]{3,64}$",
+				"valid_username_label": "^iov$"
+			}
+		},
+		"initialize_schema": [
+			{"ver": 1, "pkg": "aswap"},
+			{"ver": 1, "pkg": "cash"},
+			{"ver": 1, "pkg": "cron"},
+			{"ver": 1, "pkg": "currency"},
+			{"ver": 1, "pkg": "datamigration"},
+			{"ver": 1, "pkg": "distribution"},
+			{"ver": 1, "pkg": "escrow"},
+			{"ver": 1, "pkg": "msgfee"},
+			{"ver": 1, "pkg": "multisig"},
+			{"ver": 1, "pkg": "paychan"},
+			{"ver": 1, "pkg": "preregistration"},
+			{"ver": 1, "pkg": "qualityscore"},
+			{"ver": 1, "pkg": "sigs"},
+			{"ver": 1, "pkg": "termdeposit"},
+			{"ver": 1, "pkg": "username"},
+			{"ver": 1, "pkg": "validators"}
+		],
+		"currencies": [
+			{"ticker": "FRNK", "name": "Utility token of this chain"},
+			{"ticker": "ETH", "name": "Other token of this chain"}
+		],
+		"update_validators": {"addresses": ["seq:multisig/usage/1"]},
+		"multisig": [
+			{
+				"participants": [{"weight": 1, "signature": "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0"}],
+				"activation_threshold": 1,
+				"admin_threshold": 1
+			}
+		],
+		"distribution": [
+			{
+				"admin": "seq:multisig/usage/1",
+				"destinations": [{"weight": 1, "address": "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0"}]
+			}
+		],
+		"escrow": [
+			{
+				"source": "0000000000000000000000000000000000000000",
+				"arbiter": "seq:multisig/usage/1",
+				"destination": "seq:dist/revenue/1",
+				"amount": ["1000000 FRNK"],
+				"timeout": "2050-01-01T00:00:00Z"
+			}
+		],
+		"msgfee": [
+			{"msg_path": "distribution/create", "fee": "2 FRNK"}
+		],
+		"username": [
+			{"username": "alice*iov", "owner": "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0", "targets": []}
+		],
+		"aswap": [
+			{
+				"id": 3,
+				"preimage_hash": "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=",
+				"source": "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0",
+				"destination": "seq:dist/revenue/1",
+				"timeout": "2050-01-01T00:00:00Z"
+			}
+		],
+		"depositcontract": [
+			{"id": 2, "valid_since": 1000, "valid_until": 2000}
+		],
+		"deposit": [
+			{
+				"id": 4,
+				"deposit_contract_id": 2,
+				"amount": "10 FRNK",
+				"rate": {"numerator": 1, "denominator": 10},
+				"depositor": "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0",
+				"created_at": 1500
+			}
+		],
+		"preregistration": [
+			{"domain": "wunderland", "owner": "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0"}
+		],
+		"datamigration": [
+			{"migration_id": "fix-0001"}
+		],
+		"qualityscore": {
+			"load": {"numerator": 3, "denominator": 2},
+			"score": {"numerator": 1, "denominator": 1}
+		}
+	}`
+
+	var opts weave.Options
+	assert.Nil(t, json.Unmarshal([]byte(genesis), &opts))
+
+	exts := genesisExtensions()
+	inits := make([]weave.Initializer, len(exts))
+	for i, e := range exts {
+		inits[i] = e
+	}
+	init := app.ChainInitializers(inits...)
+	exp := GenesisExporter()
+
+	db := iavl.MockCommitStore().CacheWrap()
+	assert.Nil(t, init.FromGenesis(opts, weave.GenesisParams{}, db))
+
+	// Signers and payment channels are not declared in the genesis file
+	// written by hand, because public keys are serialized using protobuf.
+	pubkey := crypto.GenPrivKeyEd25519().PublicKey()
+	signer := sigs.NewUser(pubkey)
+	sigs.AsUser(signer).Sequence = 7
+	assert.Nil(t, sigs.NewBucket().Save(db, signer))
+	total := coin.NewCoin(5, 0, "FRNK")
+	transferred := coin.NewCoin(1, 0, "FRNK")
+	_, err := paychan.NewPaymentChannelBucket().Put(db, nil, &paychan.PaymentChannel{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Source:       pubkey.Address(),
+		SourcePubkey: pubkey,
+		Destination:  weavetest.NewCondition().Address(),
+		Total:        &total,
+		Timeout:      weave.AsUnixTime(time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Transferred:  &transferred,
+		Address:      weave.NewCondition("paychan", "seq", weavetest.SequenceID(1)).Address(),
+	})
+	assert.Nil(t, err)
+
+	exported, err := exp.ToGenesis(db)
+	assert.Nil(t, err)
+	for key := range opts {
+		if _, ok := exported[key]; !ok {
+			t.Errorf("%q not exported", key)
+		}
+	}
+
+	// Exported state must survive serialization, as it does when written
+	// to a genesis file.
+	raw, err := json.Marshal(exported)
+	assert.Nil(t, err)
+	var reopts weave.Options
+	assert.Nil(t, json.Unmarshal(raw, &reopts))
+
+	redb := iavl.MockCommitStore().CacheWrap()
+	assert.Nil(t, init.FromGenesis(reopts, weave.GenesisParams{}, redb))
+	reexported, err := exp.ToGenesis(redb)
+	assert.Nil(t, err)
+
+	assert.Equal(t, exported, reexported)
+	assert.Equal(t, dump(t, db), dump(t, redb))
+}
+
+func TestGenesisExportUnknownState(t *testing.T) {
+	exp := app.RequireExported(exportedPrefixes...)
+
+	db := iavl.MockCommitStore().CacheWrap()
+	_, err := exp.ToGenesis(db)
+	assert.Nil(t, err)
+
+	assert.Nil(t, db.Set([]byte("unknown:\x00\x00\x00\x00\x00\x00\x00\x01"), []byte("unknown")))
+	if _, err := exp.ToGenesis(db); !errors.ErrState.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+// dump returns all key value pairs stored in given database.
+func dump(t testing.TB, db weave.ReadOnlyKVStore) map[string]string {
+	t.Helper()
+	it, err := db.Iterator(nil, nil)
+	assert.Nil(t, err)
+	defer it.Release()
+
+	res := make(map[string]string)
+	for {
+		switch key, value, err := it.Next(); {
+		case err == nil:
+			res[string(key)] = string(value)
+		case errors.ErrIteratorDone.Is(err):
+			return res
+		default:
+			t.Fatalf("cannot iterate: %s", err)
+		}
+	}
+}
@@ -118,6 +118,8 @@ func appStateGenesis(keyAddress weave.Address) []byte {
 			{"ver": 1, "pkg": "multisig"},
 			{"ver": 1, "pkg": "paychan"},
 			{"ver": 1, "pkg": "sigs"},
+			{"ver": 1, "pkg": "slashing"},
+			{"ver": 1, "pkg": "staking"},
 			{"ver": 1, "pkg": "username"},
 			{"ver": 1, "pkg": "utils"},
 			{"ver": 1, "pkg": "validators"},
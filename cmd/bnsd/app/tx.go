@@ -27,12 +27,18 @@ var _ weave.Tx = (*Tx)(nil)
 var _ cash.FeeTx = (*Tx)(nil)
 var _ sigs.SignedTx = (*Tx)(nil)
 var _ multisig.MultiSigTx = (*Tx)(nil)
+var _ weave.GasLimitedTx = (*Tx)(nil)
 
 // GetMsg switches over all types defined in the protobuf file
 func (tx *Tx) GetMsg() (weave.Msg, error) {
 	return weave.ExtractMsgFromSum(tx.GetSum())
 }
 
+// GetGasLimit returns the gas limit declared in the fee info.
+func (tx *Tx) GetGasLimit() int64 {
+	return tx.GetFees().GetGasLimit()
+}
+
 // GetSignBytes returns the bytes to sign...
 func (tx *Tx) GetSignBytes() ([]byte, error) {
 	// temporarily unset the signatures, as the sign bytes
@@ -154,12 +154,23 @@ type AbciResponse struct {
 	// a list of key/value pairs
 	Models []weave.Model
 	Height int64
+	// Cursor is set when the query result is paginated and there are more
+	// results. Use it to request the next page.
+	Cursor []byte
+}
+
+// HasMore returns true if there are more results that can be requested
+// using the cursor.
+func (r AbciResponse) HasMore() bool {
+	return len(r.Cursor) != 0
 }
 
 // AbciQuery calls abci query on tendermint rpc,
 // verifies if it is an error or empty, and if there is
 // data pulls out the ResultSets from keys and values into
 // a useful AbciResponse struct
+//
+// Use weave.QueryPath to build a path of a paginated query.
 func (b *BnsClient) AbciQuery(path string, data []byte) (AbciResponse, error) {
 	var out AbciResponse
 
@@ -188,6 +199,7 @@ func (b *BnsClient) AbciQuery(path string, data []byte) (AbciResponse, error) {
 		return out, err
 	}
 
+	out.Cursor = keys.Cursor
 	out.Models, err = app.JoinResults(&keys, &vals)
 	return out, err
 }
@@ -5,8 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/iov-one/weave"
+	weaveclient "github.com/iov-one/weave/client"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
 	"github.com/tendermint/tendermint/rpc/client"
 	rpctest "github.com/tendermint/tendermint/rpc/test"
 )
@@ -67,6 +70,50 @@ func TestWalletQuery(t *testing.T) {
 	assert.Equal(t, initBalance.Ticker, coin.Ticker)
 }
 
+func TestPaginatedAbciQuery(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bcp := NewClient(conn)
+	client.WaitForHeight(conn, 5, fastWaiter)
+
+	all, err := bcp.AbciQuery("/schemas?"+weave.PrefixQueryMod, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, false, all.HasMore())
+
+	var (
+		page = weave.QueryPage{Limit: 5}
+		keys [][]byte
+	)
+	for {
+		path := weave.QueryPath("/schemas", weave.PrefixQueryMod, page)
+		resp, err := bcp.AbciQuery(path, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, true, len(resp.Models) <= page.Limit)
+		for _, m := range resp.Models {
+			keys = append(keys, m.Key)
+		}
+		if !resp.HasMore() {
+			break
+		}
+		page.Cursor = resp.Cursor
+	}
+	assert.Equal(t, len(all.Models), len(keys))
+	for i, m := range all.Models {
+		assert.Equal(t, m.Key, keys[i])
+	}
+}
+
+func TestTypedBalanceQuery(t *testing.T) {
+	conn := NewLocalConnection(node)
+	client.WaitForHeight(conn, 5, fastWaiter)
+
+	wc := weaveclient.NewClient(conn)
+	req := &cash.BalanceRequest{Address: faucet.PublicKey().Address(), Ticker: initBalance.Ticker}
+	var resp cash.BalanceResponse
+	assert.Nil(t, wc.TypedQuery("/balances", req, &resp))
+	assert.Equal(t, 1, len(resp.Coins))
+	assert.Equal(t, initBalance.Ticker, resp.Coins[0].Ticker)
+}
+
 func TestNonce(t *testing.T) {
 	addr := GenPrivateKey().PublicKey().Address()
 	conn := NewLocalConnection(node)
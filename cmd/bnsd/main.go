@@ -34,6 +34,9 @@ func helpMessage() {
 	fmt.Println("start     Run the abci server")
 	fmt.Println("getblock  Extract a block from blockchain.db")
 	fmt.Println("retry     Run last block again to ensure it produces same result")
+	fmt.Println("export-snapshot  Write the application state into a snapshot directory")
+	fmt.Println("import-snapshot  Rebuild the application state from a snapshot directory")
+	fmt.Println("export-genesis  Write the application state as a genesis file")
 	fmt.Println("testgen   Generate various protoc and json files to test against")
 	fmt.Println("version   Print the app version")
 	fmt.Println(`
@@ -67,6 +70,12 @@ func main() {
 		err = server.GetBlockCmd(rest)
 	case "retry":
 		err = server.RetryCmd(bnsd.InlineApp, logger, *varHome, rest)
+	case "export-snapshot":
+		err = server.ExportSnapshotCmd(rest)
+	case "import-snapshot":
+		err = server.ImportSnapshotCmd(rest)
+	case "export-genesis":
+		err = server.ExportGenesisCmd(bnsd.GenesisExporter(), *varHome, rest)
 	case "testgen":
 		err = commands.TestGenCmd(bnsd.Examples(), rest)
 	case "version":
@@ -265,6 +265,11 @@ func initGenesis(t testing.TB, env *EnvConf, filename string) {
 				ValidUsernameLabel: `^iov$`,
 				Owner:              mustParseAddr(t, "seq:uname/admin/1"),
 			},
+			"slashing": dict{
+				// Jailing for missed blocks is disabled unless
+				// enabled by the test.
+				"owner": env.Alice.PublicKey().Address(),
+			},
 			"txfee": txfee.Configuration{
 				Owner:     mustParseAddr(t, "seq:txfee/admin/1"),
 				BaseFee:   coin.NewCoin(0, 20, "IOV"),
@@ -308,6 +313,8 @@ func initGenesis(t testing.TB, env *EnvConf, filename string) {
 			{"ver": 1, "pkg": "multisig"},
 			{"ver": 1, "pkg": "paychan"},
 			{"ver": 1, "pkg": "sigs"},
+			{"ver": 1, "pkg": "slashing"},
+			{"ver": 1, "pkg": "staking"},
 			{"ver": 1, "pkg": "username"},
 			{"ver": 1, "pkg": "utils"},
 			{"ver": 1, "pkg": "validators"},
@@ -0,0 +1,104 @@
+package scenarios
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	bnsdApp "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/cmd/bnsd/client"
+	"github.com/iov-one/weave/cmd/bnsd/scenarios/bnsdtest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/slashing"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestJailValidatorForMissedBlocks(t *testing.T) {
+	env, cleanup := bnsdtest.StartBnsd(t)
+	defer cleanup()
+
+	if env.IsRemote() {
+		t.Skip("validator set is modified")
+	}
+
+	// Jailing for missed blocks is disabled in the genesis. Enable it, so
+	// that a validator that is not signing is quickly removed.
+	confTx := &bnsdApp.Tx{
+		Sum: &bnsdApp.Tx_SlashingUpdateConfigurationMsg{
+			SlashingUpdateConfigurationMsg: &slashing.UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &slashing.Configuration{
+					Metadata:          &weave.Metadata{Schema: 1},
+					MissedBlocksLimit: 2,
+				},
+			},
+		},
+	}
+	confTx.Fee(env.Alice.PublicKey().Address(), env.AntiSpamFee)
+	bnsdtest.MustSignTx(t, env, confTx, env.Alice)
+	bnsdtest.MustBroadcastTx(t, env, confTx)
+
+	// A validator that is not running a node cannot sign any block.
+	absent := ed25519.GenPrivKey().PubKey().(ed25519.PubKeyEd25519)
+	pubKey := weave.PubKey{Type: "ed25519", Data: absent[:]}
+
+	addValidatorTx := client.SetValidatorTx(weave.ValidatorUpdate{PubKey: pubKey, Power: 1})
+	addValidatorTx.Fee(env.Alice.PublicKey().Address(), env.AntiSpamFee)
+	_, _, contractID, err := env.MultiSigContract.Parse()
+	if err != nil {
+		t.Fatalf("cannot parse multisig contract: %s", err)
+	}
+	addValidatorTx.Multisig = [][]byte{contractID}
+	bnsdtest.MustSignTx(t, env, addValidatorTx, env.Alice)
+	resp := bnsdtest.MustBroadcastTx(t, env, addValidatorTx)
+
+	tmValidatorSet := awaitValidatorUpdate(env, resp.Height+2)
+	assert.Equal(t, true, tmValidatorSet != nil)
+	assert.Equal(t, true, contains(tmValidatorSet.Validators, absent))
+
+	info := awaitJailed(t, env, slashing.ValidatorAddress(pubKey), 15*time.Second)
+	assert.Equal(t, int64(1), info.Power)
+
+	// Jailed validator is removed from the tendermint validator set. The
+	// change takes effect with a delay of two blocks.
+	admin := client.Admin(client.NewClient(env.Client.TendermintClient()))
+	for i := 0; ; i++ {
+		current, err := admin.GetValidators(client.CurrentHeight)
+		assert.Nil(t, err)
+		if !contains(current.Validators, absent) {
+			break
+		}
+		if i == 15 {
+			t.Fatal("jailed validator was not removed from the validator set")
+		}
+		time.Sleep(time.Duration(i) * 50 * time.Millisecond)
+	}
+}
+
+// awaitJailed waits until the validator with given address is jailed and
+// returns its signing information.
+func awaitJailed(t testing.TB, env *bnsdtest.EnvConf, address weave.Address, timeout time.Duration) *slashing.SigningInfo {
+	t.Helper()
+
+	stop := time.After(timeout)
+	for {
+		resp, err := env.Client.AbciQuery("/signinfos", address)
+		if err != nil {
+			t.Fatalf("cannot query signing info: %s", err)
+		}
+		if len(resp.Models) == 1 {
+			var info slashing.SigningInfo
+			if err := info.Unmarshal(resp.Models[0].Value); err != nil {
+				t.Fatalf("cannot unmarshal signing info: %s", err)
+			}
+			if info.Jailed {
+				return &info
+			}
+		}
+		select {
+		case <-stop:
+			t.Fatalf("timeout: validator %s was not jailed on time", address)
+		case <-time.After(time.Second / 2):
+		}
+	}
+}
@@ -190,13 +190,8 @@ func (h *registerDomainHandler) validate(ctx weave.Context, db weave.KVStore, tx
 		return nil, nil, errors.Wrap(err, "load msg")
 	}
 
-	switch err := h.domains.Has(db, []byte(msg.Domain)); {
-	case err == nil:
-		return nil, nil, errors.Wrapf(errors.ErrDuplicate, "domain %q already registered", msg.Domain)
-	case errors.ErrNotFound.Is(err):
-		// All good.
-	default:
-		return nil, nil, errors.Wrap(err, "cannot check if domain already exists")
+	if err := orm.CheckUnique(db, h.domains, []byte(msg.Domain)); err != nil {
+		return nil, nil, errors.Wrapf(err, "domain %q", msg.Domain)
 	}
 
 	conf, err := loadConf(db)
@@ -590,13 +585,8 @@ func (h *registerAccountHandler) validate(ctx weave.Context, db weave.KVStore, t
 	if weave.IsExpired(ctx, domain.ValidUntil) {
 		return nil, nil, errors.Wrap(errors.ErrExpired, "domain is expired")
 	}
-	switch err := h.accounts.Has(db, accountKey(msg.Name, msg.Domain)); {
-	case errors.ErrNotFound.Is(err):
-		// All good.
-	case err == nil:
-		return nil, nil, errors.Wrap(errors.ErrDuplicate, "account already exists")
-	default:
-		return nil, nil, errors.Wrap(err, "cannot check is account exists")
+	if err := orm.CheckUnique(db, h.accounts, accountKey(msg.Name, msg.Domain)); err != nil {
+		return nil, nil, errors.Wrap(err, "account")
 	}
 	if ok, err := regexp.MatchString(conf.ValidName, msg.Name); err != nil || !ok {
 		return nil, nil, errors.Wrap(errors.ErrInput, "name is not allowed")
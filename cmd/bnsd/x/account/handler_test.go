@@ -13,6 +13,7 @@ import (
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
@@ -25,6 +26,9 @@ func TestUseCases(t *testing.T) {
 		Tx          weave.Tx
 		BlockHeight int64
 		WantErr     *errors.Error
+		// WantConflict, if set, is the key of the entity that the
+		// request must conflict with.
+		WantConflict []byte
 	}
 
 	var (
@@ -72,8 +76,9 @@ func TestUseCases(t *testing.T) {
 							Broker:       brokerCond.Address(),
 						},
 					},
-					BlockHeight: 2,
-					WantErr:     errors.ErrDuplicate,
+					BlockHeight:  2,
+					WantErr:      errors.ErrDuplicate,
+					WantConflict: []byte("wunderland"),
 				},
 			},
 		},
@@ -119,8 +124,9 @@ func TestUseCases(t *testing.T) {
 							Name:     "bob",
 						},
 					},
-					BlockHeight: 102,
-					WantErr:     errors.ErrDuplicate,
+					BlockHeight:  102,
+					WantErr:      errors.ErrDuplicate,
+					WantConflict: []byte("bob*wunderland"),
 				},
 				{
 					Now:        now + 3,
@@ -2666,9 +2672,17 @@ func TestUseCases(t *testing.T) {
 					t.Fatalf("unexpected %d check error: want %q, got %+v", i, req.WantErr, err)
 				}
 				cache.Discard()
-				if _, err := rt.Deliver(ctx, db, req.Tx); !req.WantErr.Is(err) {
+				_, err := rt.Deliver(ctx, db, req.Tx)
+				if !req.WantErr.Is(err) {
 					t.Fatalf("unexpected %d deliver error: want %q, got %+v", i, req.WantErr, err)
 				}
+				if req.WantConflict != nil {
+					uerr, ok := orm.AsUniqueIndexError(err)
+					if !ok {
+						t.Fatalf("want %d deliver unique index error, got %+v", i, err)
+					}
+					assert.Equal(t, req.WantConflict, uerr.Key)
+				}
 			}
 
 			if tc.AfterTest != nil {
@@ -4,6 +4,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -11,6 +12,32 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisDomain struct {
+	Domain       string             `json:"domain"`
+	Admin        weave.Address      `json:"admin"`
+	ValidUntil   weave.UnixTime     `json:"valid_until"`
+	AccountRenew weave.UnixDuration `json:"account_renew"`
+	HasSuperuser bool               `json:"has_superuser"`
+	MsgFees      []AccountMsgFee    `json:"msg_fees,omitempty"`
+	Broker       weave.Address      `json:"broker,omitempty"`
+}
+
+type genesisAccount struct {
+	Domain       string              `json:"domain"`
+	Name         string              `json:"name"`
+	Owner        weave.Address       `json:"owner"`
+	ValidUntil   weave.UnixTime      `json:"valid_until"`
+	Targets      []BlockchainAddress `json:"targets,omitempty"`
+	Certificates [][]byte            `json:"certificates,omitempty"`
+	Broker       weave.Address       `json:"broker,omitempty"`
+}
+
+type genesisAccounts struct {
+	Domains  []genesisDomain  `json:"domains"`
+	Accounts []genesisAccount `json:"accounts"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
@@ -27,21 +54,7 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 		return errors.Wrap(err, "cannot initialize gconf based configuration")
 	}
 
-	var input struct {
-		Domains []struct {
-			Domain       string             `json:"domain"`
-			Admin        weave.Address      `json:"admin"`
-			ValidUntil   weave.UnixTime     `json:"valid_until"`
-			AccountRenew weave.UnixDuration `json:"account_renew"`
-			HasSuperuser bool               `json:"has_superuser"`
-		}
-		Accounts []struct {
-			Domain     string         `json:"domain"`
-			Name       string         `json:"name"`
-			Owner      weave.Address  `json:"owner"`
-			ValidUntil weave.UnixTime `json:"valid_until"`
-		}
-	}
+	var input genesisAccounts
 	switch err := opts.ReadOptions("account", &input); {
 	case err == nil:
 		// All good.
@@ -62,6 +75,8 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			ValidUntil:   d.ValidUntil,
 			AccountRenew: d.AccountRenew,
 			HasSuperuser: d.HasSuperuser,
+			MsgFees:      d.MsgFees,
+			Broker:       d.Broker,
 		}
 		if _, err := domains.Put(kv, []byte(d.Domain), &domain); err != nil {
 			return errors.Wrapf(err, "cannot store %d domain", i)
@@ -84,11 +99,14 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			return errors.Wrap(err, "cannot create account because of missing domain")
 		}
 		account := Account{
-			Metadata:   &weave.Metadata{Schema: 1},
-			Domain:     a.Domain,
-			Name:       a.Name,
-			Owner:      a.Owner,
-			ValidUntil: a.ValidUntil,
+			Metadata:     &weave.Metadata{Schema: 1},
+			Domain:       a.Domain,
+			Name:         a.Name,
+			Owner:        a.Owner,
+			ValidUntil:   a.ValidUntil,
+			Targets:      a.Targets,
+			Certificates: a.Certificates,
+			Broker:       a.Broker,
 		}
 		if _, err := accounts.Put(kv, accountKey(a.Name, a.Domain), &account); err != nil {
 			return errors.Wrapf(err, "cannot store %d account", i)
@@ -96,3 +114,69 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 	}
 	return nil
 }
+
+// ToGenesis will export all domains, accounts and the configuration from
+// the database. Nothing is exported if there is no configuration.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	opts, err := gconf.ExportConfig(kv, "account", &Configuration{})
+	switch {
+	case errors.ErrNotFound.Is(err):
+		return weave.Options{}, nil
+	case err != nil:
+		return nil, errors.Wrap(err, "cannot export gconf based configuration")
+	}
+
+	output := genesisAccounts{
+		Domains:  []genesisDomain{},
+		Accounts: []genesisAccount{},
+	}
+
+	domains := orm.IterAll("domain")
+	for {
+		var d Domain
+		_, err := domains.Next(kv, &d)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot load domain")
+		}
+		output.Domains = append(output.Domains, genesisDomain{
+			Domain:       d.Domain,
+			Admin:        d.Admin,
+			ValidUntil:   d.ValidUntil,
+			AccountRenew: d.AccountRenew,
+			HasSuperuser: d.HasSuperuser,
+			MsgFees:      d.MsgFees,
+			Broker:       d.Broker,
+		})
+	}
+
+	// Accounts with an empty name are exported as well, because they can
+	// differ from the ones created together with their domain.
+	accounts := orm.IterAll("account")
+	for {
+		var a Account
+		_, err := accounts.Next(kv, &a)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot load account")
+		}
+		output.Accounts = append(output.Accounts, genesisAccount{
+			Domain:       a.Domain,
+			Name:         a.Name,
+			Owner:        a.Owner,
+			ValidUntil:   a.ValidUntil,
+			Targets:      a.Targets,
+			Certificates: a.Certificates,
+			Broker:       a.Broker,
+		})
+	}
+
+	if err := opts.WriteOptions("account", output); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -11,13 +12,77 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisRecord struct {
+	Domain string        `json:"domain"`
+	Owner  weave.Address `json:"owner"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
-// database
+// database. Records are stored under their domain.
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
 	// Preregistration configuration is optional.
 	if err := gconf.InitConfig(kv, opts, "preregistration", &Configuration{}); err != nil && !errors.ErrNotFound.Is(err) {
 		return errors.Wrap(err, "init config")
 	}
+
+	var records []genesisRecord
+	if err := opts.ReadOptions("preregistration", &records); err != nil {
+		return err
+	}
+	bucket := NewRecordBucket()
+	for i, g := range records {
+		switch err := bucket.Has(kv, []byte(g.Domain)); {
+		case err == nil:
+			return errors.Wrapf(errors.ErrDuplicate, "record %d", i)
+		case !errors.ErrNotFound.Is(err):
+			return errors.Wrapf(err, "record %d", i)
+		}
+		rec := Record{
+			Metadata: &weave.Metadata{Schema: 1},
+			Domain:   g.Domain,
+			Owner:    g.Owner,
+		}
+		if _, err := bucket.Put(kv, []byte(g.Domain), &rec); err != nil {
+			return errors.Wrapf(err, "record %d", i)
+		}
+	}
 	return nil
 }
+
+// ToGenesis will export the configuration, if present, and all records from
+// the database.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	opts, err := gconf.ExportConfig(kv, "preregistration", &Configuration{})
+	switch {
+	case errors.ErrNotFound.Is(err):
+		opts = make(weave.Options)
+	case err != nil:
+		return nil, errors.Wrap(err, "export config")
+	}
+
+	records := []genesisRecord{}
+	it := orm.IterAll("records")
+	for {
+		var r Record
+		key, err := it.Next(kv, &r)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "record")
+		}
+		if string(key) != r.Domain {
+			return nil, errors.Wrapf(errors.ErrState, "record %q is not stored under its domain", key)
+		}
+		records = append(records, genesisRecord{
+			Domain: r.Domain,
+			Owner:  r.Owner,
+		})
+	}
+	if err := opts.WriteOptions("preregistration", records); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
@@ -30,14 +30,31 @@ type Configuration struct {
 	// This defines the Address that is allowed to update the Configuration object and is
 	// needed to make use of gconf.NewUpdateConfigurationHandler
 	Owner github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
-	C     weave.Fraction                   `protobuf:"bytes,3,opt,name=c,proto3" json:"c"`
-	K     weave.Fraction                   `protobuf:"bytes,4,opt,name=k,proto3" json:"k"`
-	Kp    weave.Fraction                   `protobuf:"bytes,5,opt,name=kp,proto3" json:"kp"`
-	Q0    weave.Fraction                   `protobuf:"bytes,6,opt,name=q0,proto3" json:"q0"`
-	X     weave.Fraction                   `protobuf:"bytes,7,opt,name=x,proto3" json:"x"`
-	XInf  weave.Fraction                   `protobuf:"bytes,8,opt,name=x_inf,json=xInf,proto3" json:"x_inf"`
-	XSup  weave.Fraction                   `protobuf:"bytes,9,opt,name=x_sup,json=xSup,proto3" json:"x_sup"`
-	Delta weave.Fraction                   `protobuf:"bytes,10,opt,name=delta,proto3" json:"delta"`
+	// Quality score is computed from the load, which is the moving average of
+	// the number of transactions per block:
+	//   load = (1 - delta) * load + delta * block_load
+	//   load = min(max(load, x_inf), x_sup)
+	//   e = (load - x) / x
+	//   score = max(c, q0 + k * e)   when e >= 0
+	//   score = max(c, q0 + kp * e)  when e < 0
+	//
+	// C is the minimal quality score.
+	C weave.Fraction `protobuf:"bytes,3,opt,name=c,proto3" json:"c"`
+	// K is the rate of the score growth when the load is above the target.
+	K weave.Fraction `protobuf:"bytes,4,opt,name=k,proto3" json:"k"`
+	// Kp is the rate of the score decrease when the load is below the target.
+	Kp weave.Fraction `protobuf:"bytes,5,opt,name=kp,proto3" json:"kp"`
+	// Q0 is the quality score when the load is equal to the target.
+	Q0 weave.Fraction `protobuf:"bytes,6,opt,name=q0,proto3" json:"q0"`
+	// X is the target load. Zero disables the quality score.
+	X weave.Fraction `protobuf:"bytes,7,opt,name=x,proto3" json:"x"`
+	// X inf is the lower limit of the load.
+	XInf weave.Fraction `protobuf:"bytes,8,opt,name=x_inf,json=xInf,proto3" json:"x_inf"`
+	// X sup is the upper limit of the load. Zero means no limit.
+	XSup weave.Fraction `protobuf:"bytes,9,opt,name=x_sup,json=xSup,proto3" json:"x_sup"`
+	// Delta is the weight of the last block load in the moving average. It
+	// must not be greater than one.
+	Delta weave.Fraction `protobuf:"bytes,10,opt,name=delta,proto3" json:"delta"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
@@ -197,9 +214,94 @@ func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
 	return nil
 }
 
+// State is the quality score computed from the load of recent blocks.
+// It is updated when the first transaction of a new block is delivered.
+type State struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Height of the block for which the delivered transactions are counted.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Number of transactions delivered in the block of given height.
+	BlockLoad int64 `protobuf:"varint,3,opt,name=block_load,json=blockLoad,proto3" json:"block_load,omitempty"`
+	// Load is the moving average of the number of transactions per block,
+	// smoothed using delta and limited to the [x_inf, x_sup] range.
+	Load weave.Fraction `protobuf:"bytes,4,opt,name=load,proto3" json:"load"`
+	// Score is the value that the fee required by transactions is multiplied
+	// by.
+	Score weave.Fraction `protobuf:"bytes,5,opt,name=score,proto3" json:"score"`
+}
+
+func (m *State) Reset()         { *m = State{} }
+func (m *State) String() string { return proto.CompactTextString(m) }
+func (*State) ProtoMessage()    {}
+func (*State) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63ab9fb1ba3b6e41, []int{2}
+}
+func (m *State) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_State.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *State) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_State.Merge(m, src)
+}
+func (m *State) XXX_Size() int {
+	return m.Size()
+}
+func (m *State) XXX_DiscardUnknown() {
+	xxx_messageInfo_State.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_State proto.InternalMessageInfo
+
+func (m *State) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *State) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *State) GetBlockLoad() int64 {
+	if m != nil {
+		return m.BlockLoad
+	}
+	return 0
+}
+
+func (m *State) GetLoad() weave.Fraction {
+	if m != nil {
+		return m.Load
+	}
+	return weave.Fraction{}
+}
+
+func (m *State) GetScore() weave.Fraction {
+	if m != nil {
+		return m.Score
+	}
+	return weave.Fraction{}
+}
+
 func init() {
 	proto.RegisterType((*Configuration)(nil), "qualityscore.Configuration")
 	proto.RegisterType((*UpdateConfigurationMsg)(nil), "qualityscore.UpdateConfigurationMsg")
+	proto.RegisterType((*State)(nil), "qualityscore.State")
 }
 
 func init() {
@@ -207,30 +309,34 @@ func init() {
 }
 
 var fileDescriptor_63ab9fb1ba3b6e41 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0xd2, 0x41, 0x6b, 0xea, 0x40,
-	0x10, 0x00, 0xe0, 0xac, 0x1a, 0x9f, 0x6f, 0xf5, 0xf1, 0x20, 0x3c, 0x1e, 0x8b, 0x85, 0x28, 0xda,
-	0x82, 0x54, 0x9a, 0x58, 0x7b, 0xeb, 0xad, 0x16, 0x0a, 0x3d, 0x78, 0x49, 0xe9, 0xb9, 0xac, 0xbb,
-	0x6b, 0x0c, 0xd1, 0xdd, 0x35, 0xd9, 0x68, 0xfa, 0x2f, 0xfa, 0x7b, 0xfa, 0x0b, 0x3c, 0x7a, 0xec,
-	0x49, 0x8a, 0xfe, 0x8b, 0x9e, 0x4a, 0x4c, 0x29, 0x7a, 0x59, 0xe8, 0x6d, 0x98, 0xf9, 0x66, 0x67,
-	0x61, 0x06, 0xb6, 0xc9, 0x8c, 0xba, 0x23, 0x1e, 0x53, 0x37, 0x75, 0xe7, 0x09, 0x9e, 0x06, 0xea,
-	0x39, 0x26, 0x22, 0x62, 0x2e, 0x11, 0x94, 0x11, 0x47, 0x46, 0x42, 0x09, 0xab, 0x76, 0x58, 0xa9,
-	0x57, 0x0f, 0x4a, 0xf5, 0x7f, 0xbe, 0xf0, 0xc5, 0x3e, 0x74, 0xb3, 0x28, 0xcf, 0xb6, 0x5e, 0x8b,
-	0xf0, 0xcf, 0xad, 0xe0, 0xe3, 0xc0, 0x4f, 0x22, 0xac, 0x02, 0xc1, 0xad, 0x2e, 0xac, 0xcc, 0x98,
-	0xc2, 0x14, 0x2b, 0x8c, 0x40, 0x13, 0x74, 0xaa, 0xfd, 0xbf, 0xce, 0x92, 0xe1, 0x05, 0x73, 0x86,
-	0x5f, 0x69, 0xef, 0x1b, 0x58, 0xd7, 0xd0, 0x14, 0x4b, 0xce, 0x22, 0x54, 0x68, 0x82, 0x4e, 0x6d,
-	0x70, 0xfa, 0xb1, 0x69, 0x34, 0xfd, 0x40, 0x4d, 0x92, 0x91, 0x43, 0xc4, 0xcc, 0x0d, 0xc4, 0xe2,
-	0x42, 0x70, 0xe6, 0xe6, 0xfd, 0x37, 0x94, 0x46, 0x2c, 0x8e, 0xbd, 0xbc, 0xc5, 0x6a, 0x43, 0x40,
-	0x50, 0xf1, 0x68, 0xc2, 0x5d, 0x84, 0x49, 0xf6, 0x89, 0x41, 0x69, 0xb5, 0x69, 0x18, 0x1e, 0x20,
-	0x19, 0x0a, 0x51, 0x49, 0x8b, 0x42, 0xeb, 0x0c, 0x16, 0x42, 0x89, 0x4c, 0x9d, 0x2a, 0x84, 0x32,
-	0x63, 0xf3, 0x1e, 0x2a, 0x6b, 0xd9, 0xbc, 0x97, 0x8d, 0x4c, 0xd1, 0x2f, 0xed, 0xc8, 0xd4, 0x3a,
-	0x87, 0x66, 0xfa, 0x14, 0xf0, 0x31, 0xaa, 0xe8, 0x60, 0x29, 0xbd, 0xe7, 0xe3, 0xdc, 0xc6, 0x89,
-	0x44, 0xbf, 0xf5, 0xf6, 0x21, 0x91, 0x56, 0x17, 0x9a, 0x94, 0x4d, 0x15, 0x46, 0x50, 0x67, 0x73,
-	0xd3, 0x4a, 0xe1, 0xff, 0x47, 0x49, 0xb1, 0x62, 0x47, 0x1b, 0x1c, 0xc6, 0xfe, 0xcf, 0x96, 0x78,
-	0x09, 0x4d, 0x89, 0x15, 0x99, 0xec, 0x97, 0x58, 0xed, 0x9f, 0x38, 0x87, 0x47, 0xe4, 0x1c, 0xbd,
-	0xed, 0xe5, 0x72, 0x80, 0x56, 0x5b, 0x1b, 0xac, 0xb7, 0x36, 0x78, 0xdf, 0xda, 0xe0, 0x65, 0x67,
-	0x1b, 0xeb, 0x9d, 0x6d, 0xbc, 0xed, 0x6c, 0x63, 0x54, 0xde, 0xdf, 0xd5, 0xd5, 0x67, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x1f, 0x95, 0x77, 0xb7, 0xaf, 0x02, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6b, 0xdb, 0x30,
+	0x14, 0xc7, 0xad, 0xd8, 0xce, 0xda, 0x97, 0x8e, 0x81, 0x18, 0x45, 0x74, 0xcc, 0x0d, 0xe9, 0x06,
+	0xdd, 0xca, 0xec, 0xae, 0xbb, 0xed, 0xb6, 0x0c, 0x06, 0x83, 0xf5, 0xe2, 0xb2, 0x73, 0x91, 0x25,
+	0xc5, 0x36, 0x76, 0x2c, 0xc7, 0x96, 0x5b, 0xef, 0x5b, 0xec, 0xf3, 0xec, 0xba, 0x4b, 0x8f, 0x3d,
+	0xee, 0x54, 0x46, 0xf2, 0x2d, 0x76, 0x1a, 0x96, 0x43, 0x49, 0x2e, 0x82, 0xdc, 0x9e, 0xde, 0xfb,
+	0x3d, 0xfd, 0x1f, 0x7a, 0x7f, 0xc1, 0x09, 0x9b, 0xf3, 0x20, 0x2a, 0x6a, 0x1e, 0xb4, 0xc1, 0xa2,
+	0xa1, 0x79, 0xaa, 0x7e, 0xd4, 0x4c, 0x56, 0x22, 0x60, 0x92, 0x0b, 0xe6, 0x97, 0x95, 0x54, 0x12,
+	0x1f, 0x6c, 0x56, 0x8e, 0x46, 0x1b, 0xa5, 0xa3, 0xe7, 0xb1, 0x8c, 0xa5, 0x0e, 0x83, 0x2e, 0xea,
+	0xb3, 0x93, 0x5f, 0x36, 0x3c, 0xfd, 0x2c, 0x8b, 0x59, 0x1a, 0x37, 0x15, 0x55, 0xa9, 0x2c, 0xf0,
+	0x19, 0xec, 0xcd, 0x85, 0xa2, 0x9c, 0x2a, 0x4a, 0xd0, 0x18, 0x9d, 0x8e, 0x2e, 0x9e, 0xf9, 0xb7,
+	0x82, 0xde, 0x08, 0xff, 0x72, 0x9d, 0x0e, 0x1f, 0x01, 0xfc, 0x11, 0x5c, 0x79, 0x5b, 0x88, 0x8a,
+	0x0c, 0xc6, 0xe8, 0xf4, 0x60, 0xfa, 0xea, 0xdf, 0xc3, 0xf1, 0x38, 0x4e, 0x55, 0xd2, 0x44, 0x3e,
+	0x93, 0xf3, 0x20, 0x95, 0x37, 0xef, 0x64, 0x21, 0x82, 0xbe, 0xff, 0x13, 0xe7, 0x95, 0xa8, 0xeb,
+	0xb0, 0x6f, 0xc1, 0x27, 0x80, 0x18, 0xb1, 0xb7, 0x14, 0xbe, 0x54, 0x94, 0x75, 0x43, 0x4c, 0x9d,
+	0xbb, 0x87, 0x63, 0x2b, 0x44, 0xac, 0x83, 0x32, 0xe2, 0x18, 0xa1, 0x0c, 0xbf, 0x86, 0x41, 0x56,
+	0x12, 0xd7, 0x44, 0x0d, 0xb2, 0xb2, 0xc3, 0x16, 0xe7, 0x64, 0x68, 0xc4, 0x16, 0xe7, 0x9d, 0x64,
+	0x4b, 0x9e, 0x18, 0x25, 0x5b, 0xfc, 0x16, 0xdc, 0xf6, 0x3a, 0x2d, 0x66, 0x64, 0xcf, 0x04, 0x3a,
+	0xed, 0xd7, 0x62, 0xd6, 0xb3, 0x75, 0x53, 0x92, 0x7d, 0x33, 0x7b, 0xd5, 0x94, 0xf8, 0x0c, 0x5c,
+	0x2e, 0x72, 0x45, 0x09, 0x98, 0xd8, 0x9e, 0x99, 0xb4, 0x70, 0xf8, 0xbd, 0xe4, 0x54, 0x89, 0xad,
+	0x0d, 0x5e, 0xd6, 0xf1, 0x6e, 0x4b, 0x7c, 0x0f, 0x6e, 0x49, 0x15, 0x4b, 0xf4, 0x12, 0x47, 0x17,
+	0x2f, 0xfc, 0x4d, 0x13, 0xf9, 0x5b, 0x77, 0x87, 0x3d, 0x39, 0xf9, 0x8d, 0xc0, 0xbd, 0x52, 0x54,
+	0x89, 0xdd, 0x94, 0x0e, 0x61, 0x98, 0x88, 0x34, 0x4e, 0x94, 0x96, 0xb2, 0xc3, 0xf5, 0x09, 0xbf,
+	0x04, 0x88, 0x72, 0xc9, 0xb2, 0xeb, 0x5c, 0x52, 0xae, 0x3d, 0x61, 0x87, 0xfb, 0x3a, 0xf3, 0x4d,
+	0x52, 0x8e, 0xdf, 0x80, 0xa3, 0x0b, 0x46, 0x1f, 0x68, 0xa4, 0x7b, 0x3f, 0x3d, 0xb6, 0xd9, 0x0d,
+	0x3d, 0x33, 0x25, 0x77, 0x4b, 0x0f, 0xdd, 0x2f, 0x3d, 0xf4, 0x77, 0xe9, 0xa1, 0x9f, 0x2b, 0xcf,
+	0xba, 0x5f, 0x79, 0xd6, 0x9f, 0x95, 0x67, 0x45, 0x43, 0xfd, 0x3b, 0x3e, 0xfc, 0x1f, 0x00, 0x85,
+	0xc3, 0x94, 0x7b, 0x75, 0x03, 0x00, 0x00,
 }
 
 func (m *Configuration) Marshal() (dAtA []byte, err error) {
@@ -369,6 +475,60 @@ func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *State) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n12, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Height))
+	}
+	if m.BlockLoad != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlockLoad))
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Load.Size()))
+	n13, err := m.Load.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n13
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Score.Size()))
+	n14, err := m.Score.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n14
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -428,6 +588,29 @@ func (m *UpdateConfigurationMsg) Size() (n int) {
 	return n
 }
 
+func (m *State) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCodec(uint64(m.Height))
+	}
+	if m.BlockLoad != 0 {
+		n += 1 + sovCodec(uint64(m.BlockLoad))
+	}
+	l = m.Load.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -953,6 +1136,199 @@ func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLoad", wireType)
+			}
+			m.BlockLoad = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockLoad |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Load.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCodec(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,12 +14,27 @@ func (c *Configuration) Validate() error {
 	var errs error
 	errs = errors.AppendField(errs, "Metadata", c.Metadata.Validate())
 	errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	errs = errors.AppendField(errs, "C", c.C.Validate())
+	errs = errors.AppendField(errs, "K", c.K.Validate())
+	errs = errors.AppendField(errs, "Kp", c.Kp.Validate())
+	errs = errors.AppendField(errs, "Q0", c.Q0.Validate())
+	errs = errors.AppendField(errs, "X", c.X.Validate())
+	errs = errors.AppendField(errs, "XInf", c.XInf.Validate())
+	errs = errors.AppendField(errs, "XSup", c.XSup.Validate())
+	if err := c.Delta.Validate(); err != nil {
+		errs = errors.AppendField(errs, "Delta", err)
+	} else if c.Delta.Numerator > c.Delta.Denominator {
+		errs = errors.AppendField(errs, "Delta", errors.Wrap(errors.ErrInput, "must not be greater than one"))
+	}
+	if !isZero(c.XSup) && ratio(c.XInf).Cmp(ratio(c.XSup)) > 0 {
+		errs = errors.AppendField(errs, "XSup", errors.Wrap(errors.ErrInput, "must not be lower than x inf"))
+	}
 	return errs
 }
 
-func loadConf(db gconf.Store) (*Configuration, error) {
+func loadConf(db gconf.ReadStore) (*Configuration, error) {
 	var conf Configuration
-	if err := gconf.Load(db, "account", &conf); err != nil {
+	if err := gconf.Load(db, "qualityscore", &conf); err != nil {
 		return nil, errors.Wrap(err, "load")
 	}
 	return &conf, nil
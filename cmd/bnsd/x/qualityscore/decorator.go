@@ -0,0 +1,90 @@
+package qualityscore
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// Decorator multiplies the fee required by a transaction by the quality
+// score. The score is computed from the number of transactions in recent
+// blocks, as declared by the extension configuration. The busier the chain
+// is, the more expensive transactions are.
+//
+// This decorator does not directly deduct fees from the fee payer account.
+// It must be placed after cash.DynamicFeeDecorator, so that the scaled fee
+// is withdrawn, and before all decorators that declare a required fee, so
+// that their fees are scaled.
+//
+// Reading and updating the state is bookkeeping of the chain and is not
+// charged to the transaction gas meter.
+type Decorator struct{}
+
+var _ weave.Decorator = (*Decorator)(nil)
+
+// NewDecorator returns a quality score decorator. If the extension
+// configuration does not exist, this decorator is a no-op.
+func NewDecorator() *Decorator {
+	return &Decorator{}
+}
+
+func (d *Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	res, err := next.Check(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := weave.UnmeteredKVStore(store)
+	conf, err := loadConf(db)
+	if err != nil {
+		if errors.ErrNotFound.Is(err) {
+			return res, nil
+		}
+		return nil, errors.Wrap(err, "cannot load configuration")
+	}
+	state, err := loadState(db, conf)
+	if err != nil {
+		return nil, err
+	}
+	// A checked transaction is going to be delivered in the next block,
+	// so the fee is computed using the score of the next block.
+	height, _ := weave.GetHeight(ctx)
+	state = advance(conf, state, height+1)
+
+	if res.RequiredFee, err = scaleFee(res.RequiredFee, state.Score); err != nil {
+		return nil, errors.Wrap(err, "cannot apply quality score")
+	}
+	return res, nil
+}
+
+func (d *Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	res, err := next.Deliver(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := weave.UnmeteredKVStore(store)
+	conf, err := loadConf(db)
+	if err != nil {
+		if errors.ErrNotFound.Is(err) {
+			return res, nil
+		}
+		return nil, errors.Wrap(err, "cannot load configuration")
+	}
+	state, err := loadState(db, conf)
+	if err != nil {
+		return nil, err
+	}
+	height, _ := weave.GetHeight(ctx)
+	state = advance(conf, state, height)
+
+	if res.RequiredFee, err = scaleFee(res.RequiredFee, state.Score); err != nil {
+		return nil, errors.Wrap(err, "cannot apply quality score")
+	}
+
+	// Only successfully delivered transactions are counted.
+	state.BlockLoad++
+	if err := saveState(db, state); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
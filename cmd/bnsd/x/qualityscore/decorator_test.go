@@ -0,0 +1,141 @@
+package qualityscore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestDecorator(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "qualityscore")
+	conf := Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Owner:    weavetest.NewCondition().Address(),
+		K:        frac(1, 1),
+		Kp:       frac(1, 1),
+		Q0:       frac(1, 1),
+		X:        frac(2, 1),
+		Delta:    frac(1, 1),
+	}
+	assert.Nil(t, gconf.Save(db, "qualityscore", &conf))
+
+	fee := coin.NewCoin(10, 0, "IOV")
+	handler := &weavetest.Handler{
+		CheckResult:   weave.CheckResult{RequiredFee: fee},
+		DeliverResult: weave.DeliverResult{RequiredFee: fee},
+	}
+	d := NewDecorator()
+
+	check := func(t testing.TB, height int64, want coin.Coin) {
+		t.Helper()
+		ctx := weave.WithHeight(context.Background(), height)
+		res, err := d.Check(ctx, db, &weavetest.Tx{}, handler)
+		assert.Nil(t, err)
+		if !want.Equals(res.RequiredFee) {
+			t.Fatalf("want %v checked fee, got %v", want, res.RequiredFee)
+		}
+	}
+	deliver := func(t testing.TB, height int64, want coin.Coin) {
+		t.Helper()
+		ctx := weave.WithHeight(context.Background(), height)
+		res, err := d.Deliver(ctx, db, &weavetest.Tx{}, handler)
+		assert.Nil(t, err)
+		if !want.Equals(res.RequiredFee) {
+			t.Fatalf("want %v delivered fee, got %v", want, res.RequiredFee)
+		}
+	}
+
+	// Initially the load is equal to the target.
+	check(t, 0, fee)
+	deliver(t, 1, fee)
+	deliver(t, 1, fee)
+
+	// Two transactions were delivered, which is the target load.
+	check(t, 1, fee)
+	for i := 0; i < 4; i++ {
+		deliver(t, 2, fee)
+	}
+
+	// Four transactions is twice the target, so the fee is doubled. Check
+	// must compute the same fee as delivery in the next block.
+	check(t, 2, coin.NewCoin(20, 0, "IOV"))
+	deliver(t, 3, coin.NewCoin(20, 0, "IOV"))
+
+	// A failed transaction is not counted.
+	failing := &weavetest.Handler{DeliverErr: errors.ErrInput}
+	ctx := weave.WithHeight(context.Background(), 3)
+	if _, err := d.Deliver(ctx, db, &weavetest.Tx{}, failing); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	check(t, 3, coin.NewCoin(5, 0, "IOV"))
+}
+
+func TestDecoratorWithoutConfiguration(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "qualityscore")
+
+	fee := coin.NewCoin(10, 0, "IOV")
+	handler := &weavetest.Handler{
+		CheckResult:   weave.CheckResult{RequiredFee: fee},
+		DeliverResult: weave.DeliverResult{RequiredFee: fee},
+	}
+	d := NewDecorator()
+	ctx := weave.WithHeight(context.Background(), 1)
+
+	cres, err := d.Check(ctx, db, &weavetest.Tx{}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, fee, cres.RequiredFee)
+
+	dres, err := d.Deliver(ctx, db, &weavetest.Tx{}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, fee, dres.RequiredFee)
+
+	// No state is stored.
+	raw, err := db.Get([]byte(stateKey))
+	assert.Nil(t, err)
+	assert.Nil(t, raw)
+}
+
+func TestDecoratorDoesNotConsumeGas(t *testing.T) {
+	raw := store.MemStore()
+	migration.MustInitPkg(raw, "qualityscore")
+	conf := Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Owner:    weavetest.NewCondition().Address(),
+		K:        frac(1, 1),
+		Kp:       frac(1, 1),
+		Q0:       frac(1, 1),
+		X:        frac(2, 1),
+		Delta:    frac(1, 1),
+	}
+	assert.Nil(t, gconf.Save(raw, "qualityscore", &conf))
+
+	meter := weave.NewGasMeter(0)
+	db := weave.NewGasKVStore(raw, meter, weave.DefaultGasConfig())
+	handler := &weavetest.Handler{
+		CheckResult:   weave.CheckResult{RequiredFee: coin.NewCoin(10, 0, "IOV")},
+		DeliverResult: weave.DeliverResult{RequiredFee: coin.NewCoin(10, 0, "IOV")},
+	}
+	d := NewDecorator()
+	ctx := weave.WithHeight(context.Background(), 1)
+
+	_, err := d.Check(ctx, db, &weavetest.Tx{}, handler)
+	assert.Nil(t, err)
+	_, err = d.Deliver(ctx, db, &weavetest.Tx{}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), meter.GasConsumed())
+
+	// The state is updated nevertheless.
+	s, err := loadState(raw, &conf)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), s.BlockLoad)
+}
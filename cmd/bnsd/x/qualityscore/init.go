@@ -0,0 +1,81 @@
+package qualityscore
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file.
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisState struct {
+	Load  weave.Fraction `json:"load"`
+	Score weave.Fraction `json:"score"`
+}
+
+// FromGenesis loads the configuration and the state, both optional. Block
+// heights are not carried over to a new chain, so the loaded state is used
+// for the first block.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	if err := gconf.InitConfig(kv, opts, "qualityscore", &Configuration{}); err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "init config")
+	}
+
+	var genesis *genesisState
+	if err := opts.ReadOptions("qualityscore", &genesis); err != nil {
+		return err
+	}
+	if genesis == nil {
+		return nil
+	}
+	s := State{
+		Metadata: &weave.Metadata{Schema: 1},
+		Load:     genesis.Load,
+		Score:    genesis.Score,
+	}
+	return saveState(kv, &s)
+}
+
+// ToGenesis exports the configuration and the state, if present. The load of
+// the last block is included in the exported moving average.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	opts, err := gconf.ExportConfig(kv, "qualityscore", &Configuration{})
+	switch {
+	case errors.ErrNotFound.Is(err):
+		opts = make(weave.Options)
+	case err != nil:
+		return nil, errors.Wrap(err, "export config")
+	}
+
+	raw, err := kv.Get([]byte(stateKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get state")
+	}
+	if raw == nil {
+		return opts, nil
+	}
+	var s State
+	if err := s.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal state")
+	}
+	if s.Height != 0 {
+		conf, err := loadConf(kv)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot load configuration")
+		}
+		s = *advance(conf, &s, s.Height+1)
+	}
+	genesis := genesisState{
+		Load:  s.Load,
+		Score: s.Score,
+	}
+	if err := opts.WriteOptions("qualityscore", genesis); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
@@ -0,0 +1,68 @@
+package qualityscore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "qualityscore")
+	conf := Configuration{
+		Metadata: &weave.Metadata{Schema: 1},
+		Owner:    weavetest.NewCondition().Address(),
+		K:        frac(1, 1),
+		Kp:       frac(1, 1),
+		Q0:       frac(1, 1),
+		X:        frac(2, 1),
+		Delta:    frac(1, 1),
+	}
+	assert.Nil(t, gconf.Save(db, "qualityscore", &conf))
+
+	fee := coin.NewCoin(10, 0, "IOV")
+	handler := &weavetest.Handler{
+		CheckResult:   weave.CheckResult{RequiredFee: fee},
+		DeliverResult: weave.DeliverResult{RequiredFee: fee},
+	}
+	d := NewDecorator()
+	for height := int64(1); height <= 2; height++ {
+		for i := 0; i < 4; i++ {
+			ctx := weave.WithHeight(context.Background(), height)
+			_, err := d.Deliver(ctx, db, &weavetest.Tx{}, handler)
+			assert.Nil(t, err)
+		}
+	}
+
+	var ini Initializer
+	opts, err := ini.ToGenesis(db)
+	assert.Nil(t, err)
+
+	redb := store.MemStore()
+	migration.MustInitPkg(redb, "qualityscore")
+	assert.Nil(t, ini.FromGenesis(opts, weave.GenesisParams{}, redb))
+
+	// The first block of the new chain uses the score computed for the
+	// block following the export.
+	want, err := d.Check(weave.WithHeight(context.Background(), 2), db, &weavetest.Tx{}, handler)
+	assert.Nil(t, err)
+	res, err := d.Deliver(weave.WithHeight(context.Background(), 1), redb, &weavetest.Tx{}, handler)
+	assert.Nil(t, err)
+	if !want.RequiredFee.Equals(res.RequiredFee) {
+		t.Fatalf("want %v fee, got %v", want.RequiredFee, res.RequiredFee)
+	}
+	if want.RequiredFee.Equals(fee) {
+		t.Fatal("score was not changed by the load")
+	}
+
+	reopts, err := ini.ToGenesis(redb)
+	assert.Nil(t, err)
+	assert.Equal(t, opts["conf"], reopts["conf"])
+}
@@ -0,0 +1,207 @@
+package qualityscore
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &State{}, migration.NoModification)
+}
+
+const (
+	// stateKey is the database key under which the state is stored.
+	stateKey = "_qualityscore:state"
+
+	// precision is the denominator of the load and score fractions stored
+	// in the state.
+	precision = 1000000
+
+	// maxEmptyBlocks limits how many blocks without transactions are
+	// included in the load computation. After that many blocks the load
+	// is close enough to x inf.
+	maxEmptyBlocks = 1000
+)
+
+func (s *State) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", s.Metadata.Validate())
+	if s.Height < 0 {
+		errs = errors.AppendField(errs, "Height", errors.ErrInput)
+	}
+	if s.BlockLoad < 0 {
+		errs = errors.AppendField(errs, "BlockLoad", errors.ErrInput)
+	}
+	errs = errors.AppendField(errs, "Load", s.Load.Validate())
+	errs = errors.AppendField(errs, "Score", s.Score.Validate())
+	return errs
+}
+
+// loadState returns the quality score state. If the state does not exist
+// yet, it is initialized with the target load.
+func loadState(db weave.ReadOnlyKVStore, conf *Configuration) (*State, error) {
+	raw, err := db.Get([]byte(stateKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get state")
+	}
+	if raw == nil {
+		load := limitLoad(conf, ratio(conf.X))
+		return &State{
+			Metadata: &weave.Metadata{Schema: 1},
+			Load:     fraction(load),
+			Score:    fraction(score(conf, load)),
+		}, nil
+	}
+	var s State
+	if err := s.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal state")
+	}
+	return &s, nil
+}
+
+func saveState(db weave.KVStore, s *State) error {
+	if err := s.Validate(); err != nil {
+		return errors.Wrap(err, "invalid state")
+	}
+	raw, err := s.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal state")
+	}
+	if err := db.Set([]byte(stateKey), raw); err != nil {
+		return errors.Wrap(err, "cannot set state")
+	}
+	return nil
+}
+
+// advance returns the state that is used to process transactions of the
+// block at given height. When called for a new block, the load of all
+// previous blocks is included in the moving average and the score is
+// recomputed.
+func advance(conf *Configuration, s *State, height int64) *State {
+	if height <= s.Height {
+		return s
+	}
+	next := &State{
+		Metadata: s.Metadata,
+		Height:   height,
+		Load:     s.Load,
+		Score:    s.Score,
+	}
+	if s.Height == 0 {
+		// No block was counted yet.
+		return next
+	}
+
+	load := nextLoad(conf, ratio(s.Load), s.BlockLoad)
+	empty := height - s.Height - 1
+	if empty > maxEmptyBlocks {
+		empty = maxEmptyBlocks
+	}
+	for i := int64(0); i < empty; i++ {
+		load = nextLoad(conf, load, 0)
+	}
+	next.Load = fraction(load)
+	next.Score = fraction(score(conf, load))
+	return next
+}
+
+// nextLoad returns the moving average of the load after a block with given
+// number of transactions. The result is rounded to the stored precision.
+func nextLoad(conf *Configuration, load *big.Rat, blockLoad int64) *big.Rat {
+	delta := ratio(conf.Delta)
+	keep := new(big.Rat).Sub(big.NewRat(1, 1), delta)
+	next := new(big.Rat).Mul(load, keep)
+	next.Add(next, new(big.Rat).Mul(delta, big.NewRat(blockLoad, 1)))
+	return ratio(fraction(limitLoad(conf, next)))
+}
+
+// limitLoad returns the load limited to the [x inf, x sup] range.
+func limitLoad(conf *Configuration, load *big.Rat) *big.Rat {
+	if inf := ratio(conf.XInf); load.Cmp(inf) < 0 {
+		return inf
+	}
+	if !isZero(conf.XSup) {
+		if sup := ratio(conf.XSup); load.Cmp(sup) > 0 {
+			return sup
+		}
+	}
+	return load
+}
+
+// score returns the quality score for given load.
+func score(conf *Configuration, load *big.Rat) *big.Rat {
+	target := ratio(conf.X)
+	if target.Sign() == 0 {
+		return big.NewRat(1, 1)
+	}
+	e := new(big.Rat).Sub(load, target)
+	e.Quo(e, target)
+
+	rate := ratio(conf.K)
+	if e.Sign() < 0 {
+		rate = ratio(conf.Kp)
+	}
+	q := new(big.Rat).Mul(rate, e)
+	q.Add(q, ratio(conf.Q0))
+	if c := ratio(conf.C); q.Cmp(c) < 0 {
+		q = c
+	}
+	return q
+}
+
+// scaleFee returns the fee multiplied by the score. The result is rounded
+// up to the smallest coin unit.
+func scaleFee(fee coin.Coin, score weave.Fraction) (coin.Coin, error) {
+	if fee.IsZero() {
+		return fee, nil
+	}
+	unit := big.NewInt(coin.FracUnit)
+	total := new(big.Int).Mul(big.NewInt(fee.Whole), unit)
+	total.Add(total, big.NewInt(fee.Fractional))
+
+	r := new(big.Rat).SetInt(total)
+	r.Mul(r, ratio(score))
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if m.Sign() > 0 {
+		q.Add(q, big.NewInt(1))
+	}
+
+	whole, frac := new(big.Int).QuoRem(q, unit, new(big.Int))
+	if !whole.IsInt64() || whole.Int64() > coin.MaxInt {
+		return coin.Coin{}, errors.Wrap(errors.ErrOverflow, "fee")
+	}
+	return coin.NewCoin(whole.Int64(), frac.Int64(), fee.Ticker), nil
+}
+
+// ratio returns the value of the fraction. Fraction with zero denominator
+// is zero.
+func ratio(f weave.Fraction) *big.Rat {
+	if f.Denominator == 0 {
+		return new(big.Rat)
+	}
+	return big.NewRat(int64(f.Numerator), int64(f.Denominator))
+}
+
+// fraction returns a non negative value as a fraction with the precision
+// denominator. The value is rounded down and limited to the greatest value
+// that can be represented.
+func fraction(r *big.Rat) weave.Fraction {
+	if r.Sign() <= 0 {
+		return weave.Fraction{Numerator: 0, Denominator: precision}
+	}
+	n := new(big.Int).Mul(r.Num(), big.NewInt(precision))
+	n.Quo(n, r.Denom())
+	if !n.IsUint64() || n.Uint64() > math.MaxUint32 {
+		return weave.Fraction{Numerator: math.MaxUint32, Denominator: precision}
+	}
+	return weave.Fraction{Numerator: uint32(n.Uint64()), Denominator: precision}
+}
+
+func isZero(f weave.Fraction) bool {
+	return f.Numerator == 0
+}
@@ -0,0 +1,210 @@
+package qualityscore
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestScore(t *testing.T) {
+	conf := &Configuration{
+		C:    frac(1, 2),
+		K:    frac(2, 1),
+		Kp:   frac(1, 1),
+		Q0:   frac(1, 1),
+		X:    frac(10, 1),
+		XSup: frac(100, 1),
+	}
+	cases := map[string]struct {
+		load *big.Rat
+		want *big.Rat
+	}{
+		"load equal to the target": {
+			load: big.NewRat(10, 1),
+			want: big.NewRat(1, 1),
+		},
+		"load above the target": {
+			load: big.NewRat(15, 1),
+			want: big.NewRat(2, 1),
+		},
+		"load below the target": {
+			load: big.NewRat(8, 1),
+			want: big.NewRat(4, 5),
+		},
+		"no load is limited by the minimal score": {
+			load: big.NewRat(0, 1),
+			want: big.NewRat(1, 2),
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if got := score(conf, tc.load); got.Cmp(tc.want) != 0 {
+				t.Fatalf("want %s, got %s", tc.want, got)
+			}
+		})
+	}
+
+	// Without the target load, the score is disabled.
+	if got := score(&Configuration{Q0: frac(5, 1)}, big.NewRat(3, 1)); got.Cmp(big.NewRat(1, 1)) != 0 {
+		t.Fatalf("want score of one, got %s", got)
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	conf := &Configuration{
+		C:     frac(1, 10),
+		K:     frac(1, 1),
+		Kp:    frac(1, 1),
+		Q0:    frac(1, 1),
+		X:     frac(10, 1),
+		XInf:  frac(2, 1),
+		XSup:  frac(20, 1),
+		Delta: frac(1, 2),
+	}
+	state := &State{
+		Metadata: &weave.Metadata{Schema: 1},
+		Load:     frac(10, 1),
+		Score:    frac(1, 1),
+	}
+
+	// The first block is not counted.
+	state = advance(conf, state, 5)
+	assert.Equal(t, int64(5), state.Height)
+	assert.Equal(t, frac(10, 1), state.Load)
+
+	// Advancing to the same block does not change anything.
+	state.BlockLoad = 30
+	assert.Equal(t, state, advance(conf, state, 5))
+
+	// (10 + 30) / 2 = 20
+	state = advance(conf, state, 6)
+	assert.Equal(t, int64(6), state.Height)
+	assert.Equal(t, int64(0), state.BlockLoad)
+	assert.Equal(t, frac(20, 1), state.Load)
+	assert.Equal(t, frac(2, 1), state.Score)
+
+	// Load is limited by x sup: (20 + 50) / 2 = 35
+	state.BlockLoad = 50
+	state = advance(conf, state, 7)
+	assert.Equal(t, frac(20, 1), state.Load)
+
+	// Blocks without transactions lower the load.
+	// (20 + 6) / 2 = 13, 13 / 2 = 6.5, 6.5 / 2 = 3.25
+	state.BlockLoad = 6
+	state = advance(conf, state, 10)
+	assert.Equal(t, frac(13, 4), state.Load)
+	assert.Equal(t, frac(13, 40), state.Score)
+
+	// Load is limited by x inf.
+	state = advance(conf, state, 1000000)
+	assert.Equal(t, frac(2, 1), state.Load)
+	assert.Equal(t, frac(1, 5), state.Score)
+}
+
+func TestScaleFee(t *testing.T) {
+	cases := map[string]struct {
+		fee     coin.Coin
+		score   weave.Fraction
+		want    coin.Coin
+		wantErr *errors.Error
+	}{
+		"zero fee": {
+			fee:   coin.Coin{},
+			score: frac(3, 1),
+			want:  coin.Coin{},
+		},
+		"whole fee multiplied": {
+			fee:   coin.NewCoin(3, 0, "IOV"),
+			score: frac(3, 2),
+			want:  coin.NewCoin(4, 500000000, "IOV"),
+		},
+		"result is rounded up": {
+			fee:   coin.NewCoin(0, 1, "IOV"),
+			score: frac(1, 3),
+			want:  coin.NewCoin(0, 1, "IOV"),
+		},
+		"zero score": {
+			fee:   coin.NewCoin(7, 0, "IOV"),
+			score: frac(0, 1),
+			want:  coin.NewCoin(0, 0, "IOV"),
+		},
+		"overflow": {
+			fee:     coin.NewCoin(coin.MaxInt, 0, "IOV"),
+			score:   frac(2, 1),
+			wantErr: errors.ErrOverflow,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got, err := scaleFee(tc.fee, tc.score)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr == nil && !tc.want.Equals(got) {
+				t.Fatalf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestConfigurationValidate(t *testing.T) {
+	valid := func() *Configuration {
+		return &Configuration{
+			Metadata: &weave.Metadata{Schema: 1},
+			Owner:    weavetest.NewCondition().Address(),
+			Q0:       frac(1, 1),
+			X:        frac(10, 1),
+			XInf:     frac(1, 1),
+			XSup:     frac(20, 1),
+			Delta:    frac(1, 10),
+		}
+	}
+	cases := map[string]struct {
+		conf    func() *Configuration
+		wantErr *errors.Error
+	}{
+		"valid": {
+			conf: valid,
+		},
+		"delta greater than one": {
+			conf: func() *Configuration {
+				c := valid()
+				c.Delta = frac(3, 2)
+				return c
+			},
+			wantErr: errors.ErrInput,
+		},
+		"x inf greater than x sup": {
+			conf: func() *Configuration {
+				c := valid()
+				c.XInf = frac(30, 1)
+				return c
+			},
+			wantErr: errors.ErrInput,
+		},
+		"zero division": {
+			conf: func() *Configuration {
+				c := valid()
+				c.K = weave.Fraction{Numerator: 1}
+				return c
+			},
+			wantErr: errors.ErrState,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.conf().Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func frac(n, d uint32) weave.Fraction {
+	return fraction(big.NewRat(int64(n), int64(d)))
+}
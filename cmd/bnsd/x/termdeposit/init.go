@@ -1,9 +1,13 @@
 package termdeposit
 
 import (
+	"encoding/binary"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -11,27 +15,40 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisContract struct {
+	// ID is optional. Contracts without an ID are assigned the next value
+	// of the deposit sequence.
+	ID         uint64         `json:"id,omitempty"`
+	ValidSince weave.UnixTime `json:"valid_since"`
+	ValidUntil weave.UnixTime `json:"valid_until"`
+}
+
+type genesisDeposit struct {
+	ID                uint64         `json:"id"`
+	DepositContractID uint64         `json:"deposit_contract_id"`
+	Amount            coin.Coin      `json:"amount"`
+	Rate              weave.Fraction `json:"rate"`
+	Depositor         weave.Address  `json:"depositor"`
+	Released          bool           `json:"released,omitempty"`
+	CreatedAt         weave.UnixTime `json:"created_at"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
-// database
+// database. Contracts and deposits share the same ID sequence, which is
+// raised above all loaded IDs. Deposited funds are part of the cash extension
+// state and are not issued.
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, db weave.KVStore) error {
 	conf := Configuration{
 		Metadata: &weave.Metadata{Schema: 1},
 	}
-	switch err := gconf.InitConfig(db, opts, "termdeposit", &conf); {
-	default:
-		// All good.
-	case errors.ErrNotFound.Is(err):
-		return nil
-	case err != nil:
+	// Configuration is optional.
+	if err := gconf.InitConfig(db, opts, "termdeposit", &conf); err != nil && !errors.ErrNotFound.Is(err) {
 		return errors.Wrap(err, "cannot initialize gconf based configuration")
 	}
 
-	var contracts []struct {
-		ValidSince weave.UnixTime `json:"valid_since"`
-		ValidUntil weave.UnixTime `json:"valid_until"`
-		Rate       weave.Fraction `json:"rate"`
-	}
+	var contracts []genesisContract
 
 	if err := opts.ReadOptions("depositcontract", &contracts); err != nil {
 		return err
@@ -46,9 +63,139 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 		if err := contract.Validate(); err != nil {
 			return errors.Wrapf(err, "contract %d is invalid", i)
 		}
-		if _, err := b.Put(db, nil, &contract); err != nil {
+		var key []byte
+		if c.ID != 0 {
+			key = sequenceKey(c.ID)
+			switch err := b.Has(db, key); {
+			case err == nil:
+				return errors.Wrapf(errors.ErrDuplicate, "contract %d", i)
+			case !errors.ErrNotFound.Is(err):
+				return errors.Wrapf(err, "contract %d", i)
+			}
+		}
+		if _, err := b.Put(db, key, &contract); err != nil {
 			return errors.Wrapf(err, "store contract %d", i)
 		}
+		if c.ID != 0 {
+			if err := depositSeq.Raise(db, int64(c.ID)); err != nil {
+				return errors.Wrapf(err, "contract %d: sequence", i)
+			}
+		}
+	}
+
+	var deposits []genesisDeposit
+	if err := opts.ReadOptions("deposit", &deposits); err != nil {
+		return err
+	}
+	depBucket := NewDepositBucket()
+	for i, d := range deposits {
+		if d.ID == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "deposit %d: ID", i)
+		}
+		key := sequenceKey(d.ID)
+		switch err := depBucket.Has(db, key); {
+		case err == nil:
+			return errors.Wrapf(errors.ErrDuplicate, "deposit %d", i)
+		case !errors.ErrNotFound.Is(err):
+			return errors.Wrapf(err, "deposit %d", i)
+		}
+		contractID := sequenceKey(d.DepositContractID)
+		if err := b.Has(db, contractID); err != nil {
+			return errors.Wrapf(err, "deposit %d: contract", i)
+		}
+		deposit := Deposit{
+			Metadata:          &weave.Metadata{Schema: 1},
+			DepositContractID: contractID,
+			Amount:            d.Amount,
+			Rate:              d.Rate,
+			Depositor:         d.Depositor,
+			Released:          d.Released,
+			CreatedAt:         d.CreatedAt,
+		}
+		if err := deposit.Validate(); err != nil {
+			return errors.Wrapf(err, "deposit %d is invalid", i)
+		}
+		if _, err := depBucket.Put(db, key, &deposit); err != nil {
+			return errors.Wrapf(err, "store deposit %d", i)
+		}
+		if err := depositSeq.Raise(db, int64(d.ID)); err != nil {
+			return errors.Wrapf(err, "deposit %d: sequence", i)
+		}
 	}
 	return nil
 }
+
+// ToGenesis will export the configuration, if present, together with all
+// deposit contracts and deposits from the database.
+func (*Initializer) ToGenesis(db weave.ReadOnlyKVStore) (weave.Options, error) {
+	opts, err := gconf.ExportConfig(db, "termdeposit", &Configuration{})
+	switch {
+	case errors.ErrNotFound.Is(err):
+		opts = make(weave.Options)
+	case err != nil:
+		return nil, errors.Wrap(err, "cannot export gconf based configuration")
+	}
+
+	contracts := []genesisContract{}
+	it := orm.IterAll("depcontr")
+	for {
+		var c DepositContract
+		key, err := it.Next(db, &c)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "contract")
+		}
+		if err := orm.ValidateSequence(key); err != nil {
+			return nil, errors.Wrapf(err, "contract %x", key)
+		}
+		contracts = append(contracts, genesisContract{
+			ID:         binary.BigEndian.Uint64(key),
+			ValidSince: c.ValidSince,
+			ValidUntil: c.ValidUntil,
+		})
+	}
+	if err := opts.WriteOptions("depositcontract", contracts); err != nil {
+		return nil, err
+	}
+
+	deposits := []genesisDeposit{}
+	it = orm.IterAll("deposit")
+	for {
+		var d Deposit
+		key, err := it.Next(db, &d)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "deposit")
+		}
+		if err := orm.ValidateSequence(key); err != nil {
+			return nil, errors.Wrapf(err, "deposit %x", key)
+		}
+		if err := orm.ValidateSequence(d.DepositContractID); err != nil {
+			return nil, errors.Wrapf(err, "deposit %x: contract", key)
+		}
+		deposits = append(deposits, genesisDeposit{
+			ID:                binary.BigEndian.Uint64(key),
+			DepositContractID: binary.BigEndian.Uint64(d.DepositContractID),
+			Amount:            d.Amount,
+			Rate:              d.Rate,
+			Depositor:         d.Depositor,
+			Released:          d.Released,
+			CreatedAt:         d.CreatedAt,
+		})
+	}
+	if err := opts.WriteOptions("deposit", deposits); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// sequenceKey returns the key of an entity with given sequence ID.
+func sequenceKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
@@ -74,13 +74,8 @@ func (h *registerTokenHandler) validate(ctx weave.Context, db weave.KVStore, tx
 		return nil, errors.Wrap(err, "username")
 	}
 
-	switch err := h.bucket.Has(db, []byte(msg.Username)); {
-	case err == nil:
-		return nil, errors.Wrapf(errors.ErrDuplicate, "username %q already registered", msg.Username)
-	case errors.ErrNotFound.Is(err):
-		// All good. Username is not taken yet.
-	default:
-		return nil, errors.Wrap(err, "cannot check if username is unique")
+	if err := orm.CheckUnique(db, h.bucket, []byte(msg.Username)); err != nil {
+		return nil, errors.Wrapf(err, "username %q", msg.Username)
 	}
 
 	return &msg, nil
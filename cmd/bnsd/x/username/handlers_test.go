@@ -8,6 +8,7 @@ import (
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
@@ -112,9 +113,17 @@ func TestRegisterTokenHandler(t *testing.T) {
 				t.Fatalf("unexpected check error: %s", err)
 			}
 			cache.Discard()
-			if _, err := h.Deliver(context.TODO(), db, tc.Tx); !tc.WantDeliverErr.Is(err) {
+			_, err = h.Deliver(context.TODO(), db, tc.Tx)
+			if !tc.WantDeliverErr.Is(err) {
 				t.Fatalf("unexpected deliver error: %s", err)
 			}
+			if errors.ErrDuplicate.Is(err) {
+				uerr, ok := orm.AsUniqueIndexError(err)
+				if !ok {
+					t.Fatalf("want unique index error, got %+v", err)
+				}
+				assert.Equal(t, []byte("alice*iov"), uerr.Key)
+			}
 		})
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -11,15 +12,17 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisToken struct {
+	Username string              `json:"username"`
+	Targets  []BlockchainAddress `json:"targets"`
+	Owner    weave.Address       `json:"owner"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type TokenInput struct {
-		Username string
-		Targets  []BlockchainAddress
-		Owner    weave.Address
-	}
 	stream := opts.Stream("username")
 
 	var conf Configuration
@@ -29,7 +32,7 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 
 	bucket := NewTokenBucket()
 	for i := 0; ; i++ {
-		var t TokenInput
+		var t genesisToken
 
 		err := stream(&t)
 		switch {
@@ -58,3 +61,32 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 		}
 	}
 }
+
+// ToGenesis will export all tokens and the configuration from the database.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	opts, err := gconf.ExportConfig(kv, "username", &Configuration{})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot export gconf based configuration")
+	}
+
+	tokens := []genesisToken{}
+	it := orm.IterAll("tokens")
+	for {
+		var t Token
+		switch key, err := it.Next(kv, &t); {
+		case err == nil:
+			tokens = append(tokens, genesisToken{
+				Username: string(key),
+				Targets:  t.Targets,
+				Owner:    t.Owner,
+			})
+		case errors.ErrIteratorDone.Is(err):
+			if err := opts.WriteOptions("username", tokens); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "cannot load username token")
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/tendermint/iavl"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	iavlstore "github.com/iov-one/weave/store/iavl"
+)
+
+const (
+	flagGenesis = "genesis"
+	flagChainID = "chain_id"
+
+	chainIDKey    = "chain_id"
+	validatorsKey = "validators"
+)
+
+type exportGenesisArgs struct {
+	dbPath      string
+	height      int64
+	genesisFile string
+	chainID     string
+	backend     string
+}
+
+func parseExportGenesisArgs(home string, args []string) (exportGenesisArgs, error) {
+	var res exportGenesisArgs
+	if len(args) == 0 {
+		return res, errors.Wrap(errors.ErrInput,
+			"usage: cmd export-genesis <path to app state db> [-height=H] [-genesis=FILE] [-chain_id=ID] [-db_backend=NAME]")
+	}
+	var height int
+	exportFlags := flag.NewFlagSet("export-genesis", flag.ExitOnError)
+	exportFlags.IntVar(&height, flagHeight, 0, "height of the state to export (default latest)")
+	exportFlags.StringVar(&res.genesisFile, flagGenesis, filepath.Join(home, DirConfig, "genesis.json"),
+		"genesis file used as a template for all values that are not exported")
+	exportFlags.StringVar(&res.chainID, flagChainID, "", "chain ID of the new genesis (default unchanged)")
+	backendFlag(exportFlags, &res.backend)
+	err := exportFlags.Parse(args[1:])
+	res.dbPath = args[0]
+	res.height = int64(height)
+	return res, err
+}
+
+// ExportGenesisCmd writes the application state of a given height, as stored
+// in the application database, as a genesis file. This allows to start a new
+// chain from the state of an existing one.
+// All values that are not part of the application state (consensus params,
+// genesis time etc.) are copied from the current genesis file. Validators are
+// replaced with the validator set stored in the application state, unless
+// none is stored.
+// It takes the latest state unless -height is explicitly specified
+// It writes the json to stdout
+func ExportGenesisCmd(exp weave.Exporter, home string, args []string) error {
+	a, err := parseExportGenesisArgs(home, args)
+	if err != nil {
+		return err
+	}
+
+	raw, err := ioutil.ReadFile(a.genesisFile)
+	if err != nil {
+		return errors.Wrap(errors.ErrInput, err.Error())
+	}
+	var doc GenesisDoc
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return errors.Wrapf(errors.ErrInput, "genesis file: %s", err)
+	}
+
+	db, err := openDb(a.dbPath, a.backend)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tree := iavl.NewMutableTree(db, iavlstore.DefaultCacheSize)
+	latest, err := tree.Load()
+	if err != nil {
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	if a.height == 0 {
+		a.height = latest
+	}
+	kv, err := iavlstore.NewCommitStoreFromTree(tree).AtVersion(a.height)
+	if err != nil {
+		return errors.Wrapf(err, "height %d", a.height)
+	}
+
+	opts, err := exp.ToGenesis(kv)
+	if err != nil {
+		return errors.Wrap(err, "export application state")
+	}
+	if doc[AppStateKey], err = json.Marshal(opts); err != nil {
+		return errors.Wrap(errors.ErrInput, err.Error())
+	}
+
+	validators, err := genesisValidators(kv)
+	if err != nil {
+		return err
+	}
+	if validators != nil {
+		doc[validatorsKey] = validators
+	}
+
+	if a.chainID != "" {
+		if doc[chainIDKey], err = json.Marshal(a.chainID); err != nil {
+			return errors.Wrap(errors.ErrInput, err.Error())
+		}
+	}
+
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return errors.Wrap(errors.ErrInput, err.Error())
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// genesisValidators returns the validator set stored in the application
+// state, serialized the way tendermint expects it in the genesis file. Nil is
+// returned if no validator set is stored.
+func genesisValidators(kv weave.ReadOnlyKVStore) (json.RawMessage, error) {
+	updates, err := weave.GetValidatorUpdates(kv)
+	if err != nil {
+		return nil, err
+	}
+	var abciUpdates []abci.ValidatorUpdate
+	for _, u := range updates.ValidatorUpdates {
+		// Validators without power are removed from the set.
+		if u.Power > 0 {
+			abciUpdates = append(abciUpdates, u.AsABCI())
+		}
+	}
+	if len(abciUpdates) == 0 {
+		return nil, nil
+	}
+
+	vals, err := tmtypes.PB2TM.ValidatorUpdates(abciUpdates)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrState, err.Error())
+	}
+	genesisVals := make([]tmtypes.GenesisValidator, len(vals))
+	for i, v := range vals {
+		genesisVals[i] = tmtypes.GenesisValidator{
+			Address: v.Address,
+			PubKey:  v.PubKey,
+			Power:   v.VotingPower,
+		}
+	}
+	raw, err := cdc.MarshalJSON(genesisVals)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	return raw, nil
+}
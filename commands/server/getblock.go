@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/iov-one/weave/errors"
+	iavlstore "github.com/iov-one/weave/store/iavl"
 	"github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/blockchain"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -23,26 +24,28 @@ func init() {
 	ctypes.RegisterAmino(cdc)
 }
 
-func parseGetBlockArgs(args []string) (string, int64, error) {
+func parseGetBlockArgs(args []string) (string, string, int64, error) {
 	if len(args) == 0 {
-		return "", 0, errors.Wrap(errors.ErrInput, "usage: cmd getblock <path to blockstore.db> [-height=H]")
+		return "", "", 0, errors.Wrap(errors.ErrInput, "usage: cmd getblock <path to blockstore.db> [-height=H] [-db_backend=NAME]")
 	}
 	var height int
+	var backend string
 	getBlockFlags := flag.NewFlagSet("getblock", flag.ExitOnError)
 	getBlockFlags.IntVar(&height, flagHeight, 0, "height of the block to extract (default latest)")
+	backendFlag(getBlockFlags, &backend)
 	err := getBlockFlags.Parse(args[1:])
-	return args[0], int64(height), err
+	return args[0], backend, int64(height), err
 }
 
 // GetBlockCmd extracts a block from a blockstore.db and outputs as json
 // It takes the last block unless -height is explicitly specified
 // It writes the json to stdout
 func GetBlockCmd(args []string) error {
-	dbPath, height, err := parseGetBlockArgs(args)
+	dbPath, backend, height, err := parseGetBlockArgs(args)
 	if err != nil {
 		return err
 	}
-	db, err := openDb(dbPath)
+	db, err := openDb(dbPath, backend)
 	if err != nil {
 		return err
 	}
@@ -53,7 +56,17 @@ func GetBlockCmd(args []string) error {
 	return printBlock(store, height)
 }
 
-func openDb(dir string) (dbm.DB, error) {
+// backendFlag declares the flag that selects the database backend that the
+// opened database is persisted in.
+func backendFlag(fl *flag.FlagSet, backend *string) {
+	fl.StringVar(backend, flagBackend, iavlstore.DefaultBackend,
+		"database backend: "+strings.Join(iavlstore.Backends(), ", "))
+}
+
+// openDb opens the database located in given directory, using the database
+// backend registered under given name (see iavl.RegisterBackend). The
+// directory name must end with ".db".
+func openDb(dir, backend string) (dbm.DB, error) {
 	separatorStr := string(os.PathSeparator)
 	if strings.HasSuffix(dir, ".db") {
 		dir = dir[:len(dir)-3]
@@ -68,11 +81,7 @@ func openDb(dir string) (dbm.DB, error) {
 		return nil, errors.Wrapf(errors.ErrInput, "cannot cut paths on %s", dir)
 	}
 	name := dir[cut+1:]
-	db, err := dbm.NewGoLevelDB(name, dir[:cut])
-	if err != nil {
-		return nil, err
-	}
-	return db, nil
+	return iavlstore.OpenDB(backend, name, dir[:cut])
 }
 
 func printBlock(store *blockchain.BlockStore, height int64) error {
@@ -27,12 +27,13 @@ type retryArgs struct {
 	debug      bool
 	untilError bool
 	maxTries   int
+	backend    string
 }
 
 func parseRetryArgs(args []string) (retryArgs, error) {
 	if len(args) < 2 {
 		return retryArgs{}, errors.Wrap(errors.ErrInput,
-			"usage: cmd retry <path to abci.db> <path to block.json> [-debug] [-error] [-max=N]")
+			"usage: cmd retry <path to abci.db> <path to block.json> [-debug] [-error] [-max=N] [-db_backend=NAME]")
 	}
 	res := retryArgs{
 		dbPath:    args[0],
@@ -42,6 +43,7 @@ func parseRetryArgs(args []string) (retryArgs, error) {
 	getBlockFlags.BoolVar(&res.debug, flagDebug, false, "print out debug info")
 	getBlockFlags.BoolVar(&res.untilError, flagUntilError, false, "retry multiple times until an error appears")
 	getBlockFlags.IntVar(&res.maxTries, flagMaxTries, 10, "maximum number of times to retry if -error is passed")
+	backendFlag(getBlockFlags, &res.backend)
 	err := getBlockFlags.Parse(args[2:])
 	return res, err
 }
@@ -80,7 +82,7 @@ func RetryCmd(makeApp InlineAppGenerator, logger log.Logger, home string, args [
 	}
 
 	fmt.Println("--> Loading Database")
-	tree, ver, err := readTree(flags.dbPath, 0)
+	tree, ver, err := readTree(flags.dbPath, flags.backend, 0)
 	if err != nil {
 		return errors.Wrap(err, "error reading abci data")
 	}
@@ -94,8 +96,8 @@ func RetryCmd(makeApp InlineAppGenerator, logger log.Logger, home string, args [
 	return retryBlock(builder, tree, block, flags.untilError, flags.maxTries)
 }
 
-func readTree(dir string, version int) (*iavl.MutableTree, int64, error) {
-	db, err := openDb(dir)
+func readTree(dir, backend string, version int) (*iavl.MutableTree, int64, error) {
+	db, err := openDb(dir, backend)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+
+	"github.com/tendermint/iavl"
+
+	"github.com/iov-one/weave/errors"
+	iavlstore "github.com/iov-one/weave/store/iavl"
+)
+
+const (
+	flagChunkSize = "chunk_size"
+	flagHash      = "hash"
+)
+
+type exportSnapshotArgs struct {
+	dbPath      string
+	snapshotDir string
+	height      int64
+	chunkSize   int
+	backend     string
+}
+
+func parseExportSnapshotArgs(args []string) (exportSnapshotArgs, error) {
+	var res exportSnapshotArgs
+	if len(args) < 2 {
+		return res, errors.Wrap(errors.ErrInput,
+			"usage: cmd export-snapshot <path to abci.db> <snapshot dir> [-height=H] [-chunk_size=BYTES] [-db_backend=NAME]")
+	}
+	var height int
+	exportFlags := flag.NewFlagSet("export-snapshot", flag.ExitOnError)
+	exportFlags.IntVar(&height, flagHeight, 0, "height of the state to export (default latest)")
+	exportFlags.IntVar(&res.chunkSize, flagChunkSize, iavlstore.DefaultSnapshotChunkSize, "maximum size of a single chunk file")
+	backendFlag(exportFlags, &res.backend)
+	err := exportFlags.Parse(args[2:])
+	res.dbPath = args[0]
+	res.snapshotDir = args[1]
+	res.height = int64(height)
+	return res, err
+}
+
+// ExportSnapshotCmd writes the application state of a given height, as
+// stored in the abci.db, into a snapshot directory. The snapshot can be
+// used to start a new node without replaying the whole chain.
+// It takes the latest state unless -height is explicitly specified
+func ExportSnapshotCmd(args []string) error {
+	a, err := parseExportSnapshotArgs(args)
+	if err != nil {
+		return err
+	}
+	db, err := openDb(a.dbPath, a.backend)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if a.height == 0 {
+		a.height, err = iavl.NewMutableTree(db, iavlstore.DefaultCacheSize).Load()
+		if err != nil {
+			return errors.Wrap(errors.ErrDatabase, err.Error())
+		}
+	}
+
+	manifest, err := iavlstore.ExportSnapshot(db, a.height, a.snapshotDir, a.chunkSize)
+	if err != nil {
+		return errors.Wrapf(err, "height %d", a.height)
+	}
+	fmt.Printf("Exported height %d with hash %X into %d chunks\n",
+		manifest.Version, manifest.Hash, len(manifest.Chunks))
+	return nil
+}
+
+type importSnapshotArgs struct {
+	snapshotDir string
+	dbPath      string
+	hash        []byte
+	backend     string
+}
+
+func parseImportSnapshotArgs(args []string) (importSnapshotArgs, error) {
+	var res importSnapshotArgs
+	if len(args) < 2 {
+		return res, errors.Wrap(errors.ErrInput,
+			"usage: cmd import-snapshot <snapshot dir> <path to abci.db> -hash=HEX [-db_backend=NAME]")
+	}
+	var hashStr string
+	importFlags := flag.NewFlagSet("import-snapshot", flag.ExitOnError)
+	importFlags.StringVar(&hashStr, flagHash, "", "trusted application hash of the snapshot height")
+	backendFlag(importFlags, &res.backend)
+	if err := importFlags.Parse(args[2:]); err != nil {
+		return res, err
+	}
+	if hashStr == "" {
+		return res, errors.Wrap(errors.ErrInput, "trusted application hash is required")
+	}
+	hash, err := hex.DecodeString(hashStr)
+	if err != nil {
+		return res, errors.Wrapf(errors.ErrInput, "invalid hash: %s", err)
+	}
+	res.snapshotDir = args[0]
+	res.dbPath = args[1]
+	res.hash = hash
+	return res, nil
+}
+
+// ImportSnapshotCmd rebuilds the application state from a snapshot
+// directory into a new abci.db. The hash of every imported node is verified.
+// The snapshot root hash must be equal to the trusted application hash given
+// with -hash (for example, taken from the header of the next block),
+// otherwise nothing is imported. Once imported, the application continues from the
+// snapshot height. Tendermint data of that height must be provided
+// separately.
+func ImportSnapshotCmd(args []string) error {
+	a, err := parseImportSnapshotArgs(args)
+	if err != nil {
+		return err
+	}
+
+	db, err := openDb(a.dbPath, a.backend)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	manifest, err := iavlstore.ImportSnapshot(a.snapshotDir, db, a.hash)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Imported height %d with hash %X\n", manifest.Version, manifest.Hash)
+	return nil
+}
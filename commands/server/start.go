@@ -2,8 +2,12 @@ package server
 
 import (
 	"flag"
+	"strings"
+
+	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	iavlstore "github.com/iov-one/weave/store/iavl"
 	"github.com/tendermint/tendermint/abci/server"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -11,9 +15,13 @@ import (
 )
 
 const (
-	flagBind   = "bind"
-	flagDebug  = "debug"
-	flagMinFee = "min_fee"
+	flagBind      = "bind"
+	flagDebug     = "debug"
+	flagMinFee    = "min_fee"
+	flagPruning   = "pruning"
+	flagCacheSize = "cache_size"
+	flagBackend   = "db_backend"
+	flagBech32    = "bech32_prefix"
 )
 
 type Options struct {
@@ -21,12 +29,25 @@ type Options struct {
 	Debug  bool
 	Home   string
 	Logger log.Logger
+	// Pruning declares which versions of the state are kept on disk. If
+	// nil, the store default is used.
+	Pruning *iavlstore.PruningStrategy
+	// CacheSize is the number of state tree nodes cached in memory. If
+	// zero, the store default is used.
+	CacheSize int
+	// Backend is the name of the database backend that the state is
+	// persisted in. If empty, the store default is used.
+	Backend string
+	// Bech32Prefix is the human readable part of bech32 addresses
+	// returned by JSON encoded queries.
+	Bech32Prefix string
 }
 
 func parseFlags(args []string) (string, *Options, error) {
 	// parse flagBind and return the result
 	var addr string
 	var minFeeStr string
+	var pruningStr string
 	options := &Options{
 		MinFee: coin.Coin{},
 	}
@@ -35,17 +56,47 @@ func parseFlags(args []string) (string, *Options, error) {
 	startFlags.StringVar(&addr, flagBind, "tcp://localhost:26658", "address server listens on")
 	startFlags.StringVar(&minFeeStr, flagMinFee, "0 IOV", "minimal anti-spam fee")
 	startFlags.BoolVar(&options.Debug, flagDebug, false, "call stack returned on error")
+	startFlags.StringVar(&pruningStr, flagPruning, iavlstore.PruneDefault.String(),
+		`versions of the state kept on disk: "keep-all" or "keep-recent=<n>[,keep-every=<m>]"`)
+	startFlags.IntVar(&options.CacheSize, flagCacheSize, iavlstore.DefaultCacheSize, "number of state tree nodes cached in memory")
+	startFlags.StringVar(&options.Backend, flagBackend, iavlstore.DefaultBackend,
+		"database backend the state is persisted in: "+strings.Join(iavlstore.Backends(), ", "))
+	startFlags.StringVar(&options.Bech32Prefix, flagBech32, app.DefaultBech32Prefix,
+		"human readable part of bech32 addresses in JSON query results")
 	err := startFlags.Parse(args)
 
 	if err != nil {
 		return addr, options, err
 	}
 
+	pruning, err := iavlstore.ParsePruningStrategy(pruningStr)
+	if err != nil {
+		return addr, options, errors.Wrap(err, flagPruning)
+	}
+	options.Pruning = &pruning
+
+	if !isKnownBackend(options.Backend) {
+		return addr, options, errors.Wrapf(errors.ErrInput, "%s: unknown database backend %q", flagBackend, options.Backend)
+	}
+
+	if options.Bech32Prefix == "" || strings.ToLower(options.Bech32Prefix) != options.Bech32Prefix {
+		return addr, options, errors.Wrapf(errors.ErrInput, "%s: invalid prefix %q", flagBech32, options.Bech32Prefix)
+	}
+
 	options.MinFee, err = coin.ParseHumanFormat(minFeeStr)
 
 	return addr, options, err
 }
 
+func isKnownBackend(name string) bool {
+	for _, b := range iavlstore.Backends() {
+		if b == name {
+			return true
+		}
+	}
+	return false
+}
+
 // AppGenerator lets us lazily initialize app, using home dir
 // and logger potentially initialized with other flags
 type AppGenerator func(*Options) (abci.Application, error)
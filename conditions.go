@@ -22,7 +22,7 @@ var (
 	AddressLength = 20
 
 	// it must have (?s) flags, otherwise it errors when last section contains 0x20 (newline)
-	perm = regexp.MustCompile(`(?s)^([a-zA-Z0-9_\-]{3,8})/([a-zA-Z0-9_\-]{3,8})/(.+)$`)
+	perm = regexp.MustCompile(`(?s)^([a-zA-Z0-9_\-]{3,8})/([a-zA-Z0-9_\-]{3,16})/(.+)$`)
 )
 
 // Condition is a specially formatted array, containing
@@ -30,6 +30,8 @@ var (
 // It is of the format:
 //
 //   sprintf("%s/%s/%s", extension, type, data)
+//
+// Extension is 3 to 8 and type is 3 to 16 characters long.
 type Condition []byte
 
 func NewCondition(ext, typ string, data []byte) Condition {
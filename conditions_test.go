@@ -160,6 +160,10 @@ func TestConditionUnmarshalJSON(t *testing.T) {
 			json:          `"foo/bar/636f6e646974696f6e64617461"`,
 			wantCondition: weave.NewCondition("foo", "bar", []byte("conditiondata")),
 		},
+		"long condition type": {
+			json:          `"sigs/secp256k1/636f6e646974696f6e64617461"`,
+			wantCondition: weave.NewCondition("sigs", "secp256k1", []byte("conditiondata")),
+		},
 		"invalid condition format": {
 			json:    `"foo/636f6e646974696f6e64617461"`,
 			wantErr: errors.ErrInput,
@@ -42,6 +42,8 @@ const (
 	contextKeyLogger
 	contextKeyTime
 	contextCommitInfo
+	contextEvidence
+	contextKeyGasMeter
 )
 
 var (
@@ -89,6 +91,22 @@ func GetCommitInfo(ctx Context) (CommitInfo, bool) {
 	return val, ok
 }
 
+// WithEvidence sets the evidence of validators misbehaviour reported in this
+// block. Panics if already set.
+func WithEvidence(ctx Context, evidence []Evidence) Context {
+	if _, ok := GetEvidence(ctx); ok {
+		panic("Evidence already set")
+	}
+	return context.WithValue(ctx, contextEvidence, evidence)
+}
+
+// GetEvidence returns the evidence of validators misbehaviour reported in
+// this block. Returns false if not present.
+func GetEvidence(ctx Context) ([]Evidence, bool) {
+	val, ok := ctx.Value(contextEvidence).([]Evidence)
+	return val, ok
+}
+
 // WithHeight sets the block height for the Context.
 // panics if called with height already set
 func WithHeight(ctx Context, height int64) Context {
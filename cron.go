@@ -3,6 +3,7 @@ package weave
 import (
 	"time"
 
+	"github.com/iov-one/weave/errors"
 	"github.com/tendermint/tendermint/libs/common"
 )
 
@@ -40,6 +41,20 @@ type TickResult struct {
 	Diff []ValidatorUpdate
 }
 
+// EndBlocker is an interface used to execute logic at the end of a block,
+// after all transactions of that block were delivered. This allows to
+// implement functionality that depends on the whole block content, for
+// example reward distribution or validator set rotation.
+type EndBlocker interface {
+	// EndBlock is a method called at the end of the block. Returned tags
+	// and validator updates are included in the block.
+	//
+	// Same as with the Ticker, end of the block does not allow for an
+	// error response. It is the implementation responsibility to handle
+	// all error situations.
+	EndBlock(ctx Context, store CacheableKVStore) TickResult
+}
+
 // Scheduler is an interface implemented to allow scheduling message execution.
 type Scheduler interface {
 	// Schedule queues given message in the database to be executed at
@@ -52,3 +67,23 @@ type Scheduler interface {
 	// ErrNotFound if task with given ID is not present in the queue.
 	Delete(KVStore, []byte) error
 }
+
+// DeleteScheduled removes a task from the queue of given scheduler. Unlike
+// Scheduler.Delete, it does not fail if the task is not present in the queue,
+// because it was already executed or deleted. An empty task ID is ignored, so
+// that entities created before their task was scheduled can be handled as
+// well.
+func DeleteScheduled(db KVStore, s Scheduler, taskID []byte) error {
+	if len(taskID) == 0 {
+		return nil
+	}
+	switch err := s.Delete(db, taskID); {
+	case err == nil:
+		return nil
+	case errors.ErrNotFound.Is(err):
+		// The task is already gone, which is the desired state.
+		return nil
+	default:
+		return errors.Wrap(err, "cannot delete scheduled task")
+	}
+}
@@ -0,0 +1,40 @@
+package weave_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestDeleteScheduled(t *testing.T) {
+	db := store.MemStore()
+	cron := &weavetest.Cron{}
+
+	tid, err := cron.Schedule(db, time.Now(), nil, &weavetest.Msg{})
+	if err != nil {
+		t.Fatalf("cannot schedule a task: %s", err)
+	}
+	if err := weave.DeleteScheduled(db, cron, tid); err != nil {
+		t.Fatalf("cannot delete a scheduled task: %s", err)
+	}
+	if err := cron.Delete(db, tid); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("task must be deleted: %s", err)
+	}
+
+	// Deleting a task that is not queued is not an error.
+	if err := weave.DeleteScheduled(db, cron, tid); err != nil {
+		t.Fatalf("double deletion failed: %s", err)
+	}
+	if err := weave.DeleteScheduled(db, cron, nil); err != nil {
+		t.Fatalf("deletion of an empty task ID failed: %s", err)
+	}
+
+	cron.Err = errors.ErrDatabase
+	if err := weave.DeleteScheduled(db, cron, tid); !errors.ErrDatabase.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,206 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+
+	"filippo.io/edwards25519"
+	"golang.org/x/crypto/ed25519"
+)
+
+// BatchVerifier collects signatures and verifies all of them at once.
+//
+// Ed25519 signatures are verified together using a single multi-scalar
+// multiplication, which is cheaper than verifying them one by one. Signatures
+// of other algorithms are verified individually.
+//
+// Batch verification is only an accelerator. A batch is valid if and only if
+// each of its signatures is accepted by PublicKey.Verify. Ed25519 signatures
+// that the batch equation cannot decide the same way, for example because of
+// a non canonical encoding or a small order component, are verified one by
+// one. If the batch equation does not hold, all signatures are verified one by
+// one as well.
+//
+// A failed batch verification does not tell which signature is invalid. To
+// find it, each signature must be verified individually.
+type BatchVerifier struct {
+	ed25519 []ed25519BatchEntry
+	other   []batchEntry
+}
+
+type batchEntry struct {
+	pub     *PublicKey
+	message []byte
+	sig     *Signature
+}
+
+type ed25519BatchEntry struct {
+	pub     []byte
+	message []byte
+	sig     []byte
+}
+
+// NewBatchVerifier returns an empty batch verifier.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add queues the signature of the message for the verification.
+func (b *BatchVerifier) Add(pub *PublicKey, message []byte, sig *Signature) {
+	edpub, okPub := pub.GetPub().(*PublicKey_Ed25519)
+	edsig, okSig := sig.GetSig().(*Signature_Ed25519)
+	if okPub && okSig {
+		b.ed25519 = append(b.ed25519, ed25519BatchEntry{
+			pub:     edpub.Ed25519,
+			message: message,
+			sig:     edsig.Ed25519,
+		})
+		return
+	}
+	b.other = append(b.other, batchEntry{pub: pub, message: message, sig: sig})
+}
+
+// Len returns the number of queued signatures.
+func (b *BatchVerifier) Len() int {
+	return len(b.ed25519) + len(b.other)
+}
+
+// Verify returns true if all queued signatures are valid.
+func (b *BatchVerifier) Verify() bool {
+	for _, e := range b.other {
+		if !e.pub.Verify(e.message, e.sig) {
+			return false
+		}
+	}
+
+	if len(b.ed25519) == 1 {
+		// Nothing to accelerate.
+		e := b.ed25519[0]
+		return ed25519.Verify(e.pub, e.message, e.sig)
+	}
+
+	batch := make([]ed25519BatchItem, 0, len(b.ed25519))
+	for _, e := range b.ed25519 {
+		item, ok := newEd25519BatchItem(e)
+		if !ok {
+			if !ed25519.Verify(e.pub, e.message, e.sig) {
+				return false
+			}
+			continue
+		}
+		batch = append(batch, item)
+	}
+	if len(batch) > 1 && verifyEd25519(batch) {
+		return true
+	}
+	for _, item := range batch {
+		if !ed25519.Verify(item.pub, item.message, item.sig) {
+			return false
+		}
+	}
+	return true
+}
+
+// ed25519BatchItem is a signature decoded for the batch verification.
+type ed25519BatchItem struct {
+	ed25519BatchEntry
+	A, R *edwards25519.Point
+	s, k *edwards25519.Scalar
+}
+
+// newEd25519BatchItem decodes the signature. False is returned if the batch
+// equation cannot decide the signature the same way as ed25519.Verify. This
+// is the case if the public key, R or s is not canonically encoded, or if A
+// or R has a small order component.
+func newEd25519BatchItem(e ed25519BatchEntry) (ed25519BatchItem, bool) {
+	item := ed25519BatchItem{ed25519BatchEntry: e}
+	if len(e.pub) != ed25519.PublicKeySize || len(e.sig) != ed25519.SignatureSize {
+		return item, false
+	}
+	var err error
+	if item.A, err = new(edwards25519.Point).SetBytes(e.pub); err != nil {
+		return item, false
+	}
+	if item.R, err = new(edwards25519.Point).SetBytes(e.sig[:32]); err != nil {
+		return item, false
+	}
+	if item.s, err = edwards25519.NewScalar().SetCanonicalBytes(e.sig[32:]); err != nil {
+		return item, false
+	}
+	if !bytes.Equal(item.A.Bytes(), e.pub) || !bytes.Equal(item.R.Bytes(), e.sig[:32]) {
+		return item, false
+	}
+	if !isTorsionFree(item.A) || !isTorsionFree(item.R) {
+		return item, false
+	}
+
+	h := sha512.New()
+	h.Write(e.sig[:32])
+	h.Write(e.pub)
+	h.Write(e.message)
+	if item.k, err = edwards25519.NewScalar().SetUniformBytes(h.Sum(nil)); err != nil {
+		return item, false
+	}
+	return item, true
+}
+
+// isTorsionFree returns true if the point belongs to the prime order
+// subgroup, that is if [L]P is the identity. [L]P is computed as
+// [L-1]P + P, because L cannot be represented as a scalar.
+func isTorsionFree(p *edwards25519.Point) bool {
+	q := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(orderMinusOne, p, edwards25519.NewScalar())
+	q.Add(q, p)
+	return q.Equal(edwards25519.NewIdentityPoint()) == 1
+}
+
+// orderMinusOne is L-1, where L is the order of the prime order subgroup.
+var orderMinusOne = func() *edwards25519.Scalar {
+	one := make([]byte, 32)
+	one[0] = 1
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(one)
+	if err != nil {
+		panic(err)
+	}
+	return edwards25519.NewScalar().Subtract(edwards25519.NewScalar(), s)
+}()
+
+// verifyEd25519 checks the random linear combination of all signature
+// equations
+//
+//	[8](-[sum(z_i * s_i)]B + sum([z_i]R_i) + sum([z_i * k_i]A_i)) == 0
+//
+// where z_i are random 128 bit scalars. Multiplying by the cofactor makes the
+// result independent of the chosen z_i. Because A_i and R_i have no small
+// order component, the equation holds if and only if each signature equation
+// [s_i]B == R_i + [k_i]A_i holds, as checked by ed25519.Verify.
+func verifyEd25519(items []ed25519BatchItem) bool {
+	n := len(items)
+	scalars := make([]*edwards25519.Scalar, 0, 2*n+1)
+	points := make([]*edwards25519.Point, 0, 2*n+1)
+
+	// Place for the base point coefficient.
+	bSum := edwards25519.NewScalar()
+	scalars = append(scalars, bSum)
+	points = append(points, edwards25519.NewGeneratorPoint())
+
+	var zbuf [32]byte
+	for _, item := range items {
+		if _, err := rand.Read(zbuf[:16]); err != nil {
+			panic(err)
+		}
+		z, err := edwards25519.NewScalar().SetCanonicalBytes(zbuf[:])
+		if err != nil {
+			return false
+		}
+
+		bSum.MultiplyAdd(z, item.s, bSum)
+		scalars = append(scalars, z, edwards25519.NewScalar().Multiply(z, item.k))
+		points = append(points, item.R, item.A)
+	}
+	bSum.Negate(bSum)
+
+	check := new(edwards25519.Point).VarTimeMultiScalarMult(scalars, points)
+	check.MultByCofactor(check)
+	return check.Equal(edwards25519.NewIdentityPoint()) == 1
+}
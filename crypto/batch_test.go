@@ -0,0 +1,193 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"fmt"
+	"testing"
+
+	"filippo.io/edwards25519"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestBatchVerifier(t *testing.T) {
+	type signed struct {
+		pub *PublicKey
+		msg []byte
+		sig *Signature
+	}
+	sign := func(t testing.TB, priv *PrivateKey, msg string) signed {
+		t.Helper()
+		sig, err := priv.Sign([]byte(msg))
+		assert.Nil(t, err)
+		return signed{pub: priv.PublicKey(), msg: []byte(msg), sig: sig}
+	}
+
+	ed1 := GenPrivKeyEd25519()
+	ed2 := GenPrivKeyEd25519()
+	sec := GenPrivKeySecp256k1()
+
+	tampered := sign(t, ed2, "tampered")
+	raw := append([]byte{}, tampered.sig.GetEd25519()...)
+	raw[40] ^= 0x01
+	tampered.sig = &Signature{Sig: &Signature_Ed25519{Ed25519: raw}}
+
+	cases := map[string]struct {
+		entries []signed
+		want    bool
+	}{
+		"empty batch": {
+			entries: nil,
+			want:    true,
+		},
+		"single ed25519 signature": {
+			entries: []signed{sign(t, ed1, "a")},
+			want:    true,
+		},
+		"many ed25519 signatures": {
+			entries: []signed{sign(t, ed1, "a"), sign(t, ed2, "b"), sign(t, ed1, "c")},
+			want:    true,
+		},
+		"mixed algorithms": {
+			entries: []signed{sign(t, ed1, "a"), sign(t, sec, "b"), sign(t, ed2, "c")},
+			want:    true,
+		},
+		"signature of a different message": {
+			entries: []signed{
+				sign(t, ed1, "a"),
+				{pub: ed2.PublicKey(), msg: []byte("b"), sig: sign(t, ed2, "not b").sig},
+			},
+			want: false,
+		},
+		"signature of a different key": {
+			entries: []signed{
+				sign(t, ed1, "a"),
+				{pub: ed2.PublicKey(), msg: []byte("b"), sig: sign(t, ed1, "b").sig},
+			},
+			want: false,
+		},
+		"tampered signature": {
+			entries: []signed{sign(t, ed1, "a"), tampered},
+			want:    false,
+		},
+		"invalid secp256k1 signature": {
+			entries: []signed{
+				sign(t, ed1, "a"),
+				sign(t, ed2, "b"),
+				{pub: sec.PublicKey(), msg: []byte("c"), sig: sign(t, sec, "not c").sig},
+			},
+			want: false,
+		},
+		"signature algorithm not matching the key": {
+			entries: []signed{
+				sign(t, ed1, "a"),
+				{pub: ed2.PublicKey(), msg: []byte("b"), sig: sign(t, sec, "b").sig},
+			},
+			want: false,
+		},
+		"malformed ed25519 signature": {
+			entries: []signed{
+				sign(t, ed1, "a"),
+				{pub: ed2.PublicKey(), msg: []byte("b"), sig: &Signature{Sig: &Signature_Ed25519{Ed25519: []byte{1, 2, 3}}}},
+			},
+			want: false,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			b := NewBatchVerifier()
+			for _, e := range tc.entries {
+				b.Add(e.pub, e.msg, e.sig)
+			}
+			assert.Equal(t, len(tc.entries), b.Len())
+			assert.Equal(t, tc.want, b.Verify())
+		})
+	}
+}
+
+func BenchmarkBatchVerifier(b *testing.B) {
+	for _, n := range []int{1, 4, 16, 64} {
+		msgs := make([][]byte, n)
+		pubs := make([]*PublicKey, n)
+		sigs := make([]*Signature, n)
+		for i := range msgs {
+			priv := GenPrivKeyEd25519()
+			msgs[i] = []byte(fmt.Sprintf("message %d", i))
+			pubs[i] = priv.PublicKey()
+			sig, err := priv.Sign(msgs[i])
+			assert.Nil(b, err)
+			sigs[i] = sig
+		}
+
+		b.Run(fmt.Sprintf("individual %d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for k := range msgs {
+					if !pubs[k].Verify(msgs[k], sigs[k]) {
+						b.Fatal("invalid signature")
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("batch %d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				v := NewBatchVerifier()
+				for k := range msgs {
+					v.Add(pubs[k], msgs[k], sigs[k])
+				}
+				if !v.Verify() {
+					b.Fatal("invalid batch")
+				}
+			}
+		})
+	}
+}
+
+func TestBatchVerifierSmallOrderR(t *testing.T) {
+	seed := sha512.Sum512([]byte("small order"))
+	priv := PrivKeyEd25519FromSeed(seed[:32])
+	pub := priv.PublicKey()
+	msg := []byte("message")
+
+	// Build a signature whose R has a small order component. It satisfies
+	// only the cofactored verification equation, which is not enough for
+	// ed25519.Verify.
+	h := sha512.Sum512(seed[:32])
+	a, err := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+	assert.Nil(t, err)
+	r, err := edwards25519.NewScalar().SetUniformBytes(h[:])
+	assert.Nil(t, err)
+	// (0, -1) is the point of order two.
+	torsion, err := new(edwards25519.Point).SetBytes(append([]byte{0xec}, append(bytes.Repeat([]byte{0xff}, 30), 0x7f)...))
+	assert.Nil(t, err)
+	R := new(edwards25519.Point).ScalarBaseMult(r)
+	R.Add(R, torsion)
+
+	kh := sha512.New()
+	kh.Write(R.Bytes())
+	kh.Write(pub.GetEd25519())
+	kh.Write(msg)
+	k, err := edwards25519.NewScalar().SetUniformBytes(kh.Sum(nil))
+	assert.Nil(t, err)
+	s := edwards25519.NewScalar().MultiplyAdd(k, a, r)
+	sig := &Signature{Sig: &Signature_Ed25519{Ed25519: append(R.Bytes(), s.Bytes()...)}}
+
+	other := GenPrivKeyEd25519()
+	otherSig, err := other.Sign(msg)
+	assert.Nil(t, err)
+
+	single := pub.Verify(msg, sig)
+
+	one := NewBatchVerifier()
+	one.Add(pub, msg, sig)
+
+	many := NewBatchVerifier()
+	many.Add(pub, msg, sig)
+	many.Add(other.PublicKey(), msg, otherSig)
+
+	assert.Equal(t, single, one.Verify())
+	assert.Equal(t, single, many.Verify())
+	if single {
+		t.Fatal("signature with a small order R component must be invalid")
+	}
+}
@@ -1,5 +1,8 @@
 /*
 Crypto package is used to build, verify and convert signatures. It also defines useful interfaces to
 work with signatures when building new extensions.
+
+Ed25519 and secp256k1 keys are supported. The condition of a public key is
+sigs/ed25519/<pubkey> or sigs/secp256k1/<pubkey>, depending on the algorithm.
 */
 package crypto
@@ -24,6 +24,7 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type PublicKey struct {
 	// Types that are valid to be assigned to Pub:
 	//	*PublicKey_Ed25519
+	//	*PublicKey_Secp256K1
 	Pub isPublicKey_Pub `protobuf_oneof:"pub"`
 }
 
@@ -69,8 +70,12 @@ type isPublicKey_Pub interface {
 type PublicKey_Ed25519 struct {
 	Ed25519 []byte `protobuf:"bytes,1,opt,name=ed25519,proto3,oneof"`
 }
+type PublicKey_Secp256K1 struct {
+	Secp256K1 []byte `protobuf:"bytes,2,opt,name=secp256k1,proto3,oneof"`
+}
 
-func (*PublicKey_Ed25519) isPublicKey_Pub() {}
+func (*PublicKey_Ed25519) isPublicKey_Pub()   {}
+func (*PublicKey_Secp256K1) isPublicKey_Pub() {}
 
 func (m *PublicKey) GetPub() isPublicKey_Pub {
 	if m != nil {
@@ -86,10 +91,18 @@ func (m *PublicKey) GetEd25519() []byte {
 	return nil
 }
 
+func (m *PublicKey) GetSecp256K1() []byte {
+	if x, ok := m.GetPub().(*PublicKey_Secp256K1); ok {
+		return x.Secp256K1
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*PublicKey) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _PublicKey_OneofMarshaler, _PublicKey_OneofUnmarshaler, _PublicKey_OneofSizer, []interface{}{
 		(*PublicKey_Ed25519)(nil),
+		(*PublicKey_Secp256K1)(nil),
 	}
 }
 
@@ -100,6 +113,9 @@ func _PublicKey_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 	case *PublicKey_Ed25519:
 		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
 		_ = b.EncodeRawBytes(x.Ed25519)
+	case *PublicKey_Secp256K1:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.Secp256K1)
 	case nil:
 	default:
 		return fmt.Errorf("PublicKey.Pub has unexpected type %T", x)
@@ -117,6 +133,13 @@ func _PublicKey_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buff
 		x, err := b.DecodeRawBytes(true)
 		m.Pub = &PublicKey_Ed25519{x}
 		return true, err
+	case 2: // pub.secp256k1
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Pub = &PublicKey_Secp256K1{x}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -130,6 +153,10 @@ func _PublicKey_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(len(x.Ed25519)))
 		n += len(x.Ed25519)
+	case *PublicKey_Secp256K1:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Secp256K1)))
+		n += len(x.Secp256K1)
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -140,6 +167,7 @@ func _PublicKey_OneofSizer(msg proto.Message) (n int) {
 type PrivateKey struct {
 	// Types that are valid to be assigned to Priv:
 	//	*PrivateKey_Ed25519
+	//	*PrivateKey_Secp256K1
 	Priv isPrivateKey_Priv `protobuf_oneof:"priv"`
 }
 
@@ -185,8 +213,12 @@ type isPrivateKey_Priv interface {
 type PrivateKey_Ed25519 struct {
 	Ed25519 []byte `protobuf:"bytes,1,opt,name=ed25519,proto3,oneof"`
 }
+type PrivateKey_Secp256K1 struct {
+	Secp256K1 []byte `protobuf:"bytes,2,opt,name=secp256k1,proto3,oneof"`
+}
 
-func (*PrivateKey_Ed25519) isPrivateKey_Priv() {}
+func (*PrivateKey_Ed25519) isPrivateKey_Priv()   {}
+func (*PrivateKey_Secp256K1) isPrivateKey_Priv() {}
 
 func (m *PrivateKey) GetPriv() isPrivateKey_Priv {
 	if m != nil {
@@ -202,10 +234,18 @@ func (m *PrivateKey) GetEd25519() []byte {
 	return nil
 }
 
+func (m *PrivateKey) GetSecp256K1() []byte {
+	if x, ok := m.GetPriv().(*PrivateKey_Secp256K1); ok {
+		return x.Secp256K1
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*PrivateKey) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _PrivateKey_OneofMarshaler, _PrivateKey_OneofUnmarshaler, _PrivateKey_OneofSizer, []interface{}{
 		(*PrivateKey_Ed25519)(nil),
+		(*PrivateKey_Secp256K1)(nil),
 	}
 }
 
@@ -216,6 +256,9 @@ func _PrivateKey_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 	case *PrivateKey_Ed25519:
 		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
 		_ = b.EncodeRawBytes(x.Ed25519)
+	case *PrivateKey_Secp256K1:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.Secp256K1)
 	case nil:
 	default:
 		return fmt.Errorf("PrivateKey.Priv has unexpected type %T", x)
@@ -233,6 +276,13 @@ func _PrivateKey_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buf
 		x, err := b.DecodeRawBytes(true)
 		m.Priv = &PrivateKey_Ed25519{x}
 		return true, err
+	case 2: // priv.secp256k1
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Priv = &PrivateKey_Secp256K1{x}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -246,6 +296,10 @@ func _PrivateKey_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(len(x.Ed25519)))
 		n += len(x.Ed25519)
+	case *PrivateKey_Secp256K1:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Secp256K1)))
+		n += len(x.Secp256K1)
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -256,6 +310,7 @@ func _PrivateKey_OneofSizer(msg proto.Message) (n int) {
 type Signature struct {
 	// Types that are valid to be assigned to Sig:
 	//	*Signature_Ed25519
+	//	*Signature_Secp256K1
 	Sig isSignature_Sig `protobuf_oneof:"sig"`
 }
 
@@ -301,8 +356,12 @@ type isSignature_Sig interface {
 type Signature_Ed25519 struct {
 	Ed25519 []byte `protobuf:"bytes,1,opt,name=ed25519,proto3,oneof"`
 }
+type Signature_Secp256K1 struct {
+	Secp256K1 []byte `protobuf:"bytes,2,opt,name=secp256k1,proto3,oneof"`
+}
 
-func (*Signature_Ed25519) isSignature_Sig() {}
+func (*Signature_Ed25519) isSignature_Sig()   {}
+func (*Signature_Secp256K1) isSignature_Sig() {}
 
 func (m *Signature) GetSig() isSignature_Sig {
 	if m != nil {
@@ -318,10 +377,18 @@ func (m *Signature) GetEd25519() []byte {
 	return nil
 }
 
+func (m *Signature) GetSecp256K1() []byte {
+	if x, ok := m.GetSig().(*Signature_Secp256K1); ok {
+		return x.Secp256K1
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Signature) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Signature_OneofMarshaler, _Signature_OneofUnmarshaler, _Signature_OneofSizer, []interface{}{
 		(*Signature_Ed25519)(nil),
+		(*Signature_Secp256K1)(nil),
 	}
 }
 
@@ -332,6 +399,9 @@ func _Signature_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 	case *Signature_Ed25519:
 		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
 		_ = b.EncodeRawBytes(x.Ed25519)
+	case *Signature_Secp256K1:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.Secp256K1)
 	case nil:
 	default:
 		return fmt.Errorf("Signature.Sig has unexpected type %T", x)
@@ -349,6 +419,13 @@ func _Signature_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buff
 		x, err := b.DecodeRawBytes(true)
 		m.Sig = &Signature_Ed25519{x}
 		return true, err
+	case 2: // sig.secp256k1
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Sig = &Signature_Secp256K1{x}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -362,6 +439,10 @@ func _Signature_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(len(x.Ed25519)))
 		n += len(x.Ed25519)
+	case *Signature_Secp256K1:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Secp256K1)))
+		n += len(x.Secp256K1)
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -378,17 +459,19 @@ func init() {
 func init() { proto.RegisterFile("crypto/models.proto", fileDescriptor_16c93fab133ec0b1) }
 
 var fileDescriptor_16c93fab133ec0b1 = []byte{
-	// 160 bytes of a gzipped FileDescriptorProto
+	// 177 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4e, 0x2e, 0xaa, 0x2c,
 	0x28, 0xc9, 0xd7, 0xcf, 0xcd, 0x4f, 0x49, 0xcd, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x62, 0x83, 0x08, 0x2a, 0xe9, 0x71, 0x71, 0x06, 0x94, 0x26, 0xe5, 0x64, 0x26, 0x7b, 0xa7, 0x56,
+	0x62, 0x83, 0x08, 0x2a, 0xf9, 0x71, 0x71, 0x06, 0x94, 0x26, 0xe5, 0x64, 0x26, 0x7b, 0xa7, 0x56,
 	0x0a, 0x49, 0x71, 0xb1, 0xa7, 0xa6, 0x18, 0x99, 0x9a, 0x1a, 0x5a, 0x4a, 0x30, 0x2a, 0x30, 0x6a,
-	0xf0, 0x78, 0x30, 0x04, 0xc1, 0x04, 0x9c, 0x58, 0xb9, 0x98, 0x0b, 0x4a, 0x93, 0x94, 0x0c, 0xb8,
-	0xb8, 0x02, 0x8a, 0x32, 0xcb, 0x12, 0x4b, 0x52, 0x09, 0x69, 0x60, 0xe3, 0x62, 0x29, 0x28, 0xca,
-	0x2c, 0x03, 0xd9, 0x10, 0x9c, 0x99, 0x9e, 0x97, 0x58, 0x52, 0x5a, 0x94, 0x4a, 0xc8, 0x86, 0xe2,
-	0xcc, 0x74, 0x27, 0x89, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
-	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x48, 0x62, 0x03,
-	0x3b, 0xdd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x29, 0x87, 0x23, 0x3e, 0xd1, 0x00, 0x00, 0x00,
+	0xf0, 0x78, 0x30, 0x04, 0xc1, 0x04, 0x84, 0xe4, 0xb8, 0x38, 0x8b, 0x53, 0x93, 0x0b, 0x8c, 0x4c,
+	0xcd, 0xb2, 0x0d, 0x25, 0x98, 0xa0, 0xb2, 0x08, 0x21, 0x27, 0x56, 0x2e, 0xe6, 0x82, 0xd2, 0x24,
+	0xa5, 0x00, 0x2e, 0xae, 0x80, 0xa2, 0xcc, 0xb2, 0xc4, 0x92, 0x54, 0x4a, 0x0d, 0x64, 0xe3, 0x62,
+	0x29, 0x28, 0xca, 0x2c, 0x03, 0xb9, 0x30, 0x38, 0x33, 0x3d, 0x2f, 0xb1, 0xa4, 0xb4, 0x28, 0x95,
+	0x52, 0x17, 0x16, 0x67, 0xa6, 0x3b, 0x49, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
+	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
+	0x43, 0x12, 0x1b, 0x38, 0x68, 0x8c, 0x01, 0x03, 0x00, 0xed, 0x49, 0xd6, 0x14, 0x31, 0x01, 0x00,
+	0x00,
 }
 
 func (m *PublicKey) Marshal() (dAtA []byte, err error) {
@@ -426,6 +509,16 @@ func (m *PublicKey_Ed25519) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *PublicKey_Secp256K1) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Secp256K1 != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Secp256K1)))
+		i += copy(dAtA[i:], m.Secp256K1)
+	}
+	return i, nil
+}
 func (m *PrivateKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -461,6 +554,16 @@ func (m *PrivateKey_Ed25519) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *PrivateKey_Secp256K1) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Secp256K1 != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Secp256K1)))
+		i += copy(dAtA[i:], m.Secp256K1)
+	}
+	return i, nil
+}
 func (m *Signature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -496,6 +599,16 @@ func (m *Signature_Ed25519) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *Signature_Secp256K1) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Secp256K1 != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Secp256K1)))
+		i += copy(dAtA[i:], m.Secp256K1)
+	}
+	return i, nil
+}
 func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -529,6 +642,18 @@ func (m *PublicKey_Ed25519) Size() (n int) {
 	}
 	return n
 }
+func (m *PublicKey_Secp256K1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secp256K1 != nil {
+		l = len(m.Secp256K1)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
 func (m *PrivateKey) Size() (n int) {
 	if m == nil {
 		return 0
@@ -553,6 +678,18 @@ func (m *PrivateKey_Ed25519) Size() (n int) {
 	}
 	return n
 }
+func (m *PrivateKey_Secp256K1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secp256K1 != nil {
+		l = len(m.Secp256K1)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
 func (m *Signature) Size() (n int) {
 	if m == nil {
 		return 0
@@ -577,6 +714,18 @@ func (m *Signature_Ed25519) Size() (n int) {
 	}
 	return n
 }
+func (m *Signature_Secp256K1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secp256K1 != nil {
+		l = len(m.Secp256K1)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
 
 func sovModels(x uint64) (n int) {
 	for {
@@ -653,6 +802,39 @@ func (m *PublicKey) Unmarshal(dAtA []byte) error {
 			copy(v, dAtA[iNdEx:postIndex])
 			m.Pub = &PublicKey_Ed25519{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secp256K1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Pub = &PublicKey_Secp256K1{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -739,6 +921,39 @@ func (m *PrivateKey) Unmarshal(dAtA []byte) error {
 			copy(v, dAtA[iNdEx:postIndex])
 			m.Priv = &PrivateKey_Ed25519{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secp256K1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Priv = &PrivateKey_Secp256K1{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -825,6 +1040,39 @@ func (m *Signature) Unmarshal(dAtA []byte) error {
 			copy(v, dAtA[iNdEx:postIndex])
 			m.Sig = &Signature_Ed25519{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secp256K1", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Sig = &Signature_Secp256K1{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -0,0 +1,152 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+const (
+	// secp256k1SignatureSize is the size of a serialized R || S signature.
+	secp256k1SignatureSize = 64
+	// secp256k1PrivateKeySize is the size of a serialized private key.
+	secp256k1PrivateKeySize = 32
+	// secp256k1PublicKeySize is the size of a compressed public key.
+	secp256k1PublicKeySize = 33
+)
+
+// secp256k1HalfOrder is used to reject malleable signatures with S in the
+// upper half of the curve order.
+var secp256k1HalfOrder = new(big.Int).Rsh(btcec.S256().N, 1)
+
+var _ PubKey = (*PublicKey_Secp256K1)(nil)
+
+// Verify verifies the signature was created with this message and public key.
+// The message is hashed with SHA-256 before the verification. Only signatures
+// with S in the lower half of the curve order are accepted. The public key
+// must be in the compressed form, so that each key has a single condition.
+func (p *PublicKey_Secp256K1) Verify(message []byte, sig *Signature) bool {
+	secsig, ok := sig.GetSig().(*Signature_Secp256K1)
+	if !ok || len(secsig.Secp256K1) != secp256k1SignatureSize {
+		return false
+	}
+	if !isCompressedSecp256k1(p.Secp256K1) {
+		return false
+	}
+	pub, err := btcec.ParsePubKey(p.Secp256K1, btcec.S256())
+	if err != nil {
+		return false
+	}
+	signature := &btcec.Signature{
+		R: new(big.Int).SetBytes(secsig.Secp256K1[:32]),
+		S: new(big.Int).SetBytes(secsig.Secp256K1[32:]),
+	}
+	if signature.S.Cmp(secp256k1HalfOrder) > 0 {
+		return false
+	}
+	hash := sha256.Sum256(message)
+	return signature.Verify(hash[:], pub)
+}
+
+// Condition encodes the public key into a weave permission of the
+// sigs/secp256k1/<pubkey> form.
+func (p *PublicKey_Secp256K1) Condition() weave.Condition {
+	return weave.NewCondition(ExtensionName, "secp256k1", p.Secp256K1)
+}
+
+// isCompressedSecp256k1 returns true if given public key is serialized in the
+// compressed form. btcec.ParsePubKey accepts uncompressed and hybrid forms as
+// well.
+func isCompressedSecp256k1(pub []byte) bool {
+	return len(pub) == secp256k1PublicKeySize && (pub[0] == 0x02 || pub[0] == 0x03)
+}
+
+var _ Signer = (*PrivateKey_Secp256K1)(nil)
+
+// Sign returns a matching signature for this private key. The SHA-256 hash
+// of the message is signed using a deterministic nonce (RFC 6979).
+func (p *PrivateKey_Secp256K1) Sign(message []byte) (*Signature, error) {
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), p.Secp256K1)
+	hash := sha256.Sum256(message)
+	signature, err := priv.Sign(hash[:])
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	bz := append(paddedBytes(signature.R, 32), paddedBytes(signature.S, 32)...)
+	sig := &Signature{
+		Sig: &Signature_Secp256K1{
+			Secp256K1: bz,
+		},
+	}
+	return sig, nil
+}
+
+// PublicKey returns the corresponding PublicKey
+func (p *PrivateKey_Secp256K1) PublicKey() *PublicKey {
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), p.Secp256K1)
+	return &PublicKey{
+		Pub: &PublicKey_Secp256K1{
+			Secp256K1: pub.SerializeCompressed(),
+		},
+	}
+}
+
+// GenPrivKeySecp256k1 returns a random new private key
+func GenPrivKeySecp256k1() *PrivateKey {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		panic(err)
+	}
+	return secp256k1PrivateKey(priv)
+}
+
+// PrivKeySecp256k1FromSeed will deterministically generate a private key from
+// a given seed. Use if you have a strong source of external randomness,
+// or for deterministic keys in test cases.
+func PrivKeySecp256k1FromSeed(seed []byte) *PrivateKey {
+	hash := sha256.Sum256(seed)
+	// Reduce the hash to a valid, non zero scalar.
+	k := new(big.Int).SetBytes(hash[:])
+	k.Mod(k, new(big.Int).Sub(btcec.S256().N, big.NewInt(1)))
+	k.Add(k, big.NewInt(1))
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), k.Bytes())
+	return secp256k1PrivateKey(priv)
+}
+
+// PrivKeySecp256k1FromBytes returns a private key from its 32 byte
+// serialized form, as used by most secp256k1 wallets.
+func PrivKeySecp256k1FromBytes(raw []byte) (*PrivateKey, error) {
+	if len(raw) != secp256k1PrivateKeySize {
+		return nil, errors.Wrapf(errors.ErrInput, "invalid secp256k1 private key length %d", len(raw))
+	}
+	k := new(big.Int).SetBytes(raw)
+	if k.Sign() == 0 || k.Cmp(btcec.S256().N) >= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "secp256k1 private key out of range")
+	}
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), raw)
+	return secp256k1PrivateKey(priv), nil
+}
+
+func secp256k1PrivateKey(priv *btcec.PrivateKey) *PrivateKey {
+	bz := paddedBytes(priv.D, secp256k1PrivateKeySize)
+	return &PrivateKey{
+		Priv: &PrivateKey_Secp256K1{
+			Secp256K1: bz,
+		},
+	}
+}
+
+// paddedBytes returns the big endian representation of n, left padded with
+// zeros to given size.
+func paddedBytes(n *big.Int, size int) []byte {
+	bz := n.Bytes()
+	if len(bz) >= size {
+		return bz
+	}
+	padded := make([]byte, size)
+	copy(padded[size-len(bz):], bz)
+	return padded
+}
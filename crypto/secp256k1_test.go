@@ -0,0 +1,169 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestSecp256k1Signing(t *testing.T) {
+	private := GenPrivKeySecp256k1()
+	public := private.PublicKey()
+
+	msg := []byte("foobar")
+	msg2 := []byte("dingbooms")
+
+	sig, err := private.Sign(msg)
+	assert.Nil(t, err)
+	sig2, err := private.Sign(msg2)
+	assert.Nil(t, err)
+	assert.Equal(t, 64, len(sig.GetSecp256K1()))
+
+	// Signing is deterministic.
+	again, err := private.Sign(msg)
+	assert.Nil(t, err)
+	assert.Equal(t, sig, again)
+
+	if !public.Verify(msg, sig) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+	if !public.Verify(msg2, sig2) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+	if public.Verify(msg, sig2) {
+		t.Fatal("verified message signature of the wrong message")
+	}
+	if public.Verify(msg, &Signature{}) {
+		t.Fatal("verified an empty signature of a message")
+	}
+	if public.Verify(msg, nil) {
+		t.Fatal("verified a nil signature of a message")
+	}
+
+	other := GenPrivKeySecp256k1().PublicKey()
+	if other.Verify(msg, sig) {
+		t.Fatal("verified a signature with a different public key")
+	}
+
+	// An ed25519 signature is never valid for a secp256k1 key.
+	edsig, err := GenPrivKeyEd25519().Sign(msg)
+	assert.Nil(t, err)
+	if public.Verify(msg, edsig) {
+		t.Fatal("verified an ed25519 signature")
+	}
+}
+
+func TestSecp256k1RejectMalleableSignature(t *testing.T) {
+	private := PrivKeySecp256k1FromSeed([]byte("malleable"))
+	public := private.PublicKey()
+	msg := []byte("foobar")
+
+	sig, err := private.Sign(msg)
+	assert.Nil(t, err)
+	if !public.Verify(msg, sig) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+
+	// (R, N - S) is a valid ECDSA signature of the same message, but must
+	// be rejected to prevent signature malleability.
+	raw := sig.GetSecp256K1()
+	s := new(big.Int).SetBytes(raw[32:])
+	s.Sub(btcec.S256().N, s)
+	malleable := &Signature{
+		Sig: &Signature_Secp256K1{
+			Secp256K1: append(append([]byte{}, raw[:32]...), paddedBytes(s, 32)...),
+		},
+	}
+	if public.Verify(msg, malleable) {
+		t.Fatal("verified a signature with high S value")
+	}
+}
+
+func TestSecp256k1RejectUncompressedPublicKey(t *testing.T) {
+	private := PrivKeySecp256k1FromSeed([]byte("uncompressed"))
+	msg := []byte("foobar")
+	sig, err := private.Sign(msg)
+	assert.Nil(t, err)
+
+	pub, err := btcec.ParsePubKey(private.PublicKey().GetSecp256K1(), btcec.S256())
+	assert.Nil(t, err)
+	uncompressed := pub.SerializeUncompressed()
+	hybrid := pub.SerializeHybrid()
+
+	cases := map[string][]byte{
+		"uncompressed": uncompressed,
+		"hybrid":       hybrid,
+	}
+	for testName, raw := range cases {
+		t.Run(testName, func(t *testing.T) {
+			public := &PublicKey{Pub: &PublicKey_Secp256K1{Secp256K1: raw}}
+			if public.Verify(msg, sig) {
+				t.Fatal("verified a signature using a not compressed public key")
+			}
+		})
+	}
+}
+
+func TestSecp256k1Address(t *testing.T) {
+	pub := GenPrivKeySecp256k1().PublicKey()
+	pub2 := GenPrivKeySecp256k1().PublicKey()
+
+	assert.Nil(t, pub.Condition().Validate())
+	if bytes.Equal(pub.Condition(), pub2.Condition()) {
+		t.Fatal("different public keys produce the same condition")
+	}
+	ext, typ, data, err := pub.Condition().Parse()
+	assert.Nil(t, err)
+	assert.Equal(t, ExtensionName, ext)
+	assert.Equal(t, "secp256k1", typ)
+	assert.Equal(t, pub.GetSecp256K1(), data)
+
+	bz, err := pub.Marshal()
+	assert.Nil(t, err)
+	var read PublicKey
+	assert.Nil(t, read.Unmarshal(bz))
+	assert.Equal(t, read.Condition(), pub.Condition())
+}
+
+func TestPrivKeySecp256k1FromBytes(t *testing.T) {
+	one := make([]byte, 32)
+	one[31] = 1
+
+	priv, err := PrivKeySecp256k1FromBytes(one)
+	assert.Nil(t, err)
+	// Public key of the scalar one is the generator point.
+	const generator = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	assert.Equal(t, generator, hex.EncodeToString(priv.PublicKey().GetSecp256K1()))
+
+	cases := map[string][]byte{
+		"nil":         nil,
+		"too short":   one[1:],
+		"too long":    append([]byte{0}, one...),
+		"zero":        make([]byte, 32),
+		"curve order": paddedBytes(btcec.S256().N, 32),
+	}
+	for testName, raw := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if _, err := PrivKeySecp256k1FromBytes(raw); !errors.ErrInput.Is(err) {
+				t.Fatalf("want input error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPrivKeySecp256k1FromSeed(t *testing.T) {
+	a := PrivKeySecp256k1FromSeed([]byte("seed"))
+	b := PrivKeySecp256k1FromSeed([]byte("seed"))
+	c := PrivKeySecp256k1FromSeed([]byte("other seed"))
+
+	assert.Equal(t, a, b)
+	if bytes.Equal(a.GetSecp256K1(), c.GetSecp256K1()) {
+		t.Fatal("different seeds produce the same key")
+	}
+	assert.Equal(t, 32, len(a.GetSecp256K1()))
+}
@@ -25,6 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type ExecutedMigration struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Progress is set only for a migration executed in steps that is not yet
+	// completed. It is the value returned by the last executed step.
+	Progress []byte `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
 }
 
 func (m *ExecutedMigration) Reset()         { *m = ExecutedMigration{} }
@@ -67,6 +70,13 @@ func (m *ExecutedMigration) GetMetadata() *weave.Metadata {
 	return nil
 }
 
+func (m *ExecutedMigration) GetProgress() []byte {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
 type ExecuteMigrationMsg struct {
 	Metadata    *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	MigrationID string          `protobuf:"bytes,2,opt,name=migration_id,json=migrationId,proto3" json:"migration_id,omitempty"`
@@ -127,20 +137,21 @@ func init() {
 func init() { proto.RegisterFile("datamigration/codec.proto", fileDescriptor_69fd09856d0ac9c6) }
 
 var fileDescriptor_69fd09856d0ac9c6 = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
+	// 209 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0x49, 0x2c, 0x49,
 	0xcc, 0xcd, 0x4c, 0x2f, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x4f, 0xce, 0x4f, 0x49, 0x4d, 0xd6,
 	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x45, 0x91, 0x92, 0xe2, 0x46, 0x92, 0x93, 0x12, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x92, 0x03, 0x97, 0xa0, 0x6b, 0x45,
+	0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52, 0x0c, 0x97, 0xa0, 0x6b, 0x45,
 	0x6a, 0x72, 0x69, 0x49, 0x6a, 0x8a, 0x2f, 0x4c, 0x9f, 0x90, 0x36, 0x17, 0x47, 0x6e, 0x6a, 0x49,
 	0x22, 0xc8, 0x30, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x7e, 0xbd, 0xf2, 0xd4, 0xc4, 0xb2,
-	0x54, 0x3d, 0x5f, 0xa8, 0x70, 0x10, 0x5c, 0x81, 0x52, 0x19, 0x97, 0x30, 0xd4, 0x04, 0xb8, 0x01,
-	0xbe, 0xc5, 0xe9, 0x24, 0x99, 0x21, 0x64, 0xc4, 0xc5, 0x03, 0x77, 0x75, 0x7c, 0x66, 0x8a, 0x04,
-	0x93, 0x02, 0xa3, 0x06, 0xa7, 0x13, 0xff, 0xa3, 0x7b, 0xf2, 0xdc, 0x70, 0x43, 0x3d, 0x5d, 0x82,
-	0xb8, 0xe1, 0x8a, 0x3c, 0x53, 0x9c, 0x24, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0x89, 0x0d, 0xec, 0x35, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x89, 0x73, 0x6f,
-	0x29, 0x01, 0x00, 0x00,
+	0x54, 0x3d, 0x5f, 0xa8, 0x70, 0x10, 0x5c, 0x81, 0x90, 0x14, 0x17, 0x47, 0x41, 0x51, 0x7e, 0x7a,
+	0x51, 0x6a, 0x71, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x4f, 0x10, 0x9c, 0xaf, 0x54, 0xc6, 0x25,
+	0x0c, 0x35, 0x1d, 0x6e, 0xb8, 0x6f, 0x71, 0x3a, 0x69, 0xe6, 0x1b, 0x71, 0xf1, 0xc0, 0x7d, 0x14,
+	0x9f, 0x99, 0x02, 0xb6, 0x83, 0xd3, 0x89, 0xff, 0xd1, 0x3d, 0x79, 0x6e, 0xb8, 0xa1, 0x9e, 0x2e,
+	0x41, 0xdc, 0x70, 0x45, 0x9e, 0x29, 0x4e, 0x12, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
+	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
+	0xc7, 0x90, 0xc4, 0x06, 0xf6, 0xb6, 0x31, 0x60, 0x00, 0xdd, 0x34, 0xf1, 0xe6, 0x45, 0x01, 0x00,
+	0x00,
 }
 
 func (m *ExecutedMigration) Marshal() (dAtA []byte, err error) {
@@ -168,6 +179,12 @@ func (m *ExecutedMigration) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.Progress) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Progress)))
+		i += copy(dAtA[i:], m.Progress)
+	}
 	return i, nil
 }
 
@@ -224,6 +241,10 @@ func (m *ExecutedMigration) Size() (n int) {
 		l = m.Metadata.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	l = len(m.Progress)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -322,6 +343,40 @@ func (m *ExecutedMigration) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = append(m.Progress[:0], dAtA[iNdEx:postIndex]...)
+			if m.Progress == nil {
+				m.Progress = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -37,5 +37,16 @@ input (i.e. `time.Now` or `rand` package).
 A registered migration must not be deleted or altered. This is mandatory in
 order for the state replying functionality.
 
+A migration that is too big to be executed in a single transaction can be
+declared using `MigrateStep` instead of `Migrate`. Each `ExecuteMigrationMsg`
+executes a single step and the progress is stored on the chain, until the
+migration is completed.
+
+Adding a new index to an existing bucket does not index entities that were
+stored before. Use `Reindex` or `ReindexStep` to declare a migration that
+backfills such an index. `orm.ModelBucket.CheckIndex` reports entities that
+are missing from an index. Counts and sums configured for an existing native
+index must be initialized using `Recount`.
+
 */
 package datamigration
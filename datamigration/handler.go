@@ -26,26 +26,33 @@ type executeMigrationHandler struct {
 }
 
 func (h *executeMigrationHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	if _, _, err := h.validate(ctx, db, tx); err != nil {
+	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, errors.Wrap(err, "invalid message")
 	}
 	return &weave.CheckResult{GasAllocated: 0}, nil
 }
 
 func (h *executeMigrationHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	msg, mig, err := h.validate(ctx, db, tx)
+	msg, mig, progress, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid message")
 	}
 
-	if err := mig.Migrate(ctx, db); err != nil {
+	if mig.MigrateStep != nil {
+		progress, err = mig.MigrateStep(ctx, db, progress)
+	} else {
+		err = mig.Migrate(ctx, db)
+	}
+	if err != nil {
 		return nil, errors.Wrap(err, "migration failed")
 	}
 
 	// Create a record in the database to remember that this migration was
-	// executed.
+	// executed. A migration executed in steps is completed once there is
+	// no more progress to remember.
 	fix := &ExecutedMigration{
 		Metadata: &weave.Metadata{},
+		Progress: progress,
 	}
 	if _, err := h.bucket.Put(db, []byte(msg.MigrationID), fix); err != nil {
 		return nil, errors.Wrap(err, "cannot persist an information about executed migration")
@@ -54,36 +61,41 @@ func (h *executeMigrationHandler) Deliver(ctx weave.Context, db weave.KVStore, t
 	return &weave.DeliverResult{}, nil
 }
 
-func (h *executeMigrationHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ExecuteMigrationMsg, *Migration, error) {
+// validate returns the message, the migration it refers to and the progress of
+// that migration if it is executed in steps and was already started.
+func (h *executeMigrationHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ExecuteMigrationMsg, *Migration, []byte, error) {
 	var msg ExecuteMigrationMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
-		return nil, nil, errors.Wrap(err, "load msg")
+		return nil, nil, nil, errors.Wrap(err, "load msg")
 	}
 
 	m, err := h.reg.Migration(msg.MigrationID)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "cannot get migration")
+		return nil, nil, nil, errors.Wrap(err, "cannot get migration")
 	}
 
 	for _, s := range m.RequiredSigners {
 		if !h.auth.HasAddress(ctx, s) {
-			return nil, nil, errors.Wrap(errors.ErrUnauthorized, "missing signature")
+			return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "missing signature")
 		}
 	}
 
 	if !containStr(m.ChainIDs, weave.GetChainID(ctx)) {
-		return nil, nil, errors.Wrapf(errors.ErrChain, "allowed only on %q chains", m.ChainIDs)
+		return nil, nil, nil, errors.Wrapf(errors.ErrChain, "allowed only on %q chains", m.ChainIDs)
 	}
 
-	switch err := h.bucket.Has(db, []byte(msg.MigrationID)); {
+	var executed ExecutedMigration
+	switch err := h.bucket.One(db, []byte(msg.MigrationID), &executed); {
 	case errors.ErrNotFound.Is(err):
 		// All good.
 	case err == nil:
-		return nil, nil, errors.Wrap(errors.ErrState, "migration already executed")
+		if len(executed.Progress) == 0 {
+			return nil, nil, nil, errors.Wrap(errors.ErrState, "migration already executed")
+		}
 	default:
-		return nil, nil, errors.Wrap(err, "cannot check if migration was executed")
+		return nil, nil, nil, errors.Wrap(err, "cannot check if migration was executed")
 	}
-	return &msg, m, nil
+	return &msg, m, executed.Progress, nil
 }
 
 func containStr(collection []string, item string) bool {
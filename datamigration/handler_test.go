@@ -2,6 +2,7 @@ package datamigration
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 )
@@ -140,6 +142,133 @@ func TestHandler(t *testing.T) {
 	}
 }
 
+func TestHandlerMigrationInSteps(t *testing.T) {
+	defer withNewRegister()()
+
+	aliceCond := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "datamigration")
+
+	// Counters are stored before the index is declared.
+	counters := orm.NewModelBucket("cnts", &orm.Counter{})
+	for i := int64(1); i <= 5; i++ {
+		if _, err := counters.Put(db, nil, &orm.Counter{Count: i}); err != nil {
+			t.Fatalf("cannot save counter: %s", err)
+		}
+	}
+	counters = orm.NewModelBucket("cnts", &orm.Counter{},
+		orm.WithIndex("count", func(obj orm.Object) ([]byte, error) {
+			return []byte(strconv.FormatInt(obj.Value().(*orm.Counter).Count, 10)), nil
+		}, false),
+	)
+
+	MustRegister("index counters", Migration{
+		ChainIDs:        []string{"testchain"},
+		RequiredSigners: []weave.Address{aliceCond.Address()},
+		MigrateStep:     ReindexStep(counters, "count", 2),
+	})
+
+	rt := app.NewRouter()
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	RegisterRoutes(rt, auth)
+
+	ctx := weave.WithHeight(context.Background(), 100)
+	ctx = weave.WithChainID(ctx, "testchain")
+	ctx = auth.SetConditions(ctx, aliceCond)
+
+	tx := &weavetest.Tx{
+		Msg: &ExecuteMigrationMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			MigrationID: "index counters",
+		},
+	}
+
+	// Five counters are indexed in three steps.
+	wantMissing := []int{3, 1, 0}
+	for i, want := range wantMissing {
+		if _, err := rt.Deliver(ctx, db, tx); err != nil {
+			t.Fatalf("cannot deliver step %d: %s", i, err)
+		}
+		missing, err := counters.CheckIndex(db, "count")
+		if err != nil {
+			t.Fatalf("cannot check index: %s", err)
+		}
+		if len(missing) != want {
+			t.Fatalf("step %d: want %d missing, got %d", i, want, len(missing))
+		}
+	}
+
+	if _, err := rt.Deliver(ctx, db, tx); !errors.ErrState.Is(err) {
+		t.Fatalf("completed migration must not be executed again: %s", err)
+	}
+}
+
+func TestHandlerRecountMigration(t *testing.T) {
+	defer withNewRegister()()
+
+	aliceCond := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "datamigration")
+
+	indexByParity := func(obj orm.Object) ([][]byte, error) {
+		if obj.Value().(*orm.Counter).Count%2 == 0 {
+			return [][]byte{[]byte("even")}, nil
+		}
+		return [][]byte{[]byte("odd")}, nil
+	}
+
+	// Counters are indexed before the index count is configured.
+	counters := orm.NewModelBucket("cnts", &orm.Counter{},
+		orm.WithNativeIndex("parity", indexByParity))
+	for i := int64(1); i <= 5; i++ {
+		if _, err := counters.Put(db, nil, &orm.Counter{Count: i}); err != nil {
+			t.Fatalf("cannot save counter: %s", err)
+		}
+	}
+	counters = orm.NewModelBucket("cnts", &orm.Counter{},
+		orm.WithNativeIndex("parity", indexByParity, orm.WithIndexCount()))
+
+	MustRegister("count parity", Migration{
+		ChainIDs:        []string{"testchain"},
+		RequiredSigners: []weave.Address{aliceCond.Address()},
+		Migrate:         Recount(counters, "parity"),
+	})
+
+	rt := app.NewRouter()
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	RegisterRoutes(rt, auth)
+
+	ctx := weave.WithHeight(context.Background(), 100)
+	ctx = weave.WithChainID(ctx, "testchain")
+	ctx = auth.SetConditions(ctx, aliceCond)
+
+	tx := &weavetest.Tx{
+		Msg: &ExecuteMigrationMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			MigrationID: "count parity",
+		},
+	}
+	if _, err := rt.Deliver(ctx, db, tx); err != nil {
+		t.Fatalf("cannot deliver: %s", err)
+	}
+
+	idx, err := counters.Index("parity")
+	if err != nil {
+		t.Fatalf("cannot get index: %s", err)
+	}
+	for value, want := range map[string]int64{"odd": 3, "even": 2} {
+		n, err := idx.Count(db, []byte(value))
+		if err != nil {
+			t.Fatalf("cannot count %q: %s", value, err)
+		}
+		if n != want {
+			t.Fatalf("want %d %q counters, got %d", want, value, n)
+		}
+	}
+}
+
 // withNewRegister is a test helper that modifies the reference of the global
 // initialization register. To ensure that each test is running using a custom
 // register, overwrite the global register reference with an empty instance.
@@ -0,0 +1,74 @@
+package datamigration
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file.
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisExecutedMigration struct {
+	MigrationID string `json:"migration_id"`
+	// Progress is set only for a migration executed in steps that is not
+	// yet completed.
+	Progress []byte `json:"progress,omitempty"`
+}
+
+// FromGenesis loads the information about executed migrations, so that they
+// are not executed again.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	var executed []genesisExecutedMigration
+	if err := opts.ReadOptions("datamigration", &executed); err != nil {
+		return err
+	}
+	bucket := NewExecutedMigrationBucket()
+	for i, g := range executed {
+		if g.MigrationID == "" {
+			return errors.Wrapf(errors.ErrEmpty, "executed migration %d: migration ID", i)
+		}
+		switch err := bucket.Has(kv, []byte(g.MigrationID)); {
+		case err == nil:
+			return errors.Wrapf(errors.ErrDuplicate, "executed migration %d", i)
+		case !errors.ErrNotFound.Is(err):
+			return errors.Wrapf(err, "executed migration %d", i)
+		}
+		em := ExecutedMigration{
+			Metadata: &weave.Metadata{Schema: 1},
+			Progress: g.Progress,
+		}
+		if _, err := bucket.Put(kv, []byte(g.MigrationID), &em); err != nil {
+			return errors.Wrapf(err, "executed migration %d", i)
+		}
+	}
+	return nil
+}
+
+// ToGenesis exports the information about all executed migrations.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	executed := []genesisExecutedMigration{}
+	it := orm.IterAll("execmig")
+	for {
+		var em ExecutedMigration
+		switch key, err := it.Next(kv, &em); {
+		case err == nil:
+			executed = append(executed, genesisExecutedMigration{
+				MigrationID: string(key),
+				Progress:    em.Progress,
+			})
+		case errors.ErrIteratorDone.Is(err):
+			opts := make(weave.Options)
+			if err := opts.WriteOptions("datamigration", executed); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "executed migration")
+		}
+	}
+}
@@ -44,7 +44,16 @@ type Migration struct {
 	// different state.
 	ChainIDs []string
 
+	// Migrate is the migration function. It is executed once.
 	Migrate func(context.Context, weave.KVStore) error
+
+	// MigrateStep can be used instead of Migrate for a migration that is
+	// too big to be executed in a single transaction. Each execution of
+	// the migration calls this function once. Progress is nil for the
+	// first step, otherwise it is the value returned by the previous step.
+	// Progress is stored on the chain. Returning nil progress marks the
+	// migration as completed.
+	MigrateStep func(ctx context.Context, db weave.KVStore, progress []byte) ([]byte, error)
 }
 
 func (r *register) Register(migrationID string, m Migration) error {
@@ -68,6 +77,10 @@ func (r *register) Register(migrationID string, m Migration) error {
 		}
 	}
 
+	if m.Migrate != nil && m.MigrateStep != nil {
+		return errors.Wrap(errors.ErrInput, "only one of migrate functions can be given")
+	}
+
 	r.defs[migrationID] = m
 	return nil
 }
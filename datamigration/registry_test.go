@@ -24,3 +24,16 @@ func TestMigrationCanBeRegisteredOnce(t *testing.T) {
 		t.Fatalf("expected ErrState, got %+v", err)
 	}
 }
+
+func TestMigrationWithTwoMigrateFunctions(t *testing.T) {
+	reg := newRegister()
+
+	noop := func(context.Context, weave.KVStore) error { return nil }
+	noopStep := func(context.Context, weave.KVStore, []byte) ([]byte, error) { return nil, nil }
+	sigs := []weave.Address{weavetest.NewCondition().Address()}
+
+	m := Migration{ChainIDs: []string{"chain-a"}, RequiredSigners: sigs, Migrate: noop, MigrateStep: noopStep}
+	if err := reg.Register("migration name", m); !errors.ErrInput.Is(err) {
+		t.Fatalf("expected ErrInput, got %+v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package datamigration
+
+import (
+	"context"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// Reindex returns a migration function that indexes all entities of given
+// bucket by the index with given name. Use it after a new index was added to
+// a bucket that already contains entities. Entities that are already indexed
+// are not modified.
+//
+// The whole bucket is processed in a single transaction. For a big bucket use
+// ReindexStep instead.
+func Reindex(b orm.ModelBucket, indexName string) func(context.Context, weave.KVStore) error {
+	return func(ctx context.Context, db weave.KVStore) error {
+		if _, err := b.Reindex(db, indexName, nil, 0); err != nil {
+			return errors.Wrapf(err, "reindex %q", indexName)
+		}
+		return nil
+	}
+}
+
+// ReindexStep returns a migration step function that indexes at most
+// batchSize entities of given bucket by the index with given name. The key of
+// the next entity to index is the progress of the migration.
+func ReindexStep(b orm.ModelBucket, indexName string, batchSize int) func(context.Context, weave.KVStore, []byte) ([]byte, error) {
+	return func(ctx context.Context, db weave.KVStore, progress []byte) ([]byte, error) {
+		next, err := b.Reindex(db, indexName, progress, batchSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reindex %q", indexName)
+		}
+		return next, nil
+	}
+}
+
+// Recount returns a migration function that recomputes the count and sums
+// maintained by the native index with given name. Use it after an aggregate
+// was configured for an index that already holds entries (see
+// orm.WithIndexCount and orm.WithIndexSum).
+//
+// Aggregates cannot be recomputed partially, so the whole bucket is always
+// processed in a single transaction.
+func Recount(b orm.ModelBucket, indexName string) func(context.Context, weave.KVStore) error {
+	return func(ctx context.Context, db weave.KVStore) error {
+		if err := b.RecountIndex(db, indexName); err != nil {
+			return errors.Wrapf(err, "recount %q", indexName)
+		}
+		return nil
+	}
+}
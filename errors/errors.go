@@ -87,6 +87,10 @@ var (
 	// it cannot be executed on the current chain
 	ErrChain = Register(23, "invalid chain")
 
+	// ErrOutOfGas is returned when an operation cannot be completed
+	// because the gas limit was exceeded.
+	ErrOutOfGas = Register(24, "out of gas")
+
 	// ErrNetwork is returned on network failure (only for client libraries)
 	ErrNetwork = Register(100200, "network")
 
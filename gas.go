@@ -0,0 +1,292 @@
+package weave
+
+import (
+	"context"
+	"math"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// GasMeter tracks the amount of gas consumed while processing a single
+// transaction. Once the limit is exceeded, consuming more gas fails with
+// errors.ErrOutOfGas.
+type GasMeter interface {
+	// ConsumeGas charges given amount of gas. Descriptor is a short
+	// description of the operation, used in the error message.
+	ConsumeGas(amount int64, descriptor string) error
+	// GasConsumed returns the total amount of gas consumed so far.
+	GasConsumed() int64
+	// GasLimit returns the maximum amount of gas that can be consumed.
+	// Zero means there is no limit.
+	GasLimit() int64
+}
+
+// NewGasMeter returns a gas meter that allows to consume up to limit gas.
+// Zero limit creates a meter without a limit that only counts the consumed
+// gas.
+func NewGasMeter(limit int64) GasMeter {
+	return &gasMeter{limit: limit}
+}
+
+type gasMeter struct {
+	limit    int64
+	consumed int64
+}
+
+func (m *gasMeter) ConsumeGas(amount int64, descriptor string) error {
+	if amount < 0 {
+		return errors.Wrapf(errors.ErrHuman, "negative gas amount for %s", descriptor)
+	}
+	if m.consumed > math.MaxInt64-amount {
+		return errors.Wrap(errors.ErrOverflow, "gas consumed")
+	}
+	m.consumed += amount
+	if m.limit > 0 && m.consumed > m.limit {
+		return errors.Wrapf(errors.ErrOutOfGas, "%s: consumed %d, limit %d", descriptor, m.consumed, m.limit)
+	}
+	return nil
+}
+
+func (m *gasMeter) GasConsumed() int64 {
+	return m.consumed
+}
+
+func (m *gasMeter) GasLimit() int64 {
+	return m.limit
+}
+
+// WithGasMeter sets the gas meter for the Context.
+// Panics if a gas meter was already set, as all operations within a single
+// transaction must be charged to the same meter.
+func WithGasMeter(ctx Context, meter GasMeter) Context {
+	if _, ok := GetGasMeter(ctx); ok {
+		panic("gas meter already set")
+	}
+	return context.WithValue(ctx, contextKeyGasMeter, meter)
+}
+
+// GetGasMeter returns the gas meter of the currently processed transaction.
+func GetGasMeter(ctx Context) (GasMeter, bool) {
+	val, ok := ctx.Value(contextKeyGasMeter).(GasMeter)
+	return val, ok
+}
+
+// ConsumeGas charges given amount of gas to the gas meter of the Context.
+// It does nothing if the Context does not have a gas meter, for example
+// when processing a block instead of a transaction.
+func ConsumeGas(ctx Context, amount int64, descriptor string) error {
+	meter, ok := GetGasMeter(ctx)
+	if !ok {
+		return nil
+	}
+	return meter.ConsumeGas(amount, descriptor)
+}
+
+// GasLimitedTx is implemented by transactions that declare the maximum
+// amount of gas that can be consumed while processing them.
+type GasLimitedTx interface {
+	Tx
+	// GetGasLimit returns the gas limit of the transaction. Zero means
+	// no limit.
+	GetGasLimit() int64
+}
+
+// TxGasLimit returns the gas limit declared by the transaction or zero if
+// the transaction does not declare any.
+func TxGasLimit(tx Tx) int64 {
+	if gtx, ok := tx.(GasLimitedTx); ok {
+		return gtx.GetGasLimit()
+	}
+	return 0
+}
+
+// GasConfig declares how much gas is charged for store operations. Byte
+// costs are charged for every byte of both the key and the value.
+type GasConfig struct {
+	HasCost          int64
+	ReadCostFlat     int64
+	ReadCostPerByte  int64
+	WriteCostFlat    int64
+	WriteCostPerByte int64
+	DeleteCost       int64
+	IterNextCostFlat int64
+}
+
+// DefaultGasConfig returns the gas costs of store operations used by the
+// application unless configured otherwise.
+func DefaultGasConfig() GasConfig {
+	return GasConfig{
+		HasCost:          10,
+		ReadCostFlat:     10,
+		ReadCostPerByte:  1,
+		WriteCostFlat:    20,
+		WriteCostPerByte: 3,
+		DeleteCost:       10,
+		IterNextCostFlat: 5,
+	}
+}
+
+// NewGasKVStore returns a store that charges the given gas meter for every
+// operation performed on the wrapped store.
+// Cache wraps created from the returned store are charged as well. Writing
+// a cache wrap is free, because all operations were already charged.
+func NewGasKVStore(kv CacheableKVStore, meter GasMeter, conf GasConfig) CacheableKVStore {
+	return &gasKVStore{kv: kv, meter: meter, conf: conf}
+}
+
+type gasKVStore struct {
+	kv    CacheableKVStore
+	meter GasMeter
+	conf  GasConfig
+}
+
+var _ CacheableKVStore = (*gasKVStore)(nil)
+
+func (s *gasKVStore) Get(key []byte) ([]byte, error) {
+	if err := s.meter.ConsumeGas(s.conf.ReadCostFlat, "read"); err != nil {
+		return nil, err
+	}
+	value, err := s.kv.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	cost := s.conf.ReadCostPerByte * int64(len(key)+len(value))
+	if err := s.meter.ConsumeGas(cost, "read"); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (s *gasKVStore) Has(key []byte) (bool, error) {
+	if err := s.meter.ConsumeGas(s.conf.HasCost, "has"); err != nil {
+		return false, err
+	}
+	return s.kv.Has(key)
+}
+
+func (s *gasKVStore) Set(key, value []byte) error {
+	if err := chargeWrite(s.meter, s.conf, key, value); err != nil {
+		return err
+	}
+	return s.kv.Set(key, value)
+}
+
+func (s *gasKVStore) Delete(key []byte) error {
+	if err := s.meter.ConsumeGas(s.conf.DeleteCost, "delete"); err != nil {
+		return err
+	}
+	return s.kv.Delete(key)
+}
+
+func (s *gasKVStore) Iterator(start, end []byte) (Iterator, error) {
+	it, err := s.kv.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &gasIterator{it: it, meter: s.meter, conf: s.conf}, nil
+}
+
+func (s *gasKVStore) ReverseIterator(start, end []byte) (Iterator, error) {
+	it, err := s.kv.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &gasIterator{it: it, meter: s.meter, conf: s.conf}, nil
+}
+
+func (s *gasKVStore) NewBatch() Batch {
+	return &gasBatch{b: s.kv.NewBatch(), meter: s.meter, conf: s.conf}
+}
+
+func (s *gasKVStore) CacheWrap() KVCacheWrap {
+	cache := s.kv.CacheWrap()
+	return &gasCacheWrap{
+		gasKVStore: gasKVStore{kv: cache, meter: s.meter, conf: s.conf},
+		cache:      cache,
+	}
+}
+
+// UnmeteredKVStore returns the store wrapped by NewGasKVStore, so that
+// operations performed on it are not charged. This is meant for settling
+// transaction fees, which must succeed even if all the gas was consumed, and
+// for bookkeeping of the chain that must not be charged to the transaction.
+// Any other store is returned unchanged.
+func UnmeteredKVStore(kv KVStore) KVStore {
+	switch s := kv.(type) {
+	case *gasKVStore:
+		return s.kv
+	case *gasCacheWrap:
+		return s.cache
+	default:
+		return kv
+	}
+}
+
+func chargeWrite(meter GasMeter, conf GasConfig, key, value []byte) error {
+	cost := conf.WriteCostFlat + conf.WriteCostPerByte*int64(len(key)+len(value))
+	return meter.ConsumeGas(cost, "write")
+}
+
+type gasCacheWrap struct {
+	gasKVStore
+	cache KVCacheWrap
+}
+
+var _ KVCacheWrap = (*gasCacheWrap)(nil)
+
+func (c *gasCacheWrap) Write() error {
+	return c.cache.Write()
+}
+
+func (c *gasCacheWrap) Discard() {
+	c.cache.Discard()
+}
+
+type gasIterator struct {
+	it    Iterator
+	meter GasMeter
+	conf  GasConfig
+}
+
+func (i *gasIterator) Next() ([]byte, []byte, error) {
+	if err := i.meter.ConsumeGas(i.conf.IterNextCostFlat, "iterate"); err != nil {
+		return nil, nil, err
+	}
+	key, value, err := i.it.Next()
+	if err != nil {
+		return key, value, err
+	}
+	cost := i.conf.ReadCostPerByte * int64(len(key)+len(value))
+	if err := i.meter.ConsumeGas(cost, "iterate"); err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
+
+func (i *gasIterator) Release() {
+	i.it.Release()
+}
+
+type gasBatch struct {
+	b     Batch
+	meter GasMeter
+	conf  GasConfig
+}
+
+func (b *gasBatch) Set(key, value []byte) error {
+	if err := chargeWrite(b.meter, b.conf, key, value); err != nil {
+		return err
+	}
+	return b.b.Set(key, value)
+}
+
+func (b *gasBatch) Delete(key []byte) error {
+	if err := b.meter.ConsumeGas(b.conf.DeleteCost, "delete"); err != nil {
+		return err
+	}
+	return b.b.Delete(key)
+}
+
+func (b *gasBatch) Write() error {
+	return b.b.Write()
+}
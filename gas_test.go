@@ -0,0 +1,124 @@
+package weave_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGasMeter(t *testing.T) {
+	meter := weave.NewGasMeter(100)
+	assert.Nil(t, meter.ConsumeGas(60, "first"))
+	assert.Nil(t, meter.ConsumeGas(40, "second"))
+	assert.Equal(t, int64(100), meter.GasConsumed())
+
+	if err := meter.ConsumeGas(1, "third"); !errors.ErrOutOfGas.Is(err) {
+		t.Fatalf("want out of gas error, got %v", err)
+	}
+	if err := meter.ConsumeGas(-1, "negative"); !errors.ErrHuman.Is(err) {
+		t.Fatalf("want coding error, got %v", err)
+	}
+
+	unlimited := weave.NewGasMeter(0)
+	assert.Nil(t, unlimited.ConsumeGas(1<<40, "a lot"))
+	assert.Equal(t, int64(1<<40), unlimited.GasConsumed())
+}
+
+func TestGasMeterContext(t *testing.T) {
+	ctx := context.Background()
+
+	// Without a meter nothing is charged.
+	assert.Nil(t, weave.ConsumeGas(ctx, 1000, "free"))
+
+	meter := weave.NewGasMeter(10)
+	ctx = weave.WithGasMeter(ctx, meter)
+	got, ok := weave.GetGasMeter(ctx)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, meter, got)
+	assert.Panics(t, func() { weave.WithGasMeter(ctx, weave.NewGasMeter(1)) })
+
+	assert.Nil(t, weave.ConsumeGas(ctx, 10, "all"))
+	if err := weave.ConsumeGas(ctx, 1, "more"); !errors.ErrOutOfGas.Is(err) {
+		t.Fatalf("want out of gas error, got %v", err)
+	}
+}
+
+func TestGasKVStore(t *testing.T) {
+	conf := weave.GasConfig{
+		HasCost:          1,
+		ReadCostFlat:     10,
+		ReadCostPerByte:  2,
+		WriteCostFlat:    100,
+		WriteCostPerByte: 20,
+		DeleteCost:       1000,
+		IterNextCostFlat: 10000,
+	}
+	meter := weave.NewGasMeter(0)
+	db := weave.NewGasKVStore(store.MemStore(), meter, conf)
+
+	charged := func(t testing.TB, want int64, fn func()) {
+		t.Helper()
+		before := meter.GasConsumed()
+		fn()
+		if got := meter.GasConsumed() - before; got != want {
+			t.Fatalf("want %d gas charged, got %d", want, got)
+		}
+	}
+
+	charged(t, 100+20*5, func() { assert.Nil(t, db.Set([]byte("ab"), []byte("cde"))) })
+	charged(t, 10+2*5, func() {
+		v, err := db.Get([]byte("ab"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("cde"), v)
+	})
+	charged(t, 10+2*3, func() {
+		v, err := db.Get([]byte("xyz"))
+		assert.Nil(t, err)
+		assert.Nil(t, v)
+	})
+	charged(t, 1, func() {
+		ok, err := db.Has([]byte("ab"))
+		assert.Nil(t, err)
+		assert.Equal(t, true, ok)
+	})
+	charged(t, 10000+2*5+10000, func() {
+		it, err := db.Iterator(nil, nil)
+		assert.Nil(t, err)
+		defer it.Release()
+		_, _, err = it.Next()
+		assert.Nil(t, err)
+		if _, _, err := it.Next(); !errors.ErrIteratorDone.Is(err) {
+			t.Fatalf("want iterator done, got %v", err)
+		}
+	})
+
+	// Operations on a cache wrap are charged, but writing it is free.
+	cache := db.CacheWrap()
+	charged(t, 100+20*2, func() { assert.Nil(t, cache.Set([]byte("a"), []byte("b"))) })
+	charged(t, 1000, func() { assert.Nil(t, cache.Delete([]byte("ab"))) })
+	charged(t, 0, func() { assert.Nil(t, cache.Write()) })
+
+	// So is writing a batch.
+	batch := db.NewBatch()
+	charged(t, 100+20*2, func() { assert.Nil(t, batch.Set([]byte("c"), []byte("d"))) })
+	charged(t, 0, func() { assert.Nil(t, batch.Write()) })
+}
+
+func TestGasKVStoreOutOfGas(t *testing.T) {
+	conf := weave.DefaultGasConfig()
+	meter := weave.NewGasMeter(conf.WriteCostFlat)
+	raw := store.MemStore()
+	db := weave.NewGasKVStore(raw, meter, conf)
+
+	if err := db.Set([]byte("key"), []byte("value")); !errors.ErrOutOfGas.Is(err) {
+		t.Fatalf("want out of gas error, got %v", err)
+	}
+	// An operation that was not paid for must not be executed.
+	ok, err := raw.Has([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, false, ok)
+}
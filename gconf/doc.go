@@ -23,6 +23,9 @@ from the genesis into the database,
 
 5. Use `Load` function to load your configuration state from the database,
 
+6. use `ExportConfig` inside of your extension exporter to copy configuration
+from the database into the genesis,
+
 
 See existing extensions for an example of how to use this package.
 
@@ -86,3 +86,22 @@ func InitConfig(db Store, opts weave.Options, pkg string, conf Configuration) er
 	}
 	return nil
 }
+
+// ExportConfig will load the configuration of given package from the
+// database and return it as the genesis options in the format accepted by
+// InitConfig.
+// Returns ErrNotFound if there is no configuration stored.
+func ExportConfig(db ReadStore, pkg string, conf Configuration) (weave.Options, error) {
+	if err := Load(db, pkg, conf); err != nil {
+		return nil, err
+	}
+	confOptions := make(weave.Options)
+	if err := confOptions.WriteOptions(pkg, conf); err != nil {
+		return nil, errors.Wrapf(err, "write configuration for %s", pkg)
+	}
+	opts := make(weave.Options)
+	if err := opts.WriteOptions("conf", confOptions); err != nil {
+		return nil, errors.Wrap(err, "write conf")
+	}
+	return opts, nil
+}
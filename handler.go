@@ -63,6 +63,17 @@ func (o Options) ReadOptions(key string, obj interface{}) error {
 	return json.Unmarshal(msg, obj)
 }
 
+// WriteOptions serializes given obj into json and stores it under a given
+// key. This is the reverse of ReadOptions.
+func (o Options) WriteOptions(key string, obj interface{}) error {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return errors.Wrapf(errors.ErrInput, "cannot serialize %q: %s", key, err)
+	}
+	o[key] = raw
+	return nil
+}
+
 // Stream expects an array of json elements and allows to process them sequentially
 // this helps when one needs to parse a large json without having any memory leaks.
 // Returns ErrEmpty on empty key or when there are no more elements.
@@ -126,3 +137,10 @@ func FromInitChain(req abci.RequestInitChain) GenesisParams {
 type Initializer interface {
 	FromGenesis(opts Options, params GenesisParams, kv KVStore) error
 }
+
+// Exporter implementations are used to export the state of extensions into
+// genesis file contents. Returned options must be accepted by the
+// Initializer of the same extension.
+type Exporter interface {
+	ToGenesis(kv ReadOnlyKVStore) (Options, error)
+}
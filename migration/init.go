@@ -4,6 +4,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the InitStater interface to load data from
@@ -11,6 +12,7 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = Initializer{}
+var _ weave.Exporter = Initializer{}
 
 // FromGenesis will parse initial account info from genesis
 // and save it to the database
@@ -19,10 +21,7 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 		return errors.Wrap(err, "migration config")
 	}
 
-	var packages []struct {
-		Ver uint32 `json:"ver"`
-		Pkg string `json:"pkg"`
-	}
+	var packages []genesisSchema
 	if err := opts.ReadOptions("initialize_schema", &packages); err != nil {
 		return errors.Wrap(err, "initialize schema")
 	}
@@ -60,3 +59,42 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 
 	return nil
 }
+
+type genesisSchema struct {
+	Ver uint32 `json:"ver"`
+	Pkg string `json:"pkg"`
+}
+
+// ToGenesis will export the configuration and the highest schema version
+// of every package from the database.
+func (Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	opts, err := gconf.ExportConfig(kv, "migration", &Configuration{})
+	if err != nil {
+		return nil, errors.Wrap(err, "migration config")
+	}
+
+	var packages []genesisSchema
+	versions := make(map[string]int)
+	it := orm.IterAll("schema")
+	for {
+		var s Schema
+		switch _, err := it.Next(kv, &s); {
+		case err == nil:
+			if i, ok := versions[s.Pkg]; ok {
+				if packages[i].Ver < s.Version {
+					packages[i].Ver = s.Version
+				}
+				continue
+			}
+			versions[s.Pkg] = len(packages)
+			packages = append(packages, genesisSchema{Ver: s.Version, Pkg: s.Pkg})
+		case errors.ErrIteratorDone.Is(err):
+			if err := opts.WriteOptions("initialize_schema", packages); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "schema")
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"reflect"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
 )
@@ -78,6 +79,21 @@ func (svb Bucket) WithMultiKeyIndex(name string, indexer orm.MultiKeyIndexer, un
 	return svb
 }
 
+func (svb Bucket) WithCount() orm.Bucket {
+	svb.Bucket = svb.Bucket.WithCount()
+	return svb
+}
+
+func (svb Bucket) WithSum(name string, fn orm.Summer) orm.Bucket {
+	svb.Bucket = svb.Bucket.WithSum(name, fn)
+	return svb
+}
+
+func (svb Bucket) WithCoinSum(name string, fn orm.CoinSummer) orm.Bucket {
+	svb.Bucket = svb.Bucket.WithCoinSum(name, fn)
+	return svb
+}
+
 // ModelBucket implements the orm.ModelBucket interface and provides the same
 // functionality with additional model schema migration.
 type ModelBucket struct {
@@ -121,7 +137,25 @@ func (m *ModelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key []
 	if err != nil {
 		return nil, err
 	}
+	if err := m.migrateSlice(db, dest); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
 
+func (m *ModelBucket) ByIndexRange(db weave.ReadOnlyKVStore, indexName string, prefix, start, end, cursor []byte, limit int, dest orm.ModelSlicePtr) ([][]byte, []byte, error) {
+	keys, next, err := m.b.ByIndexRange(db, indexName, prefix, start, end, cursor, limit, dest)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := m.migrateSlice(db, dest); err != nil {
+		return nil, nil, err
+	}
+	return keys, next, nil
+}
+
+// migrateSlice migrates all models of given slice.
+func (m *ModelBucket) migrateSlice(db weave.ReadOnlyKVStore, dest orm.ModelSlicePtr) error {
 	// The correct type of the dest was already validated by the
 	// ModelBucket when getting data by index. We can safely skip checks -
 	// dest is a slice of models.
@@ -139,10 +173,34 @@ func (m *ModelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key []
 		}
 
 		if err := m.migrate(db, model); err != nil {
-			return nil, errors.Wrapf(err, "migrate %d element", i)
+			return errors.Wrapf(err, "migrate %d element", i)
 		}
 	}
-	return keys, nil
+	return nil
+}
+
+func (m *ModelBucket) Reindex(db weave.KVStore, indexName string, start []byte, limit int) ([]byte, error) {
+	return m.b.Reindex(db, indexName, start, limit)
+}
+
+func (m *ModelBucket) RecountIndex(db weave.KVStore, indexName string) error {
+	return m.b.RecountIndex(db, indexName)
+}
+
+func (m *ModelBucket) CheckIndex(db weave.ReadOnlyKVStore, indexName string) ([][]byte, error) {
+	return m.b.CheckIndex(db, indexName)
+}
+
+func (m *ModelBucket) Count(db weave.ReadOnlyKVStore) (int64, error) {
+	return m.b.Count(db)
+}
+
+func (m *ModelBucket) Sum(db weave.ReadOnlyKVStore, name string) (int64, error) {
+	return m.b.Sum(db, name)
+}
+
+func (m *ModelBucket) SumCoin(db weave.ReadOnlyKVStore, name string) (*coin.Coin, error) {
+	return m.b.SumCoin(db, name)
 }
 
 func (m *ModelBucket) Put(db weave.KVStore, key []byte, model orm.Model) ([]byte, error) {
@@ -232,6 +232,12 @@ func TestSchemaVersionedModelBucket(t *testing.T) {
 	}
 	assert.Equal(t, wantv, setv)
 
+	// ByIndexRange must migrate models as well.
+	var setr []*MyModel
+	if _, _, err := b.ByIndexRange(db, "const", []byte("al"), nil, nil, nil, 0, &setr); err != nil {
+		t.Fatalf("cannot query by index range: %s", err)
+	}
+	assert.Equal(t, wantp, setr)
 }
 
 func assertMyModelState(t testing.TB, m *MyModel, wantSchemaVersion uint32, wantCnt int) {
@@ -0,0 +1,328 @@
+package orm
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+)
+
+const aggregatePrefix = "_c."
+
+// Summer returns the value that given object contributes to a sum maintained
+// over all entities of a bucket. A sum that would overflow int64 cannot be
+// stored. Use CoinSummer for coin amounts.
+type Summer func(Object) (int64, error)
+
+// CoinSummer returns the amount that given object contributes to a coin sum
+// maintained over all entities of a bucket. All amounts of a sum must be of
+// the same currency. A zero coin without a ticker contributes nothing.
+type CoinSummer func(Object) (coin.Coin, error)
+
+// bucketAggregate is a value maintained over all entities of a bucket. Count
+// is an aggregate with an empty name that every entity contributes one to.
+// Exactly one of sum and coinSum is set.
+type bucketAggregate struct {
+	name    string
+	sum     Summer
+	coinSum CoinSummer
+}
+
+func countOne(Object) (int64, error) {
+	return 1, nil
+}
+
+// WithCount returns a copy of this bucket that maintains the number of
+// stored entities.
+//
+// Only entities stored after the count was configured are counted. Use a data
+// migration to initialize the count of a bucket that already holds entities.
+//
+// Panics if the count is already maintained.
+func (b bucket) WithCount() Bucket {
+	return b.withAggregate(bucketAggregate{sum: countOne})
+}
+
+// WithSum returns a copy of this bucket that maintains a sum of values
+// returned by given function for all stored entities.
+//
+// Only entities stored after the sum was configured are summed. Use a data
+// migration to initialize the sum of a bucket that already holds entities.
+//
+// Panics if a sum with that name is already registered.
+func (b bucket) WithSum(name string, fn Summer) Bucket {
+	if name == "" {
+		panic("sum name is required")
+	}
+	return b.withAggregate(bucketAggregate{name: name, sum: fn})
+}
+
+// WithCoinSum returns a copy of this bucket that maintains a sum of coins
+// returned by given function for all stored entities.
+//
+// Only entities stored after the sum was configured are summed. Use a data
+// migration to initialize the sum of a bucket that already holds entities.
+//
+// Panics if a sum with that name is already registered.
+func (b bucket) WithCoinSum(name string, fn CoinSummer) Bucket {
+	if name == "" {
+		panic("sum name is required")
+	}
+	return b.withAggregate(bucketAggregate{name: name, coinSum: fn})
+}
+
+func (b bucket) withAggregate(a bucketAggregate) Bucket {
+	if b.aggregate(a.name) != nil {
+		panic(fmt.Sprintf("Aggregate %q registered twice", a.name))
+	}
+	// Copy to not modify the array of the original bucket.
+	aggregates := make([]bucketAggregate, len(b.aggregates), len(b.aggregates)+1)
+	copy(aggregates, b.aggregates)
+	b.aggregates = append(aggregates, a)
+	return b
+}
+
+func (b bucket) aggregate(name string) *bucketAggregate {
+	for i, a := range b.aggregates {
+		if a.name == name {
+			return &b.aggregates[i]
+		}
+	}
+	return nil
+}
+
+// Count returns the number of entities stored in this bucket. It returns
+// ErrInput if the bucket does not maintain the count.
+func (b bucket) Count(db weave.ReadOnlyKVStore) (int64, error) {
+	c, err := b.loadAggregate(db, "")
+	if err != nil {
+		return 0, err
+	}
+	return c.Count, nil
+}
+
+// Sum returns the sum with given name maintained over all entities stored in
+// this bucket. It returns ErrInput if the bucket does not maintain such sum.
+func (b bucket) Sum(db weave.ReadOnlyKVStore, name string) (int64, error) {
+	if name == "" {
+		return 0, errors.Wrap(errors.ErrInput, "sum name is required")
+	}
+	c, err := b.loadAggregate(db, name)
+	if err != nil {
+		return 0, err
+	}
+	return c.Count, nil
+}
+
+// SumCoin returns the coin sum with given name maintained over all entities
+// stored in this bucket. It returns ErrInput if the bucket does not maintain
+// such coin sum.
+func (b bucket) SumCoin(db weave.ReadOnlyKVStore, name string) (*coin.Coin, error) {
+	if a := b.aggregate(name); a == nil || a.coinSum == nil {
+		return nil, errors.Wrapf(errors.ErrInput, "bucket does not maintain %q coin sum", name)
+	}
+	return loadCoinSum(db, b.aggregateKey(name))
+}
+
+// queryAggregate returns a single model holding the serialized counter of an
+// aggregate with given name, or the serialized coin if it is a coin sum.
+func (b bucket) queryAggregate(db weave.ReadOnlyKVStore, name string) ([]weave.Model, error) {
+	var value weave.Persistent
+	if a := b.aggregate(name); a != nil && a.coinSum != nil {
+		c, err := loadCoinSum(db, b.aggregateKey(name))
+		if err != nil {
+			return nil, err
+		}
+		value = c
+	} else {
+		c, err := b.loadAggregate(db, name)
+		if err != nil {
+			return nil, err
+		}
+		value = c
+	}
+	raw, err := value.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal aggregate")
+	}
+	return []weave.Model{{Key: b.aggregateKey(name), Value: raw}}, nil
+}
+
+func (b bucket) loadAggregate(db weave.ReadOnlyKVStore, name string) (*Counter, error) {
+	if a := b.aggregate(name); a == nil || a.sum == nil {
+		if name == "" {
+			return nil, errors.Wrap(errors.ErrInput, "bucket does not maintain the count")
+		}
+		return nil, errors.Wrapf(errors.ErrInput, "bucket does not maintain %q sum", name)
+	}
+	return loadCounter(db, b.aggregateKey(name))
+}
+
+// aggregateKey returns the database key that an aggregate with given name is
+// stored under. Aggregate key is using the _c.<bucket>:<name> pattern.
+func (b bucket) aggregateKey(name string) []byte {
+	return []byte(aggregatePrefix + b.name + ":" + name)
+}
+
+// updateAggregates updates all aggregates maintained by this bucket.
+//
+// prev == nil means insert
+// next == nil means delete
+func (b bucket) updateAggregates(db weave.KVStore, prev, next Object) error {
+	for _, a := range b.aggregates {
+		var err error
+		if a.coinSum != nil {
+			err = updateCoinSum(db, b.aggregateKey(a.name), a.coinSum, prev, next)
+		} else {
+			err = updateSum(db, b.aggregateKey(a.name), a.sum, prev, next)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "aggregate %q", a.name)
+		}
+	}
+	return nil
+}
+
+func updateSum(db weave.KVStore, key []byte, fn Summer, prev, next Object) error {
+	var prevN, nextN int64
+	if prev != nil {
+		n, err := fn(prev)
+		if err != nil {
+			return err
+		}
+		prevN = n
+	}
+	if next != nil {
+		n, err := fn(next)
+		if err != nil {
+			return err
+		}
+		nextN = n
+	}
+	c, err := loadCounter(db, key)
+	if err != nil {
+		return err
+	}
+	if prevN == math.MinInt64 {
+		return errors.Wrapf(errors.ErrOverflow, "%d", prevN)
+	}
+	total, err := add64(c.Count, -prevN)
+	if err != nil {
+		return err
+	}
+	if total, err = add64(total, nextN); err != nil {
+		return err
+	}
+	if total == c.Count {
+		return nil
+	}
+	return saveCounter(db, key, total)
+}
+
+func updateCoinSum(db weave.KVStore, key []byte, fn CoinSummer, prev, next Object) error {
+	total, err := loadCoinSum(db, key)
+	if err != nil {
+		return err
+	}
+	sum := *total
+	if prev != nil {
+		c, err := fn(prev)
+		if err != nil {
+			return err
+		}
+		if sum, err = sum.Subtract(c); err != nil {
+			return err
+		}
+	}
+	if next != nil {
+		c, err := fn(next)
+		if err != nil {
+			return err
+		}
+		if sum, err = sum.Add(c); err != nil {
+			return err
+		}
+	}
+	if sum.Equals(*total) {
+		return nil
+	}
+	raw, err := sum.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "marshal coin")
+	}
+	if err := db.Set(key, raw); err != nil {
+		return errors.Wrap(err, "db set")
+	}
+	return nil
+}
+
+// loadCoinSum returns the coin stored under given key. A coin sum that does
+// not exist is zero.
+func loadCoinSum(db weave.ReadOnlyKVStore, key []byte) (*coin.Coin, error) {
+	raw, err := db.Get(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "db get")
+	}
+	var c coin.Coin
+	if raw != nil {
+		if err := c.Unmarshal(raw); err != nil {
+			return nil, errors.Wrap(errors.ErrState, err.Error())
+		}
+	}
+	return &c, nil
+}
+
+// loadCounter returns the counter stored under given key. A counter that
+// does not exist is zero.
+func loadCounter(db weave.ReadOnlyKVStore, key []byte) (*Counter, error) {
+	raw, err := db.Get(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "db get")
+	}
+	var c Counter
+	if raw != nil {
+		if err := c.Unmarshal(raw); err != nil {
+			return nil, errors.Wrap(errors.ErrState, err.Error())
+		}
+	}
+	return &c, nil
+}
+
+// addToCounter adds given value to the counter stored under given key. It
+// returns ErrOverflow if the result does not fit int64.
+func addToCounter(db weave.KVStore, key []byte, diff int64) error {
+	if diff == 0 {
+		return nil
+	}
+	c, err := loadCounter(db, key)
+	if err != nil {
+		return err
+	}
+	total, err := add64(c.Count, diff)
+	if err != nil {
+		return err
+	}
+	return saveCounter(db, key, total)
+}
+
+func saveCounter(db weave.KVStore, key []byte, count int64) error {
+	raw, err := NewCounter(count).Marshal()
+	if err != nil {
+		return errors.Wrap(err, "marshal counter")
+	}
+	if err := db.Set(key, raw); err != nil {
+		return errors.Wrap(err, "db set")
+	}
+	return nil
+}
+
+// add64 returns the sum of given values or ErrOverflow if it does not fit
+// int64.
+func add64(a, b int64) (int64, error) {
+	if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+		return 0, errors.Wrapf(errors.ErrOverflow, "%d + %d", a, b)
+	}
+	return a + b, nil
+}
@@ -0,0 +1,302 @@
+package orm
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestModelBucketAggregates(t *testing.T) {
+	db := store.MemStore()
+
+	sumCounts := func(obj Object) (int64, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return 0, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		return c.Count, nil
+	}
+	b := NewModelBucket("cnts", &Counter{},
+		WithCount(),
+		WithSum("total", sumCounts),
+	)
+
+	assertAggregates := func(t testing.TB, wantCount, wantTotal int64) {
+		t.Helper()
+
+		count, err := b.Count(db)
+		if err != nil {
+			t.Fatalf("cannot count: %s", err)
+		}
+		assert.Equal(t, wantCount, count)
+
+		total, err := b.Sum(db, "total")
+		if err != nil {
+			t.Fatalf("cannot sum: %s", err)
+		}
+		assert.Equal(t, wantTotal, total)
+
+		qr := weave.NewQueryRouter()
+		b.Register("counters", qr)
+		h := qr.Handler("/counters")
+		assert.Equal(t, wantCount, queryCounter(t, db, h, weave.CountQueryMod, nil))
+		assert.Equal(t, wantTotal, queryCounter(t, db, h, weave.SumQueryMod, []byte("total")))
+	}
+
+	assertAggregates(t, 0, 0)
+
+	k1, err := b.Put(db, nil, &Counter{Count: 3})
+	assert.Nil(t, err)
+	k2, err := b.Put(db, nil, &Counter{Count: 5})
+	assert.Nil(t, err)
+	assertAggregates(t, 2, 8)
+
+	// Update changes only the sum.
+	_, err = b.Put(db, k1, &Counter{Count: 10})
+	assert.Nil(t, err)
+	assertAggregates(t, 2, 15)
+
+	assert.Nil(t, b.Delete(db, k2))
+	assertAggregates(t, 1, 10)
+
+	if _, err := b.Sum(db, "unknown"); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for an unknown sum, got %v", err)
+	}
+
+	if _, err := b.SumCoin(db, "total"); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for a not coin sum, got %v", err)
+	}
+
+	// Sum that does not fit int64 is rejected.
+	cache := db.CacheWrap()
+	if _, err := b.Put(cache, nil, &Counter{Count: math.MaxInt64}); !errors.ErrOverflow.Is(err) {
+		t.Fatalf("want overflow error, got %v", err)
+	}
+	cache.Discard()
+	assertAggregates(t, 1, 10)
+
+	plain := NewModelBucket("cnts", &Counter{})
+	if _, err := plain.Count(db); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for a bucket without a count, got %v", err)
+	}
+	qr := weave.NewQueryRouter()
+	plain.Register("counters", qr)
+	if _, err := qr.Handler("/counters").Query(db, weave.CountQueryMod, nil); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for a count query, got %v", err)
+	}
+}
+
+func TestModelBucketCoinSum(t *testing.T) {
+	db := store.MemStore()
+
+	// Counter value is used as a fractional amount, so that summing
+	// requires a carry to the whole part.
+	sumCoins := func(obj Object) (coin.Coin, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return coin.Coin{}, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		return coin.NewCoin(0, c.Count, "IOV"), nil
+	}
+	b := NewModelBucket("cnts", &Counter{}, WithCoinSum("total", sumCoins))
+
+	k1, err := b.Put(db, nil, &Counter{Count: 600000000})
+	assert.Nil(t, err)
+	_, err = b.Put(db, nil, &Counter{Count: 700000000})
+	assert.Nil(t, err)
+
+	total, err := b.SumCoin(db, "total")
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoinp(1, 300000000, "IOV"), total)
+
+	assert.Nil(t, b.Delete(db, k1))
+	total, err = b.SumCoin(db, "total")
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoinp(0, 700000000, "IOV"), total)
+
+	qr := weave.NewQueryRouter()
+	b.Register("counters", qr)
+	models, err := qr.Handler("/counters").Query(db, weave.SumQueryMod, []byte("total"))
+	assert.Nil(t, err)
+	var got coin.Coin
+	assert.Nil(t, got.Unmarshal(models[0].Value))
+	assert.Equal(t, *total, got)
+
+	if _, err := b.Sum(db, "total"); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for a coin sum, got %v", err)
+	}
+}
+
+func TestIndexCount(t *testing.T) {
+	db := store.MemStore()
+
+	// Every counter is indexed under its value and under "all".
+	indexByCount := func(obj Object) ([][]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		return [][]byte{[]byte(strconv.FormatInt(c.Count, 10)), []byte("all")}, nil
+	}
+	b := NewModelBucket("cnts", &Counter{},
+		WithNativeIndex("native", indexByCount, WithIndexCount()),
+		WithIndex("compact", indexByCount, false),
+	)
+	qr := weave.NewQueryRouter()
+	b.Register("counters", qr)
+
+	k1, err := b.Put(db, nil, &Counter{Count: 1})
+	assert.Nil(t, err)
+	_, err = b.Put(db, nil, &Counter{Count: 1})
+	assert.Nil(t, err)
+	_, err = b.Put(db, nil, &Counter{Count: 2})
+	assert.Nil(t, err)
+	_, err = b.Put(db, k1, &Counter{Count: 2})
+	assert.Nil(t, err)
+
+	for _, indexName := range []string{"native", "compact"} {
+		t.Run(indexName, func(t *testing.T) {
+			idx, err := b.Index(indexName)
+			if err != nil {
+				t.Fatalf("cannot get index: %s", err)
+			}
+			cases := map[string]int64{
+				"1":       1,
+				"2":       2,
+				"all":     3,
+				"unknown": 0,
+			}
+			h := qr.Handler("/counters/" + indexName)
+			for value, want := range cases {
+				n, err := idx.Count(db, []byte(value))
+				if err != nil {
+					t.Fatalf("cannot count %q: %s", value, err)
+				}
+				assert.Equal(t, want, n)
+				assert.Equal(t, want, queryCounter(t, db, h, weave.CountQueryMod, []byte(value)))
+			}
+
+			// Count entries must not be returned by index queries.
+			models, err := h.Query(db, weave.RangeQueryMod, nil)
+			if err != nil {
+				t.Fatalf("cannot query index: %s", err)
+			}
+			assert.Equal(t, 6, len(models))
+		})
+	}
+}
+
+func TestNativeIndexAggregates(t *testing.T) {
+	db := store.MemStore()
+
+	indexByParity := func(obj Object) ([][]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		if c.Count%2 == 0 {
+			return [][]byte{[]byte("even")}, nil
+		}
+		return [][]byte{[]byte("odd")}, nil
+	}
+	sumCounts := func(obj Object) (int64, error) {
+		return obj.Value().(*Counter).Count, nil
+	}
+
+	// Entities are indexed before aggregates are configured.
+	b := NewModelBucket("cnts", &Counter{}, WithNativeIndex("parity", indexByParity))
+	k1, err := b.Put(db, nil, &Counter{Count: 1})
+	assert.Nil(t, err)
+	for _, n := range []int64{2, 3, 4} {
+		if _, err := b.Put(db, nil, &Counter{Count: n}); err != nil {
+			t.Fatalf("cannot save counter: %s", err)
+		}
+	}
+	idx, err := b.Index("parity")
+	assert.Nil(t, err)
+	if _, err := idx.Count(db, []byte("odd")); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for an index without a count, got %v", err)
+	}
+	assertNoAggregates := func(t testing.TB) {
+		t.Helper()
+		start, end := prefixRange([]byte(nativeIdxCountPrefix))
+		it, err := db.Iterator(start, end)
+		assert.Nil(t, err)
+		keys, err := consumeIteratorKeys(it)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(keys))
+	}
+	assertNoAggregates(t)
+
+	b = NewModelBucket("cnts", &Counter{},
+		WithNativeIndex("parity", indexByParity,
+			WithIndexCount(),
+			WithIndexSum("total", sumCounts),
+		),
+	)
+	assert.Nil(t, b.RecountIndex(db, "parity"))
+
+	qr := weave.NewQueryRouter()
+	b.Register("counters", qr)
+	h := qr.Handler("/counters/parity")
+	assertAggregates := func(t testing.TB, value string, wantCount, wantTotal int64) {
+		t.Helper()
+		idx, err := b.Index("parity")
+		assert.Nil(t, err)
+		count, err := idx.Count(db, []byte(value))
+		assert.Nil(t, err)
+		assert.Equal(t, wantCount, count)
+		total, err := idx.Sum(db, "total", []byte(value))
+		assert.Nil(t, err)
+		assert.Equal(t, wantTotal, total)
+		assert.Equal(t, wantTotal, queryCounter(t, db, h, weave.SumQueryMod, []byte("total:"+value)))
+	}
+	assertAggregates(t, "odd", 2, 4)
+	assertAggregates(t, "even", 2, 6)
+
+	// Moving an entity to a different value updates both aggregates.
+	_, err = b.Put(db, k1, &Counter{Count: 10})
+	assert.Nil(t, err)
+	assertAggregates(t, "odd", 1, 3)
+	assertAggregates(t, "even", 3, 16)
+
+	// Recount of a consistent index does not change it.
+	assert.Nil(t, b.RecountIndex(db, "parity"))
+	assertAggregates(t, "odd", 1, 3)
+	assertAggregates(t, "even", 3, 16)
+
+	if _, err := h.Query(db, weave.SumQueryMod, []byte("unknown:odd")); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for an unknown sum, got %v", err)
+	}
+	if _, err := h.Query(db, weave.SumQueryMod, []byte("odd")); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for a malformed sum query, got %v", err)
+	}
+
+	// Index entries are not returned together with aggregates.
+	models, err := h.Query(db, weave.RangeQueryMod, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(models))
+}
+
+func queryCounter(t testing.TB, db weave.ReadOnlyKVStore, h weave.QueryHandler, mod string, data []byte) int64 {
+	t.Helper()
+
+	models, err := h.Query(db, mod, data)
+	if err != nil {
+		t.Fatalf("cannot query %q: %s", mod, err)
+	}
+	if len(models) != 1 {
+		t.Fatalf("want one result, got %d", len(models))
+	}
+	var c Counter
+	if err := c.Unmarshal(models[0].Value); err != nil {
+		t.Fatalf("cannot unmarshal counter: %s", err)
+	}
+	return c.Count
+}
@@ -23,6 +23,7 @@ import (
 	"sort"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 )
 
@@ -34,7 +35,7 @@ const (
 var isBucketName = regexp.MustCompile(`^[a-z_]{3,10}$`).MatchString
 
 type Bucket interface {
-	weave.QueryHandler
+	weave.PagedQueryHandler
 
 	DBKey(key []byte) []byte
 	Delete(db weave.KVStore, key []byte) error
@@ -66,7 +67,34 @@ type Bucket interface {
 	// iterator. This implementation is suitable for big collections.
 	//
 	// Panics if it an index with that name is already registered.
-	WithNativeIndex(name string, indexer MultiKeyIndexer) Bucket
+	WithNativeIndex(name string, indexer MultiKeyIndexer, opts ...NativeIndexOption) Bucket
+
+	// WithCount returns a copy of this bucket that maintains the number
+	// of stored entities.
+	// Panics if the count is already maintained.
+	WithCount() Bucket
+
+	// WithSum returns a copy of this bucket that maintains a sum of
+	// values returned by given function for all stored entities.
+	// Panics if a sum with that name is already registered.
+	WithSum(name string, fn Summer) Bucket
+
+	// WithCoinSum returns a copy of this bucket that maintains a sum of
+	// coins returned by given function for all stored entities.
+	// Panics if a sum with that name is already registered.
+	WithCoinSum(name string, fn CoinSummer) Bucket
+
+	// Count returns the number of stored entities. It returns ErrInput if
+	// the bucket does not maintain the count.
+	Count(db weave.ReadOnlyKVStore) (int64, error)
+
+	// Sum returns the sum with given name. It returns ErrInput if the
+	// bucket does not maintain such sum.
+	Sum(db weave.ReadOnlyKVStore, name string) (int64, error)
+
+	// SumCoin returns the coin sum with given name. It returns ErrInput
+	// if the bucket does not maintain such coin sum.
+	SumCoin(db weave.ReadOnlyKVStore, name string) (*coin.Coin, error)
 }
 
 // bucket is a generic holder that stores data as well
@@ -83,9 +111,12 @@ type bucket struct {
 	model  reflect.Type
 	// index is a list of indexes sorted by
 	indexes boundIndexes
+	// aggregates is a list of counters maintained over all entities.
+	aggregates []bucketAggregate
 }
 
 var _ Bucket = (*bucket)(nil)
+var _ weave.PagedQueryHandler = bucket{}
 
 type bucketBoundIndex struct {
 	idx        Index
@@ -131,6 +162,9 @@ func (b bucket) Register(name string, r weave.QueryRouter) {
 	}
 	root := "/" + name
 	r.Register(root, b)
+	if b.model != nil {
+		r.RegisterModel(b.name, reflect.New(b.model).Interface().(Model))
+	}
 	for _, ni := range b.indexes {
 		r.Register(root+"/"+ni.publicName, ni.idx)
 	}
@@ -138,44 +172,75 @@ func (b bucket) Register(name string, r weave.QueryRouter) {
 
 // Query handles queries from the QueryRouter.
 func (b bucket) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, _, err := b.QueryPage(db, mod, data, weave.QueryPage{})
+	return models, err
+}
+
+// QueryPage handles paginated queries from the QueryRouter. Range queries
+// return at most queryRangeLimit models unless a different limit is
+// requested. Other queries are not limited by default.
+func (b bucket) QueryPage(db weave.ReadOnlyKVStore, mod string, data []byte, page weave.QueryPage) ([]weave.Model, []byte, error) {
 	switch mod {
-	case weave.KeyQueryMod:
-		key := b.DBKey(data)
-		value, err := db.Get(key)
+	case weave.KeyQueryMod, weave.PrefixQueryMod, weave.RangeQueryMod:
+		start, end, limit, err := b.QueryRange(mod, data, page)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		it, err := db.Iterator(start, end)
+		if err != nil {
+			return nil, nil, err
 		}
-		if value == nil {
-			return nil, nil
+		return consumePage(&dbCursorIterator{it: it}, limit)
+	case weave.CountQueryMod:
+		if len(data) != 0 {
+			return nil, nil, errors.Wrap(errors.ErrInput, "count query data must be empty")
 		}
-		res := []weave.Model{{Key: key, Value: value}}
-		return res, nil
+		models, err := b.queryAggregate(db, "")
+		return models, nil, err
+	case weave.SumQueryMod:
+		if len(data) == 0 {
+			return nil, nil, errors.Wrap(errors.ErrInput, "sum name is required")
+		}
+		models, err := b.queryAggregate(db, string(data))
+		return models, nil, err
+	default:
+		return nil, nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
+	}
+}
+
+// QueryRange returns the [start, end) database key range that is read to
+// answer a key, prefix or range query and the maximum number of returned
+// models. Zero limit means no limit. If more models are stored within that
+// range, the cursor of the first model that was not returned is read as
+// well.
+//
+// This allows a client to check that a proof of the query result covers
+// exactly the requested data.
+func (b bucket) QueryRange(mod string, data []byte, page weave.QueryPage) (start, end []byte, limit int, err error) {
+	switch mod {
+	case weave.KeyQueryMod:
+		start = b.DBKey(data)
+		end = append(b.DBKey(data), 0)
 	case weave.PrefixQueryMod:
-		prefix := b.DBKey(data)
-		return queryPrefix(db, prefix)
+		start, end = prefixRange(b.DBKey(data))
 	case weave.RangeQueryMod:
-		start, end, err := parseQueryRange(data)
+		from, to, err := parseQueryRange(data)
 		if err != nil {
-			return nil, errors.Wrap(err, "query data")
+			return nil, nil, 0, errors.Wrap(err, "query data")
 		}
-		if len(end) == 0 {
-			end = bytes.Repeat([]byte{255}, 128) // No limit
+		if len(to) == 0 {
+			to = bytes.Repeat([]byte{255}, 128) // No limit
 		} else {
-			end = append(end,
+			to = append(to,
 				0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 				0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
 		}
-		it, err := db.Iterator(b.DBKey(start), b.DBKey(end))
-		if err != nil {
-			return nil, err
-		}
-		return consumeIterator(&paginatedIterator{
-			it:        it,
-			remaining: queryRangeLimit,
-		})
+		start, end = b.DBKey(from), b.DBKey(to)
+		limit = queryRangeLimit
 	default:
-		return nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
+		return nil, nil, 0, errors.Wrapf(errors.ErrInput, "%s query does not read a key range", mod)
 	}
+	return pageRange(start, end, page, limit)
 }
 
 // parseQueryRange parse given query data and return range query information.
@@ -276,7 +341,7 @@ func (b bucket) Save(db weave.KVStore, model Object) error {
 	if err != nil {
 		return err
 	}
-	err = b.updateIndexes(db, model.Key(), model)
+	err = b.update(db, model.Key(), model)
 	if err != nil {
 		return err
 	}
@@ -289,7 +354,7 @@ func (b bucket) Save(db weave.KVStore, model Object) error {
 
 // Delete will remove the value at a key
 func (b bucket) Delete(db weave.KVStore, key []byte) error {
-	err := b.updateIndexes(db, key, nil)
+	err := b.update(db, key, nil)
 	if err != nil {
 		return err
 	}
@@ -299,21 +364,26 @@ func (b bucket) Delete(db weave.KVStore, key []byte) error {
 	return db.Delete(dbkey)
 }
 
-func (b bucket) updateIndexes(db weave.KVStore, key []byte, model Object) error {
+// update updates all indexes and aggregates of this bucket.
+func (b bucket) update(db weave.KVStore, key []byte, model Object) error {
+	if len(b.indexes) == 0 && len(b.aggregates) == 0 {
+		return nil
+	}
+	prev, err := b.Get(db, key)
+	if err != nil {
+		return err
+	}
 	// update all indexes
-	if len(b.indexes) > 0 {
-		prev, err := b.Get(db, key)
+	for _, ni := range b.indexes {
+		err = ni.idx.Update(db, prev, model)
 		if err != nil {
 			return err
 		}
-		for _, ni := range b.indexes {
-			err = ni.idx.Update(db, prev, model)
-			if err != nil {
-				return err
-			}
-		}
 	}
-	return nil
+	if prev == nil && model == nil {
+		return nil
+	}
+	return b.updateAggregates(db, prev, model)
 }
 
 // Sequence returns a Sequence by name
@@ -321,14 +391,14 @@ func (b bucket) Sequence(name string) Sequence {
 	return NewSequence(b.name, name)
 }
 
-func (b bucket) WithNativeIndex(name string, indexer MultiKeyIndexer) Bucket {
+func (b bucket) WithNativeIndex(name string, indexer MultiKeyIndexer, opts ...NativeIndexOption) Bucket {
 	if b.indexes.Has(name) {
 		panic(fmt.Sprintf("Index %s registered twice", name))
 	}
 
 	iname := b.name + "_" + name
 	idxs := append(b.indexes, bucketBoundIndex{
-		idx:        NewNativeIndex(iname, indexer, b.DBKey),
+		idx:        NewNativeIndex(iname, indexer, b.DBKey, opts...),
 		publicName: name,
 	})
 	sort.Slice(idxs, func(i int, j int) bool {
@@ -676,3 +676,51 @@ func assertModelKeys(t testing.TB, wantKeys []string, models []weave.Model) {
 		t.Fatalf("got unexpected models: %q", keys)
 	}
 }
+
+func TestBucketQueryPage(t *testing.T) {
+	db := store.MemStore()
+	b := NewBucket("mycounter", &Counter{})
+
+	keys := []string{"a1", "a2", "a3", "a4", "a5", "b1"}
+	for _, key := range keys {
+		o := NewSimpleObj([]byte(key), &Counter{})
+		if err := b.Save(db, o); err != nil {
+			t.Fatalf("cannot save: %+v", err)
+		}
+	}
+
+	var (
+		got    []string
+		cursor []byte
+		pages  int
+	)
+	for {
+		models, next, err := b.QueryPage(db, weave.PrefixQueryMod, []byte("a"), weave.QueryPage{Cursor: cursor, Limit: 2})
+		if err != nil {
+			t.Fatalf("query page: %+v", err)
+		}
+		pages++
+		for _, m := range models {
+			got = append(got, string(m.Key))
+		}
+		if len(next) == 0 {
+			break
+		}
+		cursor = next
+	}
+	want := []string{"mycounter:a1", "mycounter:a2", "mycounter:a3", "mycounter:a4", "mycounter:a5"}
+	assert.Equal(t, want, got)
+	assert.Equal(t, 3, pages)
+
+	// Limit equal to the number of results does not return a cursor.
+	models, next, err := b.QueryPage(db, weave.PrefixQueryMod, []byte("b"), weave.QueryPage{Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(models))
+	assert.Equal(t, 0, len(next))
+
+	// Cursor cannot be used to read outside of the queried range.
+	_, _, err = b.QueryPage(db, weave.PrefixQueryMod, []byte("a"), weave.QueryPage{Cursor: []byte("mycounter:b1")})
+	if !errors.ErrInput.Is(err) {
+		t.Fatalf("want cursor out of range error, got %+v", err)
+	}
+}
@@ -1,7 +1,11 @@
 package orm
 
 import (
+	"fmt"
+
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 // Orm reserves 100~109 error codes
@@ -12,3 +16,88 @@ var ErrInvalidIndex = errors.Register(100, "invalid index")
 // ErrBucket is returned when already initialized bucket is tried
 // to be indexed again
 var ErrBucket = errors.Register(101, "bucket already initialized")
+
+// UniqueIndexError is returned when an entity cannot be indexed by a unique
+// index, because a different entity is already indexed under the same value.
+//
+// It is an errors.ErrDuplicate error. It is also a field error for the index
+// name, so errors.FieldErrors can be used to find it. The ABCI response of a
+// transaction that failed with this error holds the index name, the value and
+// the key as UniqueIndexTag, UniqueValueTag and UniqueKeyTag tags.
+type UniqueIndexError struct {
+	// Index is the name of the unique index.
+	Index string
+	// Value is the index value that is already in use.
+	Value []byte
+	// Key is the primary key of the entity that is already indexed under
+	// the value.
+	Key []byte
+}
+
+func (e *UniqueIndexError) Error() string {
+	return fmt.Sprintf("unique index %q value %x already used by %x: %s", e.Index, e.Value, e.Key, errors.ErrDuplicate)
+}
+
+// Cause implements the causer interface.
+func (e *UniqueIndexError) Cause() error {
+	return errors.ErrDuplicate
+}
+
+// Field implements the fielder interface.
+func (e *UniqueIndexError) Field() string {
+	return e.Index
+}
+
+// Tags of an ABCI response that describe a unique index collision.
+const (
+	UniqueIndexTag = "orm.unique.index"
+	UniqueValueTag = "orm.unique.value"
+	UniqueKeyTag   = "orm.unique.key"
+)
+
+// ErrorTags implements the weave.ErrorTagger interface.
+func (e *UniqueIndexError) ErrorTags() []common.KVPair {
+	return []common.KVPair{
+		{Key: []byte(UniqueIndexTag), Value: []byte(e.Index)},
+		{Key: []byte(UniqueValueTag), Value: e.Value},
+		{Key: []byte(UniqueKeyTag), Value: e.Key},
+	}
+}
+
+var _ weave.ErrorTagger = (*UniqueIndexError)(nil)
+
+// PrimaryIndex is the index name of a UniqueIndexError returned when an
+// entity is already stored under a primary key (see CheckUnique).
+const PrimaryIndex = "primary"
+
+// CheckUnique returns nil if no entity is stored under given primary key. If
+// there is one, a UniqueIndexError for the PrimaryIndex is returned.
+//
+// ModelBucket.Put overwrites an existing entity, so use CheckUnique before
+// creating an entity that must not replace another one.
+func CheckUnique(db weave.KVStore, b ModelBucket, key []byte) error {
+	switch err := b.Has(db, key); {
+	case err == nil:
+		return &UniqueIndexError{Index: PrimaryIndex, Value: key, Key: key}
+	case errors.ErrNotFound.Is(err):
+		return nil
+	default:
+		return err
+	}
+}
+
+// AsUniqueIndexError returns the unique index error that given error is or
+// wraps. It returns false if there is no such error.
+func AsUniqueIndexError(err error) (*UniqueIndexError, bool) {
+	for err != nil {
+		if e, ok := err.(*UniqueIndexError); ok {
+			return e, true
+		}
+		c, ok := err.(interface{ Cause() error })
+		if !ok {
+			return nil, false
+		}
+		err = c.Cause()
+	}
+	return nil, false
+}
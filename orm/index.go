@@ -2,8 +2,12 @@ package orm
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"math"
+	"sort"
+	"strings"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -30,6 +34,36 @@ type Index interface {
 	// when they might not be needed.
 	Keys(db weave.ReadOnlyKVStore, value []byte) weave.Iterator
 
+	// KeysRange returns an iterator that returns all entity keys that were
+	// indexed under a value from the [start, end) range. Keys are ordered
+	// by the indexed value. Keys indexed under the same value are ordered
+	// by their length first and then by their bytes. Nil start or end
+	// means no limit.
+	//
+	// Unlike with Keys, values of returned iterator are the indexed
+	// values.
+	KeysRange(db weave.ReadOnlyKVStore, start, end []byte) weave.Iterator
+
+	// Missing returns all values that given object should be, but is not
+	// indexed under. This allows to find entities that were stored before
+	// the index was created.
+	Missing(db weave.ReadOnlyKVStore, obj Object) ([][]byte, error)
+
+	// Backfill indexes given object under all values that it is missing
+	// from. Unlike Update, it can be called for an object that is
+	// already indexed.
+	Backfill(db weave.KVStore, obj Object) error
+
+	// Count returns the number of entities that were indexed under given
+	// value. It does not require to iterate over the indexed entities.
+	// It returns ErrInput if the index does not maintain the count.
+	Count(db weave.ReadOnlyKVStore, value []byte) (int64, error)
+
+	// Sum returns the sum with given name, maintained over all entities
+	// indexed under given value. It returns ErrInput if the index does
+	// not maintain such sum.
+	Sum(db weave.ReadOnlyKVStore, name string, value []byte) (int64, error)
+
 	// Query handles queries from the QueryRouter.
 	Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error)
 }
@@ -58,7 +92,7 @@ type compactIndex struct {
 	refKey func([]byte) []byte
 }
 
-var _ weave.QueryHandler = compactIndex{}
+var _ weave.PagedQueryHandler = compactIndex{}
 
 // NewMultiKeyIndex constructs an index with multi key indexer.
 // Indexer calculates the index for an object
@@ -200,6 +234,158 @@ func (i compactIndex) Keys(db weave.ReadOnlyKVStore, index []byte) weave.Iterato
 	return &keysIterator{keys: data.GetRefs()}
 }
 
+// KeysRange returns an iterator over keys of all entities that were indexed
+// under a value from the [start, end) range. The indexed value is returned
+// as the iterator value.
+func (i compactIndex) KeysRange(db weave.ReadOnlyKVStore, start, end []byte) weave.Iterator {
+	startKey := i.indexKey(start)
+	var endKey []byte
+	if end == nil {
+		_, endKey = prefixRange(i.id)
+	} else {
+		endKey = i.indexKey(end)
+	}
+	it, err := db.Iterator(startKey, endKey)
+	if err != nil {
+		return &failedIterator{err: err}
+	}
+	return &compactKeysRangeIterator{dbit: it, unique: i.unique, prefix: len(i.id)}
+}
+
+// compactKeysRangeIterator wraps a database iterator over compact index
+// entries and returns all references stored by each entry.
+type compactKeysRangeIterator struct {
+	dbit   weave.Iterator
+	unique bool
+	prefix int
+	value  []byte
+	refs   [][]byte
+}
+
+var _ weave.Iterator = (*compactKeysRangeIterator)(nil)
+
+func (it *compactKeysRangeIterator) Next() ([]byte, []byte, error) {
+	for len(it.refs) == 0 {
+		k, v, err := it.dbit.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		it.value = k[it.prefix:]
+		if it.unique {
+			it.refs = [][]byte{v}
+			continue
+		}
+		var mref MultiRef
+		if err := mref.Unmarshal(v); err != nil {
+			return nil, nil, errors.Wrap(err, "unmarshal index MultiRef")
+		}
+		// MultiRef keeps references ordered by their bytes only.
+		it.refs = mref.Refs
+		sort.SliceStable(it.refs, func(a, b int) bool {
+			return indexRefLess(it.refs[a], it.refs[b])
+		})
+	}
+	ref := it.refs[0]
+	it.refs = it.refs[1:]
+	return ref, it.value, nil
+}
+
+// indexRefLess returns true if entity key a is returned before entity key b
+// when both are indexed under the same value and read using a range scan.
+// This is the order that native index entries are stored in.
+func indexRefLess(a, b []byte) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return bytes.Compare(a, b) < 0
+}
+
+func (it *compactKeysRangeIterator) Release() {
+	it.dbit.Release()
+}
+
+// Missing returns all values that given object is not indexed under. Empty
+// values are never indexed and therefore never missing.
+func (i compactIndex) Missing(db weave.ReadOnlyKVStore, obj Object) ([][]byte, error) {
+	values, err := i.index(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "indexer")
+	}
+	var missing [][]byte
+	for _, v := range deduplicate(values) {
+		if len(v) == 0 {
+			continue
+		}
+		ok, err := i.contains(db, v, obj.Key())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			missing = append(missing, v)
+		}
+	}
+	return missing, nil
+}
+
+// contains returns true if an entity with given key is indexed under given
+// value.
+func (i compactIndex) contains(db weave.ReadOnlyKVStore, value []byte, pk []byte) (bool, error) {
+	val, err := db.Get(i.indexKey(value))
+	if err != nil {
+		return false, errors.Wrap(err, "db get")
+	}
+	if val == nil {
+		return false, nil
+	}
+	if i.unique {
+		return bytes.Equal(val, pk), nil
+	}
+	var data MultiRef
+	if err := data.Unmarshal(val); err != nil {
+		return false, errors.Wrap(err, "unmarshal index MultiRef")
+	}
+	_, found := data.findRef(pk)
+	return found, nil
+}
+
+// Backfill indexes given object under all values that it is missing from.
+func (i compactIndex) Backfill(db weave.KVStore, obj Object) error {
+	missing, err := i.Missing(db, obj)
+	if err != nil {
+		return err
+	}
+	for _, v := range missing {
+		if err := i.insert(db, v, obj.Key()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Count returns the number of entities indexed under given value.
+func (i compactIndex) Count(db weave.ReadOnlyKVStore, value []byte) (int64, error) {
+	val, err := db.Get(i.indexKey(value))
+	if err != nil {
+		return 0, errors.Wrap(err, "db get")
+	}
+	if val == nil {
+		return 0, nil
+	}
+	if i.unique {
+		return 1, nil
+	}
+	var data MultiRef
+	if err := data.Unmarshal(val); err != nil {
+		return 0, errors.Wrap(errors.ErrState, err.Error())
+	}
+	return int64(len(data.GetRefs())), nil
+}
+
+// Sum always fails, because compact index does not maintain sums.
+func (i compactIndex) Sum(db weave.ReadOnlyKVStore, name string, value []byte) (int64, error) {
+	return 0, errors.Wrapf(errors.ErrInput, "index does not maintain %q sum", name)
+}
+
 type failedIterator struct {
 	err error
 }
@@ -249,56 +435,39 @@ func consumeIteratorKeys(it weave.Iterator) ([][]byte, error) {
 	}
 }
 
-// getPrefix returns all references that have an index that
-// begins with a given prefix
-func (i compactIndex) getPrefix(db weave.ReadOnlyKVStore, prefix []byte) ([][]byte, error) {
-	dbPrefix := i.indexKey(prefix)
-	itr, err := db.Iterator(prefixRange(dbPrefix))
-	if err != nil {
-		return nil, err
-	}
-	defer itr.Release()
+// Query handles queries from the QueryRouter
+func (i compactIndex) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, _, err := i.QueryPage(db, mod, data, weave.QueryPage{})
+	return models, err
+}
 
-	var data [][]byte
-	_, value, err := itr.Next()
-	for err == nil {
-		if i.unique {
-			data = append(data, value)
-		} else {
-			tmp := new(MultiRef)
-			err := tmp.Unmarshal(value)
-			if err != nil {
-				return nil, err
-			}
-			data = append(data, tmp.Refs...)
-		}
-		_, value, err = itr.Next()
-	}
-	if !errors.ErrIteratorDone.Is(err) {
-		return nil, err
+// QueryPage handles paginated queries from the QueryRouter. Range queries
+// return at most queryRangeLimit models unless a different limit is
+// requested. Other queries are not limited by default.
+func (i compactIndex) QueryPage(db weave.ReadOnlyKVStore, mod string, data []byte, page weave.QueryPage) ([]weave.Model, []byte, error) {
+	it := &compactIndexIterator{
+		db:     db,
+		unique: i.unique,
+		dbKey:  i.refKey,
 	}
-	return data, nil
-}
+	limit := 0
 
-// Query handles queries from the QueryRouter
-func (i compactIndex) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
 	switch mod {
 	case weave.KeyQueryMod:
-		refs, err := consumeIteratorKeys(i.Keys(db, data))
-		if err != nil {
-			return nil, err
-		}
-		return i.loadRefs(db, refs)
+		it.start = i.indexKey(data)
+		it.end = append(i.indexKey(data), 0)
 	case weave.PrefixQueryMod:
-		refs, err := i.getPrefix(db, data)
-		if err != nil {
-			return nil, err
-		}
-		return i.loadRefs(db, refs)
+		it.start, it.end = prefixRange(i.indexKey(data))
+	case weave.CountQueryMod:
+		models, err := queryCount(db, i, data)
+		return models, nil, err
+	case weave.SumQueryMod:
+		models, err := querySum(db, i, data)
+		return models, nil, err
 	case weave.RangeQueryMod:
 		start, offset, end, err := parseIndexQueryRange(data)
 		if err != nil {
-			return nil, errors.Wrap(err, "query data")
+			return nil, nil, errors.Wrap(err, "query data")
 		}
 
 		if len(start) == 0 {
@@ -307,124 +476,156 @@ func (i compactIndex) Query(db weave.ReadOnlyKVStore, mod string, data []byte) (
 		if len(end) == 0 {
 			end = bytes.Repeat([]byte{255}, 128) // No limit
 		}
-
-		it, err := db.Iterator(i.indexKey(start), i.indexKey(end))
-		if err != nil {
-			return nil, errors.Wrap(err, "new iterator")
-		}
+		it.start = i.indexKey(start)
+		it.end = i.indexKey(end)
+		it.minLen = len(it.start)
 		if len(offset) > 0 {
-			offset = i.refKey(offset)
-		}
-		return consumeIterator(&paginatedIterator{
-			it: &compactIndexIterator{
-				db:      db,
-				compact: it,
-				start:   i.indexKey(start),
-				dbKey:   i.refKey,
-				unique:  i.unique,
-				offset:  offset,
-			},
-			remaining: queryRangeLimit,
-		})
-
+			it.offset = i.refKey(offset)
+		}
+		limit = queryRangeLimit
 	default:
-		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+		return nil, nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+	}
+
+	limit, err := pageLimit(page, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := it.init(page.Cursor); err != nil {
+		return nil, nil, err
 	}
+	return consumePage(it, limit)
 }
 
-// compactIndexIterator is a weave.Iterator implementation that can range over
+// compactIndexIterator is a cursorIterator implementation that can range over
 // compact index values and return key-value pairs for referenced by that
 // compact index data.
+//
+// Cursor is the compact index key together with the reference, so that the
+// iteration can be resumed in the middle of a non unique index value.
 type compactIndexIterator struct {
-	db      weave.ReadOnlyKVStore
-	start   []byte
+	db     weave.ReadOnlyKVStore
+	start  []byte
+	end    []byte
+	minLen int
+	offset []byte
+	unique bool
+	dbKey  func([]byte) []byte
+
 	compact weave.Iterator
-	offset  []byte
-	unique  bool
-	dbKey   func([]byte) []byte
+	// resume is the reference that the iteration must be resumed from,
+	// if the first returned compact index key is resumeKey.
+	resumeKey []byte
+	resume    []byte
 
-	keys [][]byte
+	key  []byte
+	refs [][]byte
 }
 
-func (c *compactIndexIterator) Next() ([]byte, []byte, error) {
-	var key []byte
-	for key == nil {
-		if len(c.keys) > 0 {
-			key = c.keys[0]
-			c.keys = c.keys[1:]
-		} else {
-			var refValues []byte
-			for refValues == nil {
-				k, v, err := c.compact.Next()
-				if err != nil {
-					return nil, nil, errors.Wrap(err, "keys iterator")
-				}
-				// This is a special case, that requires manual
-				// filter. When iterating over indexed values,
-				// we expect that index value of 100 is after
-				// 11. Compact index implementation does not
-				// consider key length and therefore when
-				// iterating over all indexed values, order
-				// might be wrong.
-				// It would be way better if the database could
-				// handle this operation. For backward
-				// compatibility reasons, this implementation
-				// cannot be changed. Use native index if you
-				// can.
-				if len(c.start) <= len(k) {
-					refValues = v
-				}
-			}
+// init creates the compact index iterator, starting at given cursor.
+func (c *compactIndexIterator) init(cursor []byte) error {
+	start := c.start
+	if len(cursor) != 0 {
+		key, ref, err := decodeCompactCursor(cursor)
+		if err != nil {
+			return err
+		}
+		if !inRange(key, c.start, c.end) {
+			return errors.Wrap(errors.ErrInput, "cursor out of the query range")
+		}
+		start = key
+		c.resumeKey = key
+		c.resume = ref
+	}
+	it, err := c.db.Iterator(start, c.end)
+	if err != nil {
+		return errors.Wrap(err, "new iterator")
+	}
+	c.compact = it
+	return nil
+}
 
-			if c.unique {
-				key = refValues
-			} else {
-				var mref MultiRef
-				if err := mref.Unmarshal(refValues); err != nil {
-					return nil, nil, errors.Wrap(err, "unmarshal index MultiRef")
-				}
-				key = mref.Refs[0]
-				c.keys = mref.Refs[1:]
+func (c *compactIndexIterator) Next() ([]byte, weave.Model, error) {
+	for {
+		for len(c.refs) > 0 {
+			ref := c.refs[0]
+			c.refs = c.refs[1:]
+
+			key := c.dbKey(ref)
+			// Ignore all keys that do not fullfill offset
+			// requirement. Offset is inclusive.
+			if len(c.offset) > 0 && bytes.Compare(c.offset, key) > 0 {
+				continue
+			}
+			value, err := c.db.Get(key)
+			if err != nil {
+				return nil, weave.Model{}, errors.Wrap(err, "get referenced value")
 			}
+			return encodeCompactCursor(c.key, ref), weave.Model{Key: key, Value: value}, nil
 		}
 
-		key = c.dbKey(key)
-
-		// Ignore all keys that do not fullfill offset requirement.
-		// Offset is inclusive.
-		if len(c.offset) > 0 && bytes.Compare(c.offset, key) > 0 {
-			key = nil
+		k, v, err := c.compact.Next()
+		if err != nil {
+			return nil, weave.Model{}, errors.Wrap(err, "keys iterator")
+		}
+		// This is a special case, that requires manual filter. When
+		// iterating over indexed values, we expect that index value
+		// of 100 is after 11. Compact index implementation does not
+		// consider key length and therefore when iterating over all
+		// indexed values, order might be wrong.
+		// It would be way better if the database could handle this
+		// operation. For backward compatibility reasons, this
+		// implementation cannot be changed. Use native index if you
+		// can.
+		if len(k) < c.minLen {
+			continue
 		}
-	}
 
-	value, err := c.db.Get(key)
-	if err != nil {
-		return nil, nil, errors.Wrap(err, "get referenced value")
+		if c.unique {
+			c.refs = [][]byte{v}
+		} else {
+			var mref MultiRef
+			if err := mref.Unmarshal(v); err != nil {
+				return nil, weave.Model{}, errors.Wrap(err, "unmarshal index MultiRef")
+			}
+			c.refs = mref.Refs
+		}
+		c.key = k
+
+		// References are sorted, so when resuming, skip all
+		// references before the cursor.
+		if c.resumeKey != nil {
+			if bytes.Equal(k, c.resumeKey) {
+				for len(c.refs) > 0 && bytes.Compare(c.refs[0], c.resume) < 0 {
+					c.refs = c.refs[1:]
+				}
+			}
+			c.resumeKey = nil
+		}
 	}
-	return key, value, nil
 }
 
 func (c *compactIndexIterator) Release() {
 	c.compact.Release()
 }
 
-func (i compactIndex) loadRefs(db weave.ReadOnlyKVStore, refs [][]byte) ([]weave.Model, error) {
-	if len(refs) == 0 {
-		return nil, nil
-	}
-	res := make([]weave.Model, len(refs))
-	for j, ref := range refs {
-		key := i.refKey(ref)
-		value, err := db.Get(key)
-		if err != nil {
-			return nil, err
-		}
-		res[j] = weave.Model{
-			Key:   key,
-			Value: value,
-		}
+// encodeCompactCursor returns a cursor pointing at given reference stored
+// under given compact index key.
+func encodeCompactCursor(key, ref []byte) []byte {
+	cursor := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(key)+len(ref))
+	n := binary.PutUvarint(cursor, uint64(len(key)))
+	cursor = append(cursor[:n], key...)
+	return append(cursor, ref...)
+}
+
+// decodeCompactCursor is the inverse of encodeCompactCursor.
+func decodeCompactCursor(cursor []byte) (key, ref []byte, err error) {
+	size, n := binary.Uvarint(cursor)
+	if n <= 0 || uint64(len(cursor)-n) < size {
+		return nil, nil, errors.Wrap(errors.ErrInput, "invalid cursor")
 	}
-	return res, nil
+	cursor = cursor[n:]
+	return cursor[:size], cursor[size:], nil
 }
 
 func (i compactIndex) move(db weave.KVStore, prev Object, save Object) error {
@@ -453,7 +654,7 @@ func (i compactIndex) move(db weave.KVStore, prev Object, save Object) error {
 				return err
 			}
 			if val != nil {
-				return errors.Wrap(errors.ErrDuplicate, i.name)
+				return &UniqueIndexError{Index: i.name, Value: newKey, Key: val}
 			}
 		}
 	}
@@ -551,7 +752,7 @@ func (i compactIndex) insert(db weave.KVStore, index []byte, pk []byte) error {
 
 	if i.unique {
 		if cur != nil {
-			return errors.Wrap(errors.ErrDuplicate, i.name)
+			return &UniqueIndexError{Index: i.name, Value: index, Key: cur}
 		}
 
 		return db.Set(key, pk)
@@ -579,19 +780,64 @@ func (i compactIndex) insert(db weave.KVStore, index []byte, pk []byte) error {
 	return db.Set(key, save)
 }
 
-const nativeIdxPrefix = "_x."
+const (
+	nativeIdxPrefix      = "_x."
+	nativeIdxCountPrefix = "_xc."
+)
 
 // NewNativeIndex returns an index implementation that is using a database
 // native storage and query in order to maintain and provide access to an
 // index.
-func NewNativeIndex(name string, indexer MultiKeyIndexer, dbKey func([]byte) []byte) Index {
-	return &nativeIndex{
+func NewNativeIndex(name string, indexer MultiKeyIndexer, dbKey func([]byte) []byte, opts ...NativeIndexOption) Index {
+	ix := &nativeIndex{
 		name:    name,
 		indexer: indexer,
 		dbKey:   dbKey,
 	}
+	for _, fn := range opts {
+		fn(ix)
+	}
+	return ix
+}
+
+// NativeIndexOption configures a native index.
+type NativeIndexOption func(*nativeIndex)
+
+// WithIndexCount configures a native index to maintain the number of entities
+// indexed under each value, so that it can be counted without iterating.
+//
+// Only entities indexed after the count was configured are counted. Use
+// ModelBucket.RecountIndex in a data migration to initialize the count of an
+// index that already holds entries.
+func WithIndexCount() NativeIndexOption {
+	return func(ix *nativeIndex) {
+		ix.count = true
+	}
 }
 
+// WithIndexSum configures a native index to maintain a sum of values returned
+// by given function for all entities indexed under each value. Sum name must
+// not be empty nor contain ":".
+//
+// Only entities indexed after the sum was configured are summed. Use
+// ModelBucket.RecountIndex in a data migration to initialize the sum of an
+// index that already holds entries.
+func WithIndexSum(name string, fn Summer) NativeIndexOption {
+	if name == "" || strings.Contains(name, ":") {
+		panic(fmt.Sprintf("invalid index sum name %q", name))
+	}
+	return func(ix *nativeIndex) {
+		for _, s := range ix.sums {
+			if s.name == name {
+				panic(fmt.Sprintf("Index sum %q registered twice", name))
+			}
+		}
+		ix.sums = append(ix.sums, indexSum{name: name, fn: fn})
+	}
+}
+
+var _ weave.PagedQueryHandler = (*nativeIndex)(nil)
+
 // nativeIndex is an index implementation that is using a database native
 // storage and query in order to maintain and provide access to an index.
 type nativeIndex struct {
@@ -600,6 +846,16 @@ type nativeIndex struct {
 	// dbKey is a function that for given entity ID returns that entity
 	// database key.
 	dbKey func([]byte) []byte
+	// count is true if the number of entities indexed under each value
+	// is maintained.
+	count bool
+	// sums are maintained for entities indexed under each value.
+	sums []indexSum
+}
+
+type indexSum struct {
+	name string
+	fn   Summer
 }
 
 func (ix *nativeIndex) Name() string {
@@ -629,14 +885,23 @@ func (ix *nativeIndex) Update(db weave.KVStore, prev Object, next Object) error
 		if err != nil {
 			return errors.Wrap(err, "indexer")
 		}
-		for _, v := range values {
+		for _, v := range deduplicate(values) {
 			idxKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), v, prev.Key()})
 			if err != nil {
 				return errors.Wrap(err, "build index key")
 			}
+			switch ok, err := db.Has(idxKey); {
+			case err != nil:
+				return errors.Wrap(err, "db has")
+			case !ok:
+				continue
+			}
 			if err := db.Delete(idxKey); err != nil {
 				return errors.Wrap(err, "db delete")
 			}
+			if err := ix.updateAggregates(db, v, prev, -1); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -646,24 +911,398 @@ func (ix *nativeIndex) Update(db weave.KVStore, prev Object, next Object) error
 		if err != nil {
 			return errors.Wrap(err, "indexer")
 		}
-		for _, v := range values {
+		for _, v := range deduplicate(values) {
 			idxKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), v, next.Key()})
 			if err != nil {
 				return errors.Wrap(err, "build index key")
 			}
+			switch ok, err := db.Has(idxKey); {
+			case err != nil:
+				return errors.Wrap(err, "db has")
+			case ok:
+				continue
+			}
 			if err := db.Set(idxKey, []byte{}); err != nil {
 				return errors.Wrap(err, "db set")
 			}
+			if err := ix.updateAggregates(db, v, next, 1); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+// Missing returns all values that given object is not indexed under.
+func (ix *nativeIndex) Missing(db weave.ReadOnlyKVStore, obj Object) ([][]byte, error) {
+	values, err := ix.indexer(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "indexer")
+	}
+	var missing [][]byte
+	for _, v := range deduplicate(values) {
+		idxKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), v, obj.Key()})
+		if err != nil {
+			return nil, errors.Wrap(err, "build index key")
+		}
+		ok, err := db.Has(idxKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "db has")
+		}
+		if !ok {
+			missing = append(missing, v)
+		}
+	}
+	return missing, nil
+}
+
+// Backfill indexes given object under all values that it is missing from.
+func (ix *nativeIndex) Backfill(db weave.KVStore, obj Object) error {
+	missing, err := ix.Missing(db, obj)
+	if err != nil {
+		return err
+	}
+	for _, v := range missing {
+		idxKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), v, obj.Key()})
+		if err != nil {
+			return errors.Wrap(err, "build index key")
+		}
+		if err := db.Set(idxKey, []byte{}); err != nil {
+			return errors.Wrap(err, "db set")
+		}
+		if err := ix.updateAggregates(db, v, obj, 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Count returns the number of entities indexed under given value. If
+// configured (see WithIndexCount), native index maintains a counter for every
+// indexed value, so that the count does not require to iterate over index
+// entries.
+func (ix *nativeIndex) Count(db weave.ReadOnlyKVStore, value []byte) (int64, error) {
+	if !ix.count {
+		return 0, errors.Wrap(errors.ErrInput, "index does not maintain the count")
+	}
+	return ix.loadAggregate(db, value, "")
+}
+
+// Sum returns the sum with given name, maintained over all entities indexed
+// under given value (see WithIndexSum).
+func (ix *nativeIndex) Sum(db weave.ReadOnlyKVStore, name string, value []byte) (int64, error) {
+	if ix.sum(name) == nil {
+		return 0, errors.Wrapf(errors.ErrInput, "index does not maintain %q sum", name)
+	}
+	return ix.loadAggregate(db, value, name)
+}
+
+func (ix *nativeIndex) sum(name string) *indexSum {
+	for i, s := range ix.sums {
+		if s.name == name {
+			return &ix.sums[i]
+		}
+	}
+	return nil
+}
+
+func (ix *nativeIndex) loadAggregate(db weave.ReadOnlyKVStore, value []byte, name string) (int64, error) {
+	key, err := ix.aggregateKey(value, name)
+	if err != nil {
+		return 0, err
+	}
+	c, err := loadCounter(db, key)
+	if err != nil {
+		return 0, err
+	}
+	return c.Count, nil
+}
+
+// updateAggregates adds (sign 1) or removes (sign -1) given object from the
+// count and all sums maintained for given value.
+func (ix *nativeIndex) updateAggregates(db weave.KVStore, value []byte, obj Object, sign int64) error {
+	if ix.count {
+		key, err := ix.aggregateKey(value, "")
+		if err != nil {
+			return err
+		}
+		if err := addToCounter(db, key, sign); err != nil {
+			return errors.Wrap(err, "index count")
+		}
+	}
+	for _, s := range ix.sums {
+		n, err := s.fn(obj)
+		if err != nil {
+			return errors.Wrapf(err, "index sum %q", s.name)
+		}
+		if n == math.MinInt64 {
+			return errors.Wrapf(errors.ErrOverflow, "index sum %q", s.name)
+		}
+		key, err := ix.aggregateKey(value, s.name)
+		if err != nil {
+			return err
+		}
+		if err := addToCounter(db, key, sign*n); err != nil {
+			return errors.Wrapf(err, "index sum %q", s.name)
+		}
+	}
+	return nil
+}
+
+// resetAggregates deletes the count and all sums maintained by this index.
+func (ix *nativeIndex) resetAggregates(db weave.KVStore) error {
+	if !ix.count && len(ix.sums) == 0 {
+		return errors.Wrap(errors.ErrInput, "index does not maintain any aggregate")
+	}
+	prefix, err := ix.aggregateKey(nil, "")
+	if err != nil {
+		return err
+	}
+	// Remove the empty value length, to match aggregates of all values.
+	prefix = prefix[:len(prefix)-1]
+	start, end := prefixRange(prefix)
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return errors.Wrap(err, "iterator")
+	}
+	keys, err := consumeIteratorKeys(it)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := db.Delete(key); err != nil {
+			return errors.Wrap(err, "db delete")
+		}
+	}
+	return nil
+}
+
+// addAggregates adds given object to the count and all sums maintained for
+// values it is indexed under.
+func (ix *nativeIndex) addAggregates(db weave.KVStore, obj Object) error {
+	values, err := ix.indexer(obj)
+	if err != nil {
+		return errors.Wrap(err, "indexer")
+	}
+	for _, v := range deduplicate(values) {
+		idxKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), v, obj.Key()})
+		if err != nil {
+			return errors.Wrap(err, "build index key")
+		}
+		switch ok, err := db.Has(idxKey); {
+		case err != nil:
+			return errors.Wrap(err, "db has")
+		case !ok:
+			continue
+		}
+		if err := ix.updateAggregates(db, v, obj, 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// aggregateKey returns the database key that the count (empty name) or a sum
+// with given name, maintained for entities indexed under given value, is
+// stored under. Aggregate keys use a different prefix than index entries, so
+// that they are never returned when iterating over an index.
+func (ix *nativeIndex) aggregateKey(value []byte, name string) ([]byte, error) {
+	chunks := [][]byte{[]byte(ix.name), value}
+	if name != "" {
+		chunks = append(chunks, []byte(name))
+	}
+	key, err := packNativeIdxKey(chunks)
+	if err != nil {
+		return nil, errors.Wrap(err, "build index aggregate key")
+	}
+	return append([]byte(nativeIdxCountPrefix), key[len(nativeIdxPrefix):]...), nil
+}
+
 func (ix *nativeIndex) Keys(db weave.ReadOnlyKVStore, value []byte) weave.Iterator {
+	start, end, err := ix.valueRange(value)
+	if err != nil {
+		return &failedIterator{err: err}
+	}
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return &failedIterator{err: err}
+	}
+
+	return &nativeIndexIterator{
+		dbit: it,
+		// Keys method must return keys not prefixed by the bucket
+		// name.
+		dbKey: func(b []byte) []byte { return b },
+	}
+}
+
+// KeysRange returns an iterator over keys of all entities that were indexed
+// under a value from the [start, end) range. The indexed value is returned
+// as the iterator value.
+//
+// Native index keys are ordered by the value length first. Entries of each
+// value length are stored next to each other and are read separately, in
+// batches of at most nativeRangeBatch entries. Returned keys are merged from
+// all those batches, so that they are ordered by the indexed value. At most
+// one database iterator is open at a time and it is released before a batch
+// is returned, no matter how many value lengths are stored.
+func (ix *nativeIndex) KeysRange(db weave.ReadOnlyKVStore, start, end []byte) weave.Iterator {
+	prefix, err := packNativeIdxKey([][]byte{[]byte(ix.name)})
+	if err != nil {
+		return &failedIterator{err: err}
+	}
+
+	merged := &nativeRangeIterator{}
+	// Find all lengths of indexed values, starting with the shortest
+	// one, and read the first batch of each of them.
+	for size := 0; size < math.MaxUint8; {
+		c := &nativeRangeCursor{
+			db:     db,
+			prefix: prefix,
+			size:   size,
+			start:  start,
+			end:    end,
+			seek:   append(append(append([]byte{}, prefix...), uint8(size)), truncate(start, size)...),
+		}
+		next, err := c.fill()
+		if err != nil {
+			return &failedIterator{err: err}
+		}
+		if len(c.entries) != 0 {
+			merged.cursors = append(merged.cursors, c)
+		}
+		if next < 0 {
+			break
+		}
+		size = next
+	}
+	return merged
+}
+
+// nativeRangeBatch is the maximum number of index entries that a single
+// database iterator reads during a range scan.
+const nativeRangeBatch = 64
+
+// truncate returns at most n first bytes of given value.
+func truncate(b []byte, n int) []byte {
+	if len(b) > n {
+		return b[:n]
+	}
+	return b
+}
+
+// nativeRangeIterator returns entity keys from all cursors, ordered by the
+// indexed value.
+type nativeRangeIterator struct {
+	cursors []*nativeRangeCursor
+}
+
+var _ weave.Iterator = (*nativeRangeIterator)(nil)
+
+func (it *nativeRangeIterator) Next() ([]byte, []byte, error) {
+	if len(it.cursors) == 0 {
+		return nil, nil, errors.ErrIteratorDone
+	}
+	lowest := 0
+	for i, c := range it.cursors {
+		if bytes.Compare(c.entries[0].value, it.cursors[lowest].entries[0].value) < 0 {
+			lowest = i
+		}
+	}
+	c := it.cursors[lowest]
+	e := c.entries[0]
+	c.entries = c.entries[1:]
+	if len(c.entries) == 0 && !c.done {
+		if _, err := c.fill(); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(c.entries) == 0 {
+		it.cursors = append(it.cursors[:lowest], it.cursors[lowest+1:]...)
+	}
+	return e.key, e.value, nil
+}
+
+func (it *nativeRangeIterator) Release() {
+	it.cursors = nil
+}
+
+// nativeRangeCursor reads native index entries with a value of a single
+// length, that is within the [start, end) range.
+type nativeRangeCursor struct {
+	db         weave.ReadOnlyKVStore
+	prefix     []byte
+	size       int
+	start, end []byte
+
+	// seek is the database key that the next batch is read from.
+	seek []byte
+	// done is set once all entries of this length were read.
+	done bool
+	// entries of the current batch that were not returned yet.
+	entries []nativeRangeEntry
+}
+
+type nativeRangeEntry struct {
+	value, key []byte
+}
+
+// fill reads the next batch of entries. Once all entries of this length are
+// read, the length of values that must be checked next is returned, or -1
+// if no longer value can be within the range.
+func (c *nativeRangeCursor) fill() (int, error) {
+	it, err := c.db.Iterator(c.seek, append(append([]byte{}, c.prefix...), math.MaxUint8))
+	if err != nil {
+		return 0, errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+
+	for len(c.entries) < nativeRangeBatch {
+		idxKey, _, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			c.done = true
+			return -1, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		chunks, err := unpackNativeIdxKey(idxKey)
+		if err != nil {
+			return 0, errors.Wrap(err, "unpack native index key")
+		}
+		if len(chunks) != 3 {
+			return 0, errors.Wrap(errors.ErrState, "malformed native index key")
+		}
+		value, key := chunks[1], chunks[2]
+		if len(value) != c.size {
+			// No more values of this length. Continue with the
+			// next length that is stored.
+			c.done = true
+			return len(value), nil
+		}
+		if c.start != nil && bytes.Compare(value, c.start) < 0 {
+			continue
+		}
+		if c.end != nil && bytes.Compare(value, c.end) >= 0 {
+			// Longer values with the same prefix might still be
+			// within the range.
+			c.done = true
+			return c.size + 1, nil
+		}
+		c.entries = append(c.entries, nativeRangeEntry{value: value, key: key})
+		// The next batch starts right after this entry.
+		c.seek = append(append([]byte{}, idxKey...), 0)
+	}
+	return c.size + 1, nil
+}
+
+// valueRange returns the range of database keys that all entries indexed
+// under given value are stored in.
+func (ix *nativeIndex) valueRange(value []byte) ([]byte, []byte, error) {
 	lookupKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), value})
 	if err != nil {
-		return &failedIterator{err: errors.Wrap(err, "build index key")}
+		return nil, nil, errors.Wrap(err, "build index key")
 	}
 
 	// Index key are built is a specific way, that allow using the native
@@ -687,39 +1326,37 @@ func (ix *nativeIndex) Keys(db weave.ReadOnlyKVStore, value []byte) weave.Iterat
 	// MaxUint8 is not used by serializer so we can use it as the maximum
 	// value guard.
 	end[len(end)-1] = math.MaxUint8
-
-	it, err := db.Iterator(start, end)
-	if err != nil {
-		return &failedIterator{err: err}
-	}
-
-	return &nativeIndexIterator{
-		dbit: it,
-		// Keys method must return keys not prefixed by the bucket
-		// name.
-		dbKey: func(b []byte) []byte { return b },
-	}
+	return start, end, nil
 }
 
 func (ix *nativeIndex) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, _, err := ix.QueryPage(db, mod, data, weave.QueryPage{})
+	return models, err
+}
+
+// QueryPage handles paginated queries from the QueryRouter. Range queries
+// return at most queryRangeLimit models unless a different limit is
+// requested. Key queries are not limited by default.
+//
+// Cursor is the database key of the index entry.
+func (ix *nativeIndex) QueryPage(db weave.ReadOnlyKVStore, mod string, data []byte, page weave.QueryPage) ([]weave.Model, []byte, error) {
+	var (
+		startKey, endKey []byte
+		limit            int
+	)
 	switch mod {
 	case weave.KeyQueryMod:
-		keys, err := consumeIteratorKeys(ix.Keys(db, data))
+		start, end, err := ix.valueRange(data)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		models := make([]weave.Model, len(keys))
-		for i, key := range keys {
-			value, err := db.Get(ix.dbKey(key))
-			if err != nil {
-				return nil, errors.Wrapf(err, "cannot get %q value for %q", i, key)
-			}
-			models[i] = weave.Model{
-				Key:   key,
-				Value: value,
-			}
-		}
-		return models, nil
+		startKey, endKey = start, end
+	case weave.CountQueryMod:
+		models, err := queryCount(db, ix, data)
+		return models, nil, err
+	case weave.SumQueryMod:
+		models, err := querySum(db, ix, data)
+		return models, nil, err
 	case weave.RangeQueryMod:
 		// Start is the value that was indexed,
 		// Offset is the referenced by this index entity ID,
@@ -728,7 +1365,7 @@ func (ix *nativeIndex) Query(db weave.ReadOnlyKVStore, mod string, data []byte)
 		// value is being built.
 		start, offset, end, err := parseIndexQueryRange(data)
 		if err != nil {
-			return nil, errors.Wrap(err, "query data")
+			return nil, nil, errors.Wrap(err, "query data")
 		}
 		if len(end) == 0 {
 			end = bytes.Repeat([]byte{255}, 128) // No limit
@@ -742,49 +1379,98 @@ func (ix *nativeIndex) Query(db weave.ReadOnlyKVStore, mod string, data []byte)
 		} else if len(start) > 0 {
 			startKeyChunks = append(startKeyChunks, start)
 		}
-		startKey, err := packNativeIdxKey(startKeyChunks)
+		startKey, err = packNativeIdxKey(startKeyChunks)
 		if err != nil {
-			return nil, errors.Wrap(err, "range start key")
+			return nil, nil, errors.Wrap(err, "range start key")
 		}
-		endKey, err := packNativeIdxKey([][]byte{[]byte(ix.name), end})
+		endKey, err = packNativeIdxKey([][]byte{[]byte(ix.name), end})
 		if err != nil {
-			return nil, errors.Wrap(err, "range end key")
+			return nil, nil, errors.Wrap(err, "range end key")
 		}
-		it, err := db.Iterator(startKey, endKey)
-		if err != nil {
-			return nil, errors.Wrap(err, "iterator")
-		}
-		return consumeIterator(&paginatedIterator{
-			it: &valueFetchingIterator{
-				db: db,
-				it: &nativeIndexIterator{dbit: it, dbKey: ix.dbKey},
-			},
-			remaining: queryRangeLimit,
-		})
+		limit = queryRangeLimit
 	default:
-		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+		return nil, nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+	}
+
+	limit, err := pageLimit(page, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(page.Cursor) != 0 {
+		if !inRange(page.Cursor, startKey, endKey) {
+			return nil, nil, errors.Wrap(errors.ErrInput, "cursor out of the query range")
+		}
+		startKey = page.Cursor
+	}
+	it, err := db.Iterator(startKey, endKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "iterator")
 	}
+	return consumePage(&nativeIndexCursorIterator{db: db, dbit: it, dbKey: ix.dbKey}, limit)
 }
 
-// valueFetchingIterator is an iterator wrapper that fetch value for each
-// returned key. This should be used together with nativeIndexIterator in order
-// to return not only an entity key but also its value.
-type valueFetchingIterator struct {
-	db weave.ReadOnlyKVStore
-	it weave.Iterator
+// nativeIndexCursorIterator wraps a database iterator over native index
+// entries and returns indexed entities. Cursor is the database key of the
+// index entry.
+type nativeIndexCursorIterator struct {
+	db    weave.ReadOnlyKVStore
+	dbit  weave.Iterator
+	dbKey func([]byte) []byte
 }
 
-func (v *valueFetchingIterator) Next() (key []byte, value []byte, err error) {
-	key, val, err := v.it.Next()
+func (it *nativeIndexCursorIterator) Next() ([]byte, weave.Model, error) {
+	idxKey, _, err := it.dbit.Next()
+	if err != nil {
+		return nil, weave.Model{}, err
+	}
+	chunks, err := unpackNativeIdxKey(idxKey)
+	if err != nil {
+		return nil, weave.Model{}, errors.Wrap(err, "unpack native index key")
+	}
+	key := it.dbKey(chunks[len(chunks)-1])
+	value, err := it.db.Get(key)
 	if err != nil {
-		return key, val, err
+		return nil, weave.Model{}, errors.Wrapf(err, "cannot get %q value", key)
 	}
-	val, err = v.db.Get(key)
-	return key, val, err
+	return idxKey, weave.Model{Key: key, Value: value}, nil
+}
+
+func (it *nativeIndexCursorIterator) Release() {
+	it.dbit.Release()
 }
 
-func (v *valueFetchingIterator) Release() {
-	v.it.Release()
+// queryCount returns a single model holding the serialized counter of
+// entities indexed under given value.
+func queryCount(db weave.ReadOnlyKVStore, idx Index, value []byte) ([]weave.Model, error) {
+	n, err := idx.Count(db, value)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := NewCounter(n).Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal counter")
+	}
+	return []weave.Model{{Key: value, Value: raw}}, nil
+}
+
+// querySum returns a single model holding the serialized sum maintained for
+// entities indexed under a value. Query data is in the format
+// <sum name>:<value>.
+func querySum(db weave.ReadOnlyKVStore, idx Index, data []byte) ([]weave.Model, error) {
+	i := bytes.IndexByte(data, ':')
+	if i <= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "sum query data must be <name>:<value>")
+	}
+	name, value := string(data[:i]), data[i+1:]
+	n, err := idx.Sum(db, name, value)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := NewCounter(n).Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal counter")
+	}
+	return []weave.Model{{Key: value, Value: raw}}, nil
 }
 
 // parseIndexQueryRange parse given query data and return range query information.
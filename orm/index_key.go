@@ -0,0 +1,81 @@
+package orm
+
+import (
+	"encoding/binary"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// IndexField is a single, typed part of a composite index key. Each field is
+// encoded so that the byte order of encoded values is the same as the natural
+// order of the values. This allows to use range scans over composite index
+// keys, for example to find all entities of a given owner that expire before
+// a certain time.
+type IndexField func(dst []byte) ([]byte, error)
+
+// IndexKey returns a composite index key built from given fields. Keys are
+// ordered by the first field, then by the second field and so on.
+//
+// Any key prefix that consists of complete fields can be used as a prefix in
+// a range scan (see ModelBucket.ByIndexRange).
+func IndexKey(fields ...IndexField) ([]byte, error) {
+	var key []byte
+	for i, f := range fields {
+		var err error
+		if key, err = f(key); err != nil {
+			return nil, errors.Wrapf(err, "field %d", i)
+		}
+	}
+	return key, nil
+}
+
+// AddressField returns an index field that holds an address. Addresses are of
+// a fixed length and are encoded as they are.
+func AddressField(a weave.Address) IndexField {
+	return func(dst []byte) ([]byte, error) {
+		if err := a.Validate(); err != nil {
+			return nil, errors.Wrap(err, "address")
+		}
+		return append(dst, a...), nil
+	}
+}
+
+// Uint64Field returns an index field that holds an unsigned integer, encoded
+// using big endian order.
+func Uint64Field(n uint64) IndexField {
+	return func(dst []byte) ([]byte, error) {
+		var raw [8]byte
+		binary.BigEndian.PutUint64(raw[:], n)
+		return append(dst, raw[:]...), nil
+	}
+}
+
+// TimeField returns an index field that holds a time value. Time is encoded
+// using big endian order with a flipped sign bit, so that a time before the
+// epoch sorts before any time after the epoch.
+func TimeField(t weave.UnixTime) IndexField {
+	return func(dst []byte) ([]byte, error) {
+		var raw [8]byte
+		binary.BigEndian.PutUint64(raw[:], uint64(t)^(1<<63))
+		return append(dst, raw[:]...), nil
+	}
+}
+
+// StringField returns an index field that holds a string of any length.
+//
+// To keep the order and to allow other fields to follow, every zero byte of
+// the string is escaped as {0, 255} and the string is terminated with
+// {0, 1}. This way "a" sorts before "a\x00" and "ab".
+func StringField(s string) IndexField {
+	return func(dst []byte) ([]byte, error) {
+		for i := 0; i < len(s); i++ {
+			if s[i] == 0 {
+				dst = append(dst, 0, 255)
+			} else {
+				dst = append(dst, s[i])
+			}
+		}
+		return append(dst, 0, 1), nil
+	}
+}
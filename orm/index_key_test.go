@@ -0,0 +1,78 @@
+package orm
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestIndexKeyOrder(t *testing.T) {
+	addr := weavetest.NewCondition().Address()
+
+	// Keys of each case must be listed in ascending order.
+	cases := map[string][][]IndexField{
+		"uint64": {
+			{Uint64Field(0)},
+			{Uint64Field(1)},
+			{Uint64Field(256)},
+			{Uint64Field(math.MaxUint64)},
+		},
+		"time": {
+			{TimeField(math.MinInt64)},
+			{TimeField(-256)},
+			{TimeField(-1)},
+			{TimeField(0)},
+			{TimeField(1)},
+			{TimeField(math.MaxInt64)},
+		},
+		"string": {
+			{StringField("")},
+			{StringField("\x00")},
+			{StringField("\x00\x00")},
+			{StringField("\x01")},
+			{StringField("a")},
+			{StringField("a\x00")},
+			{StringField("a\x00b")},
+			{StringField("ab")},
+			{StringField("b")},
+		},
+		"string and uint64": {
+			{StringField("a"), Uint64Field(math.MaxUint64)},
+			{StringField("a\x00"), Uint64Field(0)},
+			{StringField("ab"), Uint64Field(0)},
+			{StringField("ab"), Uint64Field(1)},
+		},
+		"address and time": {
+			{AddressField(addr), TimeField(-1)},
+			{AddressField(addr), TimeField(0)},
+			{AddressField(addr), TimeField(100)},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var prev []byte
+			for i, fields := range tc {
+				key, err := IndexKey(fields...)
+				if err != nil {
+					t.Fatalf("cannot build %d key: %s", i, err)
+				}
+				if i > 0 && bytes.Compare(prev, key) >= 0 {
+					t.Fatalf("key %d (%x) is not greater than the previous one (%x)", i, key, prev)
+				}
+				prev = key
+			}
+		})
+	}
+}
+
+func TestIndexKeyInvalidAddress(t *testing.T) {
+	_, err := IndexKey(StringField("a"), AddressField(weave.Address{1, 2, 3}))
+	if !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %v", err)
+	}
+}
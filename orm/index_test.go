@@ -958,6 +958,57 @@ func TestCompactIndexRangeQuery(t *testing.T) {
 	}
 }
 
+func TestNativeIndexKeysRange(t *testing.T) {
+	// Values of different length are used, so that native index entries
+	// are not stored in the order of the indexed value.
+	indexByCount := func(o Object) ([][]byte, error) {
+		c := o.Value().(*Counter).Count
+		return [][]byte{[]byte(fmt.Sprint(c))}, nil
+	}
+	b := NewModelBucket("mycounters", &Counter{},
+		WithNativeIndex("native", indexByCount),
+		WithIndex("compact", indexByCount, false),
+	)
+	db := store.MemStore()
+	for _, n := range []int64{7, 120, 3, 15, 1, 300, 19, 2, 100, 15} {
+		if _, err := b.Put(db, nil, &Counter{Count: n}); err != nil {
+			t.Fatalf("cannot insert counter: %s", err)
+		}
+	}
+	insertNoiseData(t, db)
+
+	cases := map[string]struct {
+		Start []byte
+		End   []byte
+		Want  int
+	}{
+		"whole index":            {Want: 10},
+		"from a value":           {Start: []byte("15"), Want: 7},
+		"until a value":          {End: []byte("2"), Want: 6},
+		"within a range":         {Start: []byte("12"), End: []byte("3"), Want: 5},
+		"start longer than some": {Start: []byte("150"), Want: 5},
+		"empty range":            {Start: []byte("4"), End: []byte("6"), Want: 0},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var dest []*Counter
+			got, _, err := b.ByIndexRange(db, "native", nil, tc.Start, tc.End, nil, 0, &dest)
+			if err != nil {
+				t.Fatalf("native index: %s", err)
+			}
+			want, _, err := b.ByIndexRange(db, "compact", nil, tc.Start, tc.End, nil, 0, &dest)
+			if err != nil {
+				t.Fatalf("compact index: %s", err)
+			}
+			if len(got) != tc.Want {
+				t.Fatalf("want %d keys, got %d", tc.Want, len(got))
+			}
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
 func TestNativeIndexRangeQueryReturnValues(t *testing.T) {
 	db := store.MemStore()
 
@@ -1050,3 +1101,80 @@ func insertNoiseData(t testing.TB, db weave.KVStore) {
 	}
 
 }
+
+func TestIndexQueryPage(t *testing.T) {
+	indexer := func(o Object) ([][]byte, error) {
+		c := o.Value().(*Counter).Count
+		return [][]byte{[]byte(fmt.Sprint(c))}, nil
+	}
+	buckets := map[string]ModelBucket{
+		"compact": NewModelBucket("mycounters", &Counter{}, WithIndex("tix", indexer, false)),
+		"native":  NewModelBucket("mycounters", &Counter{}, WithNativeIndex("tix", indexer)),
+	}
+
+	for bucketName, b := range buckets {
+		t.Run(bucketName, func(t *testing.T) {
+			db := store.MemStore()
+			for i := 1; i < 30; i++ {
+				count := int64(i % 4)
+				if _, err := b.Put(db, nil, &Counter{Count: count}); err != nil {
+					t.Fatalf("cannot insert counter: %s", err)
+				}
+			}
+			insertNoiseData(t, db)
+
+			idx, err := b.Index("tix")
+			if err != nil {
+				t.Fatalf("index: %+v", err)
+			}
+			paged := idx.(weave.PagedQueryHandler)
+
+			cases := map[string]struct {
+				mod     string
+				data    []byte
+				wantIDs []int64
+			}{
+				"key query": {
+					mod:     weave.KeyQueryMod,
+					data:    []byte("1"),
+					wantIDs: []int64{1, 5, 9, 13, 17, 21, 25, 29},
+				},
+				"range query": {
+					mod:     weave.RangeQueryMod,
+					data:    []byte(hex.EncodeToString([]byte("2"))),
+					wantIDs: []int64{2, 6, 10, 14, 18, 22, 26, 3, 7, 11, 15, 19, 23, 27},
+				},
+			}
+			for testName, tc := range cases {
+				t.Run(testName, func(t *testing.T) {
+					// Pages are split in the middle of the
+					// references indexed under a single value.
+					var (
+						all    []weave.Model
+						cursor []byte
+					)
+					for {
+						page := weave.QueryPage{Cursor: cursor, Limit: 3}
+						models, next, err := paged.QueryPage(db, tc.mod, tc.data, page)
+						if err != nil {
+							t.Fatalf("query page: %+v", err)
+						}
+						if len(models) > 3 {
+							t.Fatalf("limit exceeded: %d models", len(models))
+						}
+						all = append(all, models...)
+						if len(next) == 0 {
+							break
+						}
+						cursor = next
+					}
+					assertModelIDs(t, "mycounters:", tc.wantIDs, all)
+				})
+			}
+
+			if _, _, err := paged.QueryPage(db, weave.KeyQueryMod, []byte("1"), weave.QueryPage{Limit: -1}); !errors.ErrInput.Is(err) {
+				t.Fatalf("want invalid limit error, got %+v", err)
+			}
+		})
+	}
+}
@@ -2,10 +2,12 @@ package orm
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"reflect"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 )
 
@@ -49,6 +51,18 @@ type ModelBucket interface {
 	// modified.
 	ByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte, dest ModelSlicePtr) (keys [][]byte, err error)
 
+	// ByIndexRange returns all objects that secondary index with given
+	// name holds under a value that starts with given prefix and
+	// whose remaining part is within the [start, end) range. Nil start or
+	// end means no limit. Use IndexKey to build ordered index values.
+	// Matching entities are appended to given destination slice, ordered
+	// by the indexed value.
+	// At most limit entities are returned. Zero limit means no limit. If
+	// more entities match, returned is the cursor of the next entity that
+	// can be passed to continue the scan. Otherwise next is nil. Nil cursor
+	// means the first matching entity.
+	ByIndexRange(db weave.ReadOnlyKVStore, indexName string, prefix, start, end, cursor []byte, limit int, dest ModelSlicePtr) (keys [][]byte, next []byte, err error)
+
 	// Index returns the index with given name that is maintained for this
 	// bucket. This function can return ErrInvalidIndex if an index with
 	// requested name does not exist.
@@ -59,6 +73,40 @@ type ModelBucket interface {
 	// directly is a way to go.
 	Index(name string) (Index, error)
 
+	// Reindex ensures that at most limit entities, starting with the
+	// entity with given key, are indexed by the index with given name.
+	// Entities that are already indexed are not modified. Nil start means
+	// the first entity. Zero limit means no limit.
+	// Returned is the key of the next entity that was not processed or nil
+	// if all entities were processed. This allows to backfill an index of
+	// a big bucket over several transactions.
+	Reindex(db weave.KVStore, indexName string, start []byte, limit int) (next []byte, err error)
+
+	// CheckIndex returns keys of all entities that are not indexed by the
+	// index with given name under all values they should be.
+	CheckIndex(db weave.ReadOnlyKVStore, indexName string) (missing [][]byte, err error)
+
+	// RecountIndex recomputes the count and all sums maintained by the
+	// index with given name for every indexed value. Use it after an
+	// aggregate was configured for an index that already holds entries.
+	// The whole bucket is processed at once.
+	RecountIndex(db weave.KVStore, indexName string) error
+
+	// Count returns the number of entities stored in the bucket. It
+	// returns ErrInput if the bucket was not configured to maintain the
+	// count (see WithCount).
+	Count(db weave.ReadOnlyKVStore) (int64, error)
+
+	// Sum returns the sum with given name, maintained over all entities
+	// stored in the bucket. It returns ErrInput if the bucket was not
+	// configured to maintain such sum (see WithSum).
+	Sum(db weave.ReadOnlyKVStore, name string) (int64, error)
+
+	// SumCoin returns the coin sum with given name, maintained over all
+	// entities stored in the bucket. It returns ErrInput if the bucket was
+	// not configured to maintain such coin sum (see WithCoinSum).
+	SumCoin(db weave.ReadOnlyKVStore, name string) (*coin.Coin, error)
+
 	// Put saves given model in the database. Before inserting into
 	// database, model is validated using its Validate method.
 	// If the key is nil or zero length then a sequence generator is used
@@ -208,9 +256,37 @@ func WithIndex(name string, indexer interface{}, unique bool) ModelBucketOption
 // This implementation should be used to maintain an index for big collections.
 // For small collections, use WithIndex function that configures a compact
 // index implementation.
-func WithNativeIndex(name string, indexer MultiKeyIndexer) ModelBucketOption {
+// Use WithIndexCount and WithIndexSum options to maintain aggregates for each
+// indexed value.
+func WithNativeIndex(name string, indexer MultiKeyIndexer, opts ...NativeIndexOption) ModelBucketOption {
+	return func(mb *modelBucket) {
+		mb.b = mb.b.WithNativeIndex(name, indexer, opts...)
+	}
+}
+
+// WithCount configures the bucket to maintain the number of stored entities.
+// The count can be read using the Count method or a count query.
+func WithCount() ModelBucketOption {
+	return func(mb *modelBucket) {
+		mb.b = mb.b.WithCount()
+	}
+}
+
+// WithSum configures the bucket to maintain a sum of values returned by given
+// function for all stored entities. The sum can be read using the Sum method
+// or a sum query with the sum name as the query data.
+func WithSum(name string, fn Summer) ModelBucketOption {
+	return func(mb *modelBucket) {
+		mb.b = mb.b.WithSum(name, fn)
+	}
+}
+
+// WithCoinSum configures the bucket to maintain a sum of coins returned by
+// given function for all stored entities. The sum can be read using the
+// SumCoin method or a sum query with the sum name as the query data.
+func WithCoinSum(name string, fn CoinSummer) ModelBucketOption {
 	return func(mb *modelBucket) {
-		mb.b = mb.b.WithNativeIndex(name, indexer)
+		mb.b = mb.b.WithCoinSum(name, fn)
 	}
 }
 
@@ -263,6 +339,102 @@ func (mb *modelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key [
 	if err != nil {
 		return nil, err
 	}
+	return mb.appendObjects(objs, destination)
+}
+
+func (mb *modelBucket) ByIndexRange(db weave.ReadOnlyKVStore, indexName string, prefix, start, end, cursor []byte, limit int, destination ModelSlicePtr) ([][]byte, []byte, error) {
+	if limit < 0 {
+		return nil, nil, errors.Wrapf(errors.ErrInput, "invalid limit %d", limit)
+	}
+	idx, err := mb.b.Index(indexName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rangeStart := append(append([]byte{}, prefix...), start...)
+	var rangeEnd []byte
+	if end == nil {
+		_, rangeEnd = prefixRange(prefix)
+	} else {
+		rangeEnd = append(append([]byte{}, prefix...), end...)
+	}
+
+	var cursorValue, cursorKey []byte
+	if cursor != nil {
+		cursorValue, cursorKey, err = unpackRangeCursor(cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if bytes.Compare(cursorValue, rangeStart) < 0 || (rangeEnd != nil && bytes.Compare(cursorValue, rangeEnd) >= 0) {
+			return nil, nil, errors.Wrap(errors.ErrInput, "cursor out of range")
+		}
+		rangeStart = cursorValue
+	}
+
+	it := idx.KeysRange(db, rangeStart, rangeEnd)
+	defer it.Release()
+
+	var (
+		refs [][]byte
+		next []byte
+	)
+	for {
+		ref, value, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "index range")
+		}
+		if cursor != nil && bytes.Equal(value, cursorValue) && indexRefLess(ref, cursorKey) {
+			// Returned by a previous call.
+			continue
+		}
+		if limit != 0 && len(refs) == limit {
+			next = packRangeCursor(value, ref)
+			break
+		}
+		refs = append(refs, ref)
+	}
+
+	objs := make([]Object, 0, len(refs))
+	for _, ref := range refs {
+		obj, err := mb.b.Get(db, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		objs = append(objs, obj)
+	}
+	keys, err := mb.appendObjects(objs, destination)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, next, nil
+}
+
+// packRangeCursor returns a cursor that points to the entity with given key,
+// indexed under given value.
+func packRangeCursor(value, key []byte) []byte {
+	cursor := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(value)+len(key))
+	n := binary.PutUvarint(cursor, uint64(len(value)))
+	cursor = append(cursor[:n], value...)
+	return append(cursor, key...)
+}
+
+// unpackRangeCursor returns the indexed value and the entity key that given
+// cursor points to.
+func unpackRangeCursor(cursor []byte) ([]byte, []byte, error) {
+	size, n := binary.Uvarint(cursor)
+	if n <= 0 || uint64(len(cursor)-n) < size {
+		return nil, nil, errors.Wrap(errors.ErrInput, "malformed cursor")
+	}
+	value := cursor[n : n+int(size)]
+	return value, cursor[n+int(size):], nil
+}
+
+// appendObjects appends values of given objects to the destination slice and
+// returns their keys.
+func (mb *modelBucket) appendObjects(objs []Object, destination ModelSlicePtr) ([][]byte, error) {
 	if len(objs) == 0 {
 		return nil, nil
 	}
@@ -303,7 +475,149 @@ func (mb *modelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key [
 		keys = append(keys, obj.Key())
 	}
 	return keys, nil
+}
+
+func (mb *modelBucket) Reindex(db weave.KVStore, indexName string, start []byte, limit int) ([]byte, error) {
+	if limit < 0 {
+		return nil, errors.Wrapf(errors.ErrInput, "invalid limit %d", limit)
+	}
+	idx, err := mb.b.Index(indexName)
+	if err != nil {
+		return nil, err
+	}
+	// All entities are read before the index is updated, because the
+	// database must not be modified while an iterator is in use.
+	objs, next, err := mb.scan(db, start, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if err := idx.Backfill(db, obj); err != nil {
+			return nil, errors.Wrapf(err, "backfill %q", obj.Key())
+		}
+	}
+	return next, nil
+}
+
+// recountBatchSize is the number of entities that RecountIndex loads into
+// memory at once.
+const recountBatchSize = 100
+
+func (mb *modelBucket) RecountIndex(db weave.KVStore, indexName string) error {
+	idx, err := mb.b.Index(indexName)
+	if err != nil {
+		return err
+	}
+	ix, ok := idx.(*nativeIndex)
+	if !ok {
+		return errors.Wrap(errors.ErrInput, "index does not maintain any aggregate")
+	}
+	if err := ix.resetAggregates(db); err != nil {
+		return err
+	}
+	var start []byte
+	for {
+		// Entities are read in batches before aggregates are updated,
+		// because the database must not be modified while an iterator
+		// is in use.
+		objs, next, err := mb.scan(db, start, recountBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, obj := range objs {
+			if err := ix.addAggregates(db, obj); err != nil {
+				return errors.Wrapf(err, "recount %q", obj.Key())
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		start = next
+	}
+}
+
+func (mb *modelBucket) CheckIndex(db weave.ReadOnlyKVStore, indexName string) ([][]byte, error) {
+	idx, err := mb.b.Index(indexName)
+	if err != nil {
+		return nil, err
+	}
+	// Entities are checked one by one while iterating, so that the
+	// whole bucket is never loaded into memory.
+	prefix := mb.b.DBKey(nil)
+	start, end := prefixRange(prefix)
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+
+	var missing [][]byte
+	for {
+		key, value, err := it.Next()
+		if err != nil {
+			if errors.ErrIteratorDone.Is(err) {
+				return missing, nil
+			}
+			return nil, errors.Wrap(err, "iterator next")
+		}
+		key = key[len(prefix):]
+		obj, err := mb.b.Parse(key, value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %q", key)
+		}
+		values, err := idx.Missing(db, obj)
+		if err != nil {
+			return nil, errors.Wrapf(err, "check %q", key)
+		}
+		if len(values) != 0 {
+			missing = append(missing, key)
+		}
+	}
+}
+
+// scan returns at most limit entities, starting with the entity with given
+// key. Zero limit means no limit. Returned is also the key of the next entity
+// or nil if there are no more entities.
+func (mb *modelBucket) scan(db weave.ReadOnlyKVStore, start []byte, limit int) ([]Object, []byte, error) {
+	prefix := mb.b.DBKey(nil)
+	_, end := prefixRange(prefix)
+	it, err := db.Iterator(mb.b.DBKey(start), end)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "iterator")
+	}
+	defer it.Release()
+
+	var objs []Object
+	for {
+		key, value, err := it.Next()
+		if err != nil {
+			if errors.ErrIteratorDone.Is(err) {
+				return objs, nil, nil
+			}
+			return nil, nil, errors.Wrap(err, "iterator next")
+		}
+		key = key[len(prefix):]
+		if limit > 0 && len(objs) == limit {
+			return objs, key, nil
+		}
+		obj, err := mb.b.Parse(key, value)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "parse %q", key)
+		}
+		objs = append(objs, obj)
+	}
+}
+
+func (mb *modelBucket) Count(db weave.ReadOnlyKVStore) (int64, error) {
+	return mb.b.Count(db)
+}
+
+func (mb *modelBucket) Sum(db weave.ReadOnlyKVStore, name string) (int64, error) {
+	return mb.b.Sum(db, name)
+}
 
+func (mb *modelBucket) SumCoin(db weave.ReadOnlyKVStore, name string) (*coin.Coin, error) {
+	return mb.b.SumCoin(db, name)
 }
 
 func (mb *modelBucket) Put(db weave.KVStore, key []byte, m Model) ([]byte, error) {
@@ -329,7 +643,7 @@ func (mb *modelBucket) Put(db weave.KVStore, key []byte, m Model) ([]byte, error
 
 	obj := NewSimpleObj(key, m)
 	if err := mb.b.Save(db, obj); err != nil {
-		return nil, errors.Wrap(err, "cannot store in the database")
+		return nil, errors.Wrap(errors.WithType(err, m), "cannot store in the database")
 	}
 	return key, nil
 }
@@ -187,6 +187,319 @@ func TestModelBucketByIndex(t *testing.T) {
 	}
 }
 
+func TestModelBucketByIndexRange(t *testing.T) {
+	mustKey := func(fields ...IndexField) []byte {
+		t.Helper()
+		key, err := IndexKey(fields...)
+		if err != nil {
+			t.Fatalf("cannot build index key: %s", err)
+		}
+		return key
+	}
+
+	// Counters are grouped by parity and ordered by their count value.
+	// Count is shifted and encoded as time to test negative values.
+	indexByParity := func(obj Object) ([][]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		group := "even"
+		if c.Count%2 != 0 {
+			group = "odd"
+		}
+		key, err := IndexKey(StringField(group), TimeField(weave.UnixTime(c.Count-5)))
+		return [][]byte{key}, err
+	}
+
+	cases := map[string]struct {
+		Prefix []byte
+		Start  []byte
+		End    []byte
+		Want   []int64
+	}{
+		"whole index": {
+			Want: []int64{2, 4, 10, 1, 5, 7},
+		},
+		"whole group": {
+			Prefix: mustKey(StringField("even")),
+			Want:   []int64{2, 4, 10},
+		},
+		"group until a value": {
+			Prefix: mustKey(StringField("odd")),
+			End:    mustKey(TimeField(0)),
+			Want:   []int64{1},
+		},
+		"group from a value": {
+			Prefix: mustKey(StringField("odd")),
+			Start:  mustKey(TimeField(0)),
+			Want:   []int64{5, 7},
+		},
+		"group within a range": {
+			Prefix: mustKey(StringField("odd")),
+			Start:  mustKey(TimeField(-10)),
+			End:    mustKey(TimeField(1)),
+			Want:   []int64{1, 5},
+		},
+		"unknown group": {
+			Prefix: mustKey(StringField("od")),
+			Want:   nil,
+		},
+	}
+
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{},
+		WithNativeIndex("native", indexByParity),
+		WithIndex("compact", indexByParity, false),
+	)
+	for _, n := range []int64{5, 2, 10, 1, 7, 4} {
+		if _, err := b.Put(db, nil, &Counter{Count: n}); err != nil {
+			t.Fatalf("cannot save counter instance: %s", err)
+		}
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			for _, indexName := range []string{"native", "compact"} {
+				t.Run(indexName, func(t *testing.T) {
+					var dest []*Counter
+					keys, next, err := b.ByIndexRange(db, indexName, tc.Prefix, tc.Start, tc.End, nil, 0, &dest)
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+					var got []int64
+					for _, c := range dest {
+						got = append(got, c.Count)
+					}
+					assert.Equal(t, tc.Want, got)
+					assert.Equal(t, len(tc.Want), len(keys))
+					assert.Equal(t, []byte(nil), next)
+				})
+			}
+		})
+	}
+}
+
+func TestModelBucketByIndexRangePaging(t *testing.T) {
+	// Two counters share each value. Values are of a different length,
+	// so that native index entries are not stored in the order of the
+	// indexed value.
+	indexByHalf := func(obj Object) ([][]byte, error) {
+		c := obj.Value().(*Counter)
+		return [][]byte{[]byte(strconv.FormatInt(c.Count/2, 10))}, nil
+	}
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{},
+		WithNativeIndex("native", indexByHalf),
+		WithIndex("compact", indexByHalf, false),
+	)
+	for n := int64(0); n < 300; n++ {
+		if _, err := b.Put(db, nil, &Counter{Count: n}); err != nil {
+			t.Fatalf("cannot save counter instance: %s", err)
+		}
+	}
+
+	// Both indexes must return entities in the same order.
+	var want [][]byte
+	for _, indexName := range []string{"native", "compact"} {
+		t.Run(indexName, func(t *testing.T) {
+			var all []*Counter
+			allKeys, next, err := b.ByIndexRange(db, indexName, nil, []byte("1"), nil, nil, 0, &all)
+			assert.Nil(t, err)
+			assert.Equal(t, []byte(nil), next)
+			assert.Equal(t, 298, len(allKeys))
+			if want == nil {
+				want = allKeys
+			}
+			assert.Equal(t, want, allKeys)
+
+			for _, limit := range []int{1, 3, 100} {
+				var (
+					dest   []*Counter
+					keys   [][]byte
+					cursor []byte
+				)
+				for {
+					page, next, err := b.ByIndexRange(db, indexName, nil, []byte("1"), nil, cursor, limit, &dest)
+					assert.Nil(t, err)
+					if len(page) > limit {
+						t.Fatalf("limit %d: got %d keys", limit, len(page))
+					}
+					keys = append(keys, page...)
+					if next == nil {
+						break
+					}
+					cursor = next
+				}
+				assert.Equal(t, allKeys, keys)
+				assert.Equal(t, all, dest)
+			}
+		})
+	}
+
+	var dest []*Counter
+	if _, _, err := b.ByIndexRange(db, "native", nil, []byte("5"), nil, []byte{1, '1'}, 1, &dest); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for a cursor out of range, got %+v", err)
+	}
+}
+
+func TestModelBucketReindex(t *testing.T) {
+	db := store.MemStore()
+
+	// Entities are stored before the bucket is indexed.
+	b := NewModelBucket("cnts", &Counter{})
+	for i := int64(1); i <= 5; i++ {
+		if _, err := b.Put(db, nil, &Counter{Count: i}); err != nil {
+			t.Fatalf("cannot save counter instance: %s", err)
+		}
+	}
+
+	indexByCount := func(obj Object) ([]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		return []byte(strconv.FormatInt(c.Count, 10)), nil
+	}
+	b = NewModelBucket("cnts", &Counter{},
+		WithNativeIndex("native", asMultiKeyIndexer(indexByCount), WithIndexCount()),
+		WithIndex("compact", indexByCount, false),
+		WithIndex("unique", indexByCount, true),
+	)
+
+	for _, indexName := range []string{"native", "compact", "unique"} {
+		t.Run(indexName, func(t *testing.T) {
+			missing, err := b.CheckIndex(db, indexName)
+			if err != nil {
+				t.Fatalf("cannot check index: %s", err)
+			}
+			assert.Equal(t, 5, len(missing))
+
+			var (
+				next  []byte
+				steps int
+			)
+			for {
+				next, err = b.Reindex(db, indexName, next, 2)
+				if err != nil {
+					t.Fatalf("cannot reindex: %s", err)
+				}
+				steps++
+				if next == nil {
+					break
+				}
+			}
+			assert.Equal(t, 3, steps)
+
+			missing, err = b.CheckIndex(db, indexName)
+			if err != nil {
+				t.Fatalf("cannot check index: %s", err)
+			}
+			assert.Equal(t, 0, len(missing))
+
+			var found []Counter
+			if _, err := b.ByIndex(db, indexName, []byte("4"), &found); err != nil {
+				t.Fatalf("cannot query by index: %s", err)
+			}
+			assert.Equal(t, []Counter{{Count: 4}}, found)
+
+			// Reindexing an already indexed bucket is a no-op.
+			if _, err := b.Reindex(db, indexName, nil, 0); err != nil {
+				t.Fatalf("cannot reindex: %s", err)
+			}
+
+			idx, err := b.Index(indexName)
+			assert.Nil(t, err)
+			n, err := idx.Count(db, []byte("4"))
+			assert.Nil(t, err)
+			assert.Equal(t, int64(1), n)
+		})
+	}
+
+	if _, err := b.Reindex(db, "unknown", nil, 0); !ErrInvalidIndex.Is(err) {
+		t.Fatalf("want invalid index error, got %v", err)
+	}
+}
+
+func TestModelBucketUniqueIndexError(t *testing.T) {
+	db := store.MemStore()
+
+	indexByCount := func(obj Object) ([]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		return []byte(strconv.FormatInt(c.Count, 10)), nil
+	}
+	b := NewModelBucket("cnts", &Counter{}, WithIndex("value", indexByCount, true))
+
+	if _, err := b.Put(db, []byte("a"), &Counter{Count: 1}); err != nil {
+		t.Fatalf("cannot save counter instance: %s", err)
+	}
+	if _, err := b.Put(db, []byte("b"), &Counter{Count: 2}); err != nil {
+		t.Fatalf("cannot save counter instance: %s", err)
+	}
+
+	cases := map[string]struct {
+		Key   []byte
+		Count int64
+	}{
+		"insert": {Key: []byte("c"), Count: 1},
+		"update": {Key: []byte("b"), Count: 1},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			_, err := b.Put(db, tc.Key, &Counter{Count: tc.Count})
+			if !errors.ErrDuplicate.Is(err) {
+				t.Fatalf("want duplicate error, got %v", err)
+			}
+			uerr, ok := AsUniqueIndexError(err)
+			if !ok {
+				t.Fatalf("want unique index error, got %v", err)
+			}
+			assert.Equal(t, "cnts_value", uerr.Index)
+			assert.Equal(t, []byte("1"), uerr.Value)
+			assert.Equal(t, []byte("a"), uerr.Key)
+			assert.Equal(t, 1, len(errors.FieldErrors(err, "cnts_value")))
+
+			code, _ := errors.ABCIInfo(err, false)
+			assert.Equal(t, errors.ErrDuplicate.ABCICode(), code)
+		})
+	}
+
+	if _, ok := AsUniqueIndexError(errors.Wrap(errors.ErrDuplicate, "other")); ok {
+		t.Fatal("a duplicate error must not be a unique index error")
+	}
+}
+
+func TestCheckUnique(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{})
+	if _, err := b.Put(db, []byte("a"), &Counter{Count: 1}); err != nil {
+		t.Fatalf("cannot save counter instance: %s", err)
+	}
+
+	assert.Nil(t, CheckUnique(db, b, []byte("b")))
+
+	err := CheckUnique(db, b, []byte("a"))
+	if !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %v", err)
+	}
+	uerr, ok := AsUniqueIndexError(err)
+	if !ok {
+		t.Fatalf("want unique index error, got %v", err)
+	}
+	assert.Equal(t, PrimaryIndex, uerr.Index)
+	assert.Equal(t, []byte("a"), uerr.Key)
+
+	tags := weave.DeliverTxError(errors.Wrap(err, "counter"), false).Tags
+	assert.Equal(t, 3, len(tags))
+	assert.Equal(t, UniqueIndexTag, string(tags[0].Key))
+	assert.Equal(t, PrimaryIndex, string(tags[0].Value))
+	assert.Equal(t, UniqueKeyTag, string(tags[2].Key))
+	assert.Equal(t, []byte("a"), tags[2].Value)
+}
+
 func TestModelBucketPutWrongModelType(t *testing.T) {
 	db := store.MemStore()
 	b := NewModelBucket("cnts", &Counter{})
@@ -73,16 +73,11 @@ func (m *MultiRef) Sort() {
 // the ref was in the set, index is where it is
 // (or where it should be)
 func (m *MultiRef) findRef(ref []byte) (int, bool) {
-	for i, r := range m.Refs {
-		switch bytes.Compare(ref, r) {
-		case -1:
-			return i, false
-		case 0:
-			return i, true
-		}
-	}
-	// hit the end, must append
-	return len(m.Refs), false
+	// refs are always kept sorted
+	i := sort.Search(len(m.Refs), func(i int) bool {
+		return bytes.Compare(m.Refs[i], ref) >= 0
+	})
+	return i, i < len(m.Refs) && bytes.Equal(m.Refs[i], ref)
 }
 
 //------- these allow us to use MultiRef as CloneableData in tests ----
@@ -1,6 +1,8 @@
 package orm
 
 import (
+	"bytes"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
@@ -12,23 +14,6 @@ func RegisterQuery(qr weave.QueryRouter) {
 	bucket{}.Register("", qr)
 }
 
-// consumeIterator will read all remaining data into an
-// array and close the iterator
-func consumeIterator(itr weave.Iterator) ([]weave.Model, error) {
-	defer itr.Release()
-
-	var res []weave.Model
-	key, value, err := itr.Next()
-	for err == nil {
-		res = append(res, weave.Model{Key: key, Value: value})
-		key, value, err = itr.Next()
-	}
-	if !errors.ErrIteratorDone.Is(err) {
-		return nil, err
-	}
-	return res, nil
-}
-
 // prefixRange turns a prefix into (start, end) to create
 // and iterator
 func prefixRange(prefix []byte) ([]byte, []byte) {
@@ -58,30 +43,117 @@ func prefixRange(prefix []byte) ([]byte, []byte) {
 
 // queryPrefix returns a prefix query as Models
 func queryPrefix(db weave.ReadOnlyKVStore, prefix []byte) ([]weave.Model, error) {
-	iter, err := db.Iterator(prefixRange(prefix))
+	start, end := prefixRange(prefix)
+	models, _, err := queryRange(db, start, end, weave.QueryPage{}, 0)
+	return models, err
+}
+
+// queryRange returns a single page of models stored under keys in the
+// [start, end) range. Cursor of such query is the database key of the next
+// model.
+func queryRange(
+	db weave.ReadOnlyKVStore,
+	start, end []byte,
+	page weave.QueryPage,
+	defaultLimit int,
+) ([]weave.Model, []byte, error) {
+	start, end, limit, err := pageRange(start, end, page, defaultLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	return consumePage(&dbCursorIterator{it: it}, limit)
+}
+
+// pageRange returns the part of the [start, end) range that is read to
+// return given page and the maximum number of models of that page.
+func pageRange(start, end []byte, page weave.QueryPage, defaultLimit int) ([]byte, []byte, int, error) {
+	limit, err := pageLimit(page, defaultLimit)
 	if err != nil {
-		return nil, err
+		return nil, nil, 0, err
+	}
+	if len(page.Cursor) != 0 {
+		if !inRange(page.Cursor, start, end) {
+			return nil, nil, 0, errors.Wrap(errors.ErrInput, "cursor out of the query range")
+		}
+		start = page.Cursor
 	}
-	return consumeIterator(iter)
+	return start, end, limit, nil
 }
 
+// queryRangeLimit is the default number of models returned by a range query.
 var queryRangeLimit = 50
 
-// paginatedIterator wraps an iterator and returns only first X results.
-// limitedIterator name is already taken.
-type paginatedIterator struct {
-	it        weave.Iterator
-	remaining int
+// pageLimit returns the maximum number of models that a query for given page
+// returns. Zero means no limit.
+func pageLimit(page weave.QueryPage, defaultLimit int) (int, error) {
+	switch {
+	case page.Limit < 0:
+		return 0, errors.Wrapf(errors.ErrInput, "invalid limit %d", page.Limit)
+	case page.Limit == 0:
+		return defaultLimit, nil
+	default:
+		return page.Limit, nil
+	}
+}
+
+// inRange returns true if given key is within the [start, end) range. Nil
+// start or end means no limit.
+func inRange(key, start, end []byte) bool {
+	if start != nil && bytes.Compare(key, start) < 0 {
+		return false
+	}
+	if end != nil && bytes.Compare(key, end) >= 0 {
+		return false
+	}
+	return true
+}
+
+// cursorIterator is an iterator that returns a cursor together with each
+// model. A query resumed from that cursor returns that model first.
+type cursorIterator interface {
+	Next() (cursor []byte, model weave.Model, err error)
+	Release()
 }
 
-func (i *paginatedIterator) Next() (key []byte, value []byte, err error) {
-	if i.remaining == 0 {
-		return nil, nil, errors.ErrIteratorDone
+// consumePage reads at most limit models from given iterator and releases
+// it. Zero limit means no limit. Returned is the cursor of the first model
+// that was not read or nil if all models were read.
+func consumePage(it cursorIterator, limit int) ([]weave.Model, []byte, error) {
+	defer it.Release()
+
+	var res []weave.Model
+	for {
+		cursor, m, err := it.Next()
+		switch {
+		case errors.ErrIteratorDone.Is(err):
+			return res, nil, nil
+		case err != nil:
+			return nil, nil, err
+		}
+		if limit > 0 && len(res) == limit {
+			return res, cursor, nil
+		}
+		res = append(res, m)
+	}
+}
+
+// dbCursorIterator wraps a database iterator. Cursor is the database key.
+type dbCursorIterator struct {
+	it weave.Iterator
+}
+
+func (i *dbCursorIterator) Next() ([]byte, weave.Model, error) {
+	key, value, err := i.it.Next()
+	if err != nil {
+		return nil, weave.Model{}, err
 	}
-	i.remaining--
-	return i.it.Next()
+	return key, weave.Model{Key: key, Value: value}, nil
 }
 
-func (i *paginatedIterator) Release() {
+func (i *dbCursorIterator) Release() {
 	i.it.Release()
 }
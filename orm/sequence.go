@@ -36,6 +36,20 @@ func (s *Sequence) NextInt(db weave.KVStore) (int64, error) {
 	return val, err
 }
 
+// Raise sets the sequence to given value, unless it is already greater. Use
+// it after storing an entity under an explicit ID, so that the sequence never
+// generates that ID again.
+func (s *Sequence) Raise(db weave.KVStore, val int64) error {
+	raw, err := db.Get(s.id)
+	if err != nil {
+		return err
+	}
+	if decodeSequence(raw) >= val {
+		return nil
+	}
+	return db.Set(s.id, encodeSequence(val))
+}
+
 func (s *Sequence) increment(db weave.KVStore, inc int64) (int64, []byte, error) {
 	raw, err := db.Get(s.id)
 	if err != nil {
@@ -65,6 +79,67 @@ func encodeSequence(val int64) []byte {
 	return bz
 }
 
+const sequencePrefix = "_s."
+
+// SequenceInitializer loads and exports the state of all sequences. Loading
+// entities from the genesis file increments sequences only up to the highest
+// loaded ID, so the counters are restored separately. It must be the last
+// initializer, so that the restored counters are not incremented by other
+// extensions.
+type SequenceInitializer struct{}
+
+var _ weave.Initializer = (*SequenceInitializer)(nil)
+var _ weave.Exporter = (*SequenceInitializer)(nil)
+
+// FromGenesis sets all sequences listed in the genesis file to the stored
+// value, unless they are already greater.
+func (*SequenceInitializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	var sequences map[string]int64
+	if err := opts.ReadOptions("sequences", &sequences); err != nil {
+		return err
+	}
+	for name, val := range sequences {
+		if val < 0 {
+			return errors.Wrapf(errors.ErrInput, "sequence %q: negative value", name)
+		}
+		seq := Sequence{id: []byte(sequencePrefix + name)}
+		if err := seq.Raise(kv, val); err != nil {
+			return errors.Wrapf(err, "sequence %q", name)
+		}
+	}
+	return nil
+}
+
+// ToGenesis exports the current value of all sequences.
+func (*SequenceInitializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	start, end := prefixRange([]byte(sequencePrefix))
+	it, err := kv.Iterator(start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "sequences")
+	}
+	defer it.Release()
+
+	sequences := make(map[string]int64)
+	for {
+		key, value, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "sequences")
+		}
+		if err := ValidateSequence(value); err != nil {
+			return nil, errors.Wrapf(err, "sequence %q", key)
+		}
+		sequences[string(key[len(sequencePrefix):])] = decodeSequence(value)
+	}
+	opts := make(weave.Options)
+	if err := opts.WriteOptions("sequences", sequences); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
 // ValidateSequence returns an error if this is not an 8-byte
 // as expected for orm.IDGenBucket
 func ValidateSequence(id []byte) error {
@@ -3,6 +3,7 @@ package orm
 import (
 	"testing"
 
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -32,6 +33,22 @@ func TestSequence(t *testing.T) {
 	}
 }
 
+func TestSequenceRaise(t *testing.T) {
+	db := store.MemStore()
+	s := NewSequence("bucket", "name")
+
+	assert.Nil(t, s.Raise(db, 5))
+	got, err := s.NextInt(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), got)
+
+	// Sequence is never decremented.
+	assert.Nil(t, s.Raise(db, 2))
+	got, err = s.NextInt(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), got)
+}
+
 func TestSequenceKeyFormat(t *testing.T) {
 	db := store.MemStore()
 	s := NewSequence("bucket", "name")
@@ -79,3 +96,35 @@ func TestValidateSequence(t *testing.T) {
 		})
 	}
 }
+
+func TestSequenceInitializer(t *testing.T) {
+	db := store.MemStore()
+	a := NewSequence("bucket", "a")
+	b := NewSequence("bucket", "b")
+	for i := 0; i < 5; i++ {
+		_, err := a.NextVal(db)
+		assert.Nil(t, err)
+	}
+	_, err := b.NextVal(db)
+	assert.Nil(t, err)
+
+	var init SequenceInitializer
+	opts, err := init.ToGenesis(db)
+	assert.Nil(t, err)
+
+	// Loading entities may have already incremented a sequence beyond
+	// the exported value, which must be kept.
+	redb := store.MemStore()
+	for i := 0; i < 3; i++ {
+		_, err := b.NextVal(redb)
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, init.FromGenesis(opts, weave.GenesisParams{}, redb))
+
+	if got, err := a.NextInt(redb); err != nil || got != 6 {
+		t.Fatalf("want 6, got %d: %v", got, err)
+	}
+	if got, err := b.NextInt(redb); err != nil || got != 4 {
+		t.Fatalf("want 4, got %d: %v", got, err)
+	}
+}
@@ -1,7 +1,14 @@
 package weave
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/iov-one/weave/errors"
 )
 
 const (
@@ -19,8 +26,28 @@ const (
 	// encoded.
 	// See each implementation for more details.
 	RangeQueryMod = "range"
+	// CountQueryMod means to return the number of matching entities
+	// instead of the entities themselves.
+	//
+	// For bucket queries, data must be empty. For index queries, data is
+	// the indexed value. The only result holds a serialized orm.Counter.
+	CountQueryMod = "count"
+	// SumQueryMod means to return a sum maintained over all matching
+	// entities.
+	//
+	// For bucket queries, data is the name of the sum. For index queries,
+	// data is the name of the sum and the indexed value, separated with
+	// ":". The only result holds a serialized orm.Counter, or coin.Coin
+	// for a coin sum.
+	SumQueryMod = "sum"
 )
 
+// JSONQueryFormat requests the query result to be encoded as JSON instead of
+// protobuf. It is declared with the "format=json" query parameter. Each model
+// is decoded using the type registered for its bucket (see
+// QueryRouter.RegisterModel).
+const JSONQueryFormat = "json"
+
 // Model groups together key and value to return
 type Model struct {
 	Key   []byte
@@ -40,6 +67,184 @@ type QueryHandler interface {
 	Query(db ReadOnlyKVStore, mod string, data []byte) ([]Model, error)
 }
 
+// QueryPage declares which part of a query result is requested.
+type QueryPage struct {
+	// Cursor is the opaque value returned together with the previous page
+	// of the same query. Empty cursor requests the first page.
+	Cursor []byte
+	// Limit is the maximum number of models returned. Zero means the
+	// default limit of the query handler.
+	Limit int
+}
+
+// IsZero returns true if no pagination parameter is set.
+func (p QueryPage) IsZero() bool {
+	return len(p.Cursor) == 0 && p.Limit == 0
+}
+
+// PagedQueryHandler is implemented by query handlers that can return the
+// result in pages.
+type PagedQueryHandler interface {
+	QueryHandler
+
+	// QueryPage returns a single page of the query result. Returned
+	// cursor must be used to request the next page. An empty cursor
+	// means that there are no more results.
+	QueryPage(db ReadOnlyKVStore, mod string, data []byte, page QueryPage) ([]Model, []byte, error)
+}
+
+// ParseQueryMod splits the query modifier (everything after "?" in the query
+// path) into the query mode and the pagination parameters.
+//
+// Pagination parameters are declared as "limit=<number>" and
+// "cursor=<hex>", separated from the mode and from each other with "&". For
+// example "prefix&limit=10&cursor=0a1b".
+//
+// The "format=<name>" parameter is validated but not returned. Use
+// ParseQueryFormat to read it.
+func ParseQueryMod(raw string) (string, QueryPage, error) {
+	var (
+		mod  string
+		page QueryPage
+	)
+	for i, chunk := range strings.Split(raw, "&") {
+		eq := strings.IndexByte(chunk, '=')
+		if eq < 0 {
+			if i != 0 {
+				return "", page, errors.Wrapf(errors.ErrInput, "invalid query parameter %q", chunk)
+			}
+			mod = chunk
+			continue
+		}
+		switch name, value := chunk[:eq], chunk[eq+1:]; name {
+		case "limit":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				return "", page, errors.Wrapf(errors.ErrInput, "invalid limit %q", value)
+			}
+			page.Limit = n
+		case "cursor":
+			c, err := hex.DecodeString(value)
+			if err != nil {
+				return "", page, errors.Wrapf(errors.ErrInput, "invalid cursor %q", value)
+			}
+			page.Cursor = c
+		case "format":
+			if _, err := ParseQueryFormat(chunk); err != nil {
+				return "", page, err
+			}
+		default:
+			return "", page, errors.Wrapf(errors.ErrInput, "unknown query parameter %q", name)
+		}
+	}
+	return mod, page, nil
+}
+
+// ParseQueryFormat returns the result format declared by the query modifier
+// (everything after "?" in the query path). Empty format means protobuf.
+func ParseQueryFormat(raw string) (string, error) {
+	var format string
+	for _, chunk := range strings.Split(raw, "&") {
+		if !strings.HasPrefix(chunk, "format=") {
+			continue
+		}
+		switch format = chunk[len("format="):]; format {
+		case "", JSONQueryFormat:
+		default:
+			return "", errors.Wrapf(errors.ErrInput, "unknown query format %q", format)
+		}
+	}
+	return format, nil
+}
+
+// QueryPath returns the query path extended with given query mode and
+// pagination parameters. This is the inverse of ParseQueryMod.
+func QueryPath(path, mod string, page QueryPage) string {
+	var params []string
+	if mod != "" {
+		params = append(params, mod)
+	}
+	if page.Limit != 0 {
+		params = append(params, "limit="+strconv.Itoa(page.Limit))
+	}
+	if len(page.Cursor) != 0 {
+		params = append(params, "cursor="+hex.EncodeToString(page.Cursor))
+	}
+	if len(params) == 0 {
+		return path
+	}
+	return path + "?" + strings.Join(params, "&")
+}
+
+// TypedQueryHandler handles a query with a protobuf encoded request and
+// response. Use QueryRouter.RegisterTyped to register it. Clients decode the
+// response directly into the declared type, instead of decoding raw key-value
+// pairs.
+type TypedQueryHandler interface {
+	// NewRequest returns a new instance of the request message that the
+	// query data is unmarshaled into.
+	NewRequest() Persistent
+
+	// NewResponse returns a new instance of the response message. Query
+	// must always return a response of the same type.
+	NewResponse() Persistent
+
+	// Query returns the response for given request. The request is
+	// always of the type returned by NewRequest and if it implements the
+	// Validate method, it is already validated.
+	Query(db ReadOnlyKVStore, req Persistent) (Persistent, error)
+}
+
+// typedQueryHandler adapts a TypedQueryHandler to the QueryHandler interface.
+// The result is a single model, with the serialized response as the value.
+type typedQueryHandler struct {
+	h TypedQueryHandler
+}
+
+var _ QueryHandler = (*typedQueryHandler)(nil)
+
+func (t *typedQueryHandler) Query(db ReadOnlyKVStore, mod string, data []byte) ([]Model, error) {
+	if mod != KeyQueryMod {
+		return nil, errors.Wrapf(errors.ErrInput, "typed query does not support %q mode", mod)
+	}
+	req := t.h.NewRequest()
+	if err := req.Unmarshal(data); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot unmarshal %T request: %s", req, err)
+	}
+	if v, ok := req.(validater); ok {
+		if err := v.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid request")
+		}
+	}
+	resp, err := t.h.Query(db, req)
+	if err != nil {
+		return nil, err
+	}
+	if want := reflect.TypeOf(t.h.NewResponse()); reflect.TypeOf(resp) != want {
+		return nil, errors.Wrapf(errors.ErrType, "response must be %s, got %T", want, resp)
+	}
+	if v, ok := resp.(validater); ok {
+		if err := v.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid response")
+		}
+	}
+	raw, err := resp.Marshal()
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot marshal %T response", resp)
+	}
+	return []Model{{Key: nil, Value: raw}}, nil
+}
+
+// NewResponse returns a new instance of the response message. It allows to
+// decode the query result, that has no key to find the model type by.
+func (t *typedQueryHandler) NewResponse() Persistent {
+	return t.h.NewResponse()
+}
+
+type validater interface {
+	Validate() error
+}
+
 // QueryRegister is a function that adds some handlers
 // to this router
 type QueryRegister func(QueryRouter)
@@ -51,12 +256,15 @@ type QueryRegister func(QueryRouter)
 // Minimal interface modeled after net/http.ServeMux
 type QueryRouter struct {
 	routes map[string]QueryHandler
+	// models maps bucket names to the type of models stored in them.
+	models map[string]reflect.Type
 }
 
 // NewQueryRouter initializes a QueryRouter with no routes
 func NewQueryRouter() QueryRouter {
 	return QueryRouter{
 		routes: make(map[string]QueryHandler, 10),
+		models: make(map[string]reflect.Type),
 	}
 }
 
@@ -82,6 +290,48 @@ func (r QueryRouter) Register(path string, h QueryHandler) {
 	r.routes[path] = h
 }
 
+// RegisterTyped adds a new typed query handler for the given path. The same
+// path rules as for Register apply. This function panics if a handler for
+// given path is already registered.
+//
+// A typed query accepts only the exact match mode. Query data is the
+// serialized request and the query result is a single model with the
+// serialized response as the value.
+func (r QueryRouter) RegisterTyped(path string, h TypedQueryHandler) {
+	r.Register(path, &typedQueryHandler{h: h})
+}
+
+// RegisterModel declares that the bucket with given name stores models of the
+// same type as given one. Bucket name is the prefix of the database key of
+// every stored model, separated with ":". This allows to decode query results
+// without knowing the queried path. This function panics if a different model
+// type is already registered for given bucket name.
+func (r QueryRouter) RegisterModel(bucket string, m Persistent) {
+	tp := reflect.TypeOf(m)
+	if tp.Kind() != reflect.Ptr {
+		panic(fmt.Sprintf("Bucket %s model must be a pointer, got %s", bucket, tp))
+	}
+	if prev, ok := r.models[bucket]; ok && prev != tp {
+		panic(fmt.Sprintf("Re-registering bucket %s model: %s, was %s", bucket, tp, prev))
+	}
+	r.models[bucket] = tp
+}
+
+// NewModel returns a new instance of the model type registered for the
+// bucket that given database key belongs to. It returns false if no model
+// type is registered.
+func (r QueryRouter) NewModel(key []byte) (Persistent, bool) {
+	i := bytes.IndexByte(key, ':')
+	if i < 0 {
+		return nil, false
+	}
+	tp, ok := r.models[string(key[:i])]
+	if !ok {
+		return nil, false
+	}
+	return reflect.New(tp.Elem()).Interface().(Persistent), true
+}
+
 // Handler returns the registered Handler for this path.
 // If no path is found, returns a noSuchPath Handler
 // Always returns a non-nil Handler
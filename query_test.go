@@ -0,0 +1,195 @@
+package weave
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestParseQueryMod(t *testing.T) {
+	cases := map[string]struct {
+		raw      string
+		wantMod  string
+		wantPage QueryPage
+		wantErr  *errors.Error
+	}{
+		"no modifier": {
+			raw:     "",
+			wantMod: KeyQueryMod,
+		},
+		"mode only": {
+			raw:     "prefix",
+			wantMod: PrefixQueryMod,
+		},
+		"mode with pagination": {
+			raw:      "range&limit=10&cursor=0a1b",
+			wantMod:  RangeQueryMod,
+			wantPage: QueryPage{Limit: 10, Cursor: []byte{0x0a, 0x1b}},
+		},
+		"key query with pagination": {
+			raw:      "limit=3",
+			wantMod:  KeyQueryMod,
+			wantPage: QueryPage{Limit: 3},
+		},
+		"negative limit": {
+			raw:     "prefix&limit=-1",
+			wantErr: errors.ErrInput,
+		},
+		"invalid cursor": {
+			raw:     "prefix&cursor=zz",
+			wantErr: errors.ErrInput,
+		},
+		"unknown parameter": {
+			raw:     "prefix&size=2",
+			wantErr: errors.ErrInput,
+		},
+		"mode after parameters": {
+			raw:     "limit=2&prefix",
+			wantErr: errors.ErrInput,
+		},
+		"json format": {
+			raw:      "prefix&limit=2&format=json",
+			wantMod:  PrefixQueryMod,
+			wantPage: QueryPage{Limit: 2},
+		},
+		"unknown format": {
+			raw:     "prefix&format=xml",
+			wantErr: errors.ErrInput,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			mod, page, err := ParseQueryMod(tc.raw)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tc.wantMod, mod)
+			assert.Equal(t, tc.wantPage, page)
+		})
+	}
+}
+
+func TestParseQueryFormat(t *testing.T) {
+	format, err := ParseQueryFormat("prefix&format=json&limit=2")
+	assert.Nil(t, err)
+	assert.Equal(t, JSONQueryFormat, format)
+
+	format, err = ParseQueryFormat("prefix")
+	assert.Nil(t, err)
+	assert.Equal(t, "", format)
+
+	if _, err := ParseQueryFormat("format=xml"); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %v", err)
+	}
+}
+
+func TestQueryRouterNewModel(t *testing.T) {
+	qr := NewQueryRouter()
+	qr.RegisterModel("texts", &textMsg{})
+	// Registering the same type again is allowed.
+	qr.RegisterModel("texts", &textMsg{})
+
+	m, ok := qr.NewModel([]byte("texts:1"))
+	if !ok {
+		t.Fatal("model type not found")
+	}
+	if _, ok := m.(*textMsg); !ok {
+		t.Fatalf("unexpected model type: %T", m)
+	}
+
+	if _, ok := qr.NewModel([]byte("other:1")); ok {
+		t.Fatal("unregistered bucket must not have a model type")
+	}
+	if _, ok := qr.NewModel([]byte("texts")); ok {
+		t.Fatal("key without a bucket prefix must not have a model type")
+	}
+}
+
+func TestQueryPath(t *testing.T) {
+	assert.Equal(t, "/wallets", QueryPath("/wallets", KeyQueryMod, QueryPage{}))
+	assert.Equal(t, "/wallets?prefix", QueryPath("/wallets", PrefixQueryMod, QueryPage{}))
+
+	path := QueryPath("/wallets", PrefixQueryMod, QueryPage{Limit: 5, Cursor: []byte("abc")})
+	assert.Equal(t, "/wallets?prefix&limit=5&cursor=616263", path)
+
+	mod, page, err := ParseQueryMod(path[len("/wallets?"):])
+	assert.Nil(t, err)
+	assert.Equal(t, PrefixQueryMod, mod)
+	assert.Equal(t, QueryPage{Limit: 5, Cursor: []byte("abc")}, page)
+}
+
+func TestTypedQuery(t *testing.T) {
+	r := NewQueryRouter()
+	r.RegisterTyped("/upper", upperQuery{})
+	h := r.Handler("/upper")
+
+	models, err := h.Query(nil, KeyQueryMod, []byte("hello"))
+	assert.Nil(t, err)
+	assert.Equal(t, []Model{{Value: []byte("HELLO")}}, models)
+
+	if _, err := h.Query(nil, KeyQueryMod, nil); !errors.ErrEmpty.Is(err) {
+		t.Fatalf("want invalid request error, got %v", err)
+	}
+	if _, err := h.Query(nil, KeyQueryMod, []byte("fail")); !errors.ErrEmpty.Is(err) || !strings.Contains(err.Error(), "invalid response") {
+		t.Fatalf("want invalid response error, got %v", err)
+	}
+	if _, err := h.Query(nil, KeyQueryMod, []byte("other")); !errors.ErrType.Is(err) {
+		t.Fatalf("want response type error, got %v", err)
+	}
+	if _, err := h.Query(nil, PrefixQueryMod, []byte("hello")); !errors.ErrInput.Is(err) {
+		t.Fatalf("want unsupported mode error, got %v", err)
+	}
+}
+
+// upperQuery is a typed query that returns the request text in upper case.
+type upperQuery struct{}
+
+func (upperQuery) NewRequest() Persistent {
+	return &textMsg{}
+}
+
+func (upperQuery) NewResponse() Persistent {
+	return &textMsg{}
+}
+
+func (upperQuery) Query(db ReadOnlyKVStore, req Persistent) (Persistent, error) {
+	text := req.(*textMsg).text
+	switch text {
+	case "fail":
+		// Response is not valid.
+		return &textMsg{}, nil
+	case "other":
+		// Response is not of the declared type.
+		return &otherMsg{textMsg{text: text}}, nil
+	}
+	return &textMsg{text: strings.ToUpper(text)}, nil
+}
+
+type textMsg struct {
+	text string
+}
+
+func (m *textMsg) Marshal() ([]byte, error) {
+	return []byte(m.text), nil
+}
+
+func (m *textMsg) Unmarshal(raw []byte) error {
+	m.text = string(raw)
+	return nil
+}
+
+type otherMsg struct {
+	textMsg
+}
+
+func (m *textMsg) Validate() error {
+	if m.text == "" {
+		return errors.Wrap(errors.ErrEmpty, "text")
+	}
+	return nil
+}
@@ -1,5 +1,7 @@
 package weave
 
+import "github.com/tendermint/tendermint/crypto/merkle"
+
 //////////////////////////////////////////////////////////
 // Defines all public interfaces for interacting with stores
 //
@@ -125,24 +127,13 @@ type KVCacheWrap interface {
 // to disk. We modify it in batch by getting a CacheWrap()
 // and then Write(). Commit() will persist all changes to disk
 //
-// This store should also be able to return merkle proofs for
-// any committed state.
+// Stores that are able to return merkle proofs for any committed state
+// should also implement ProvableKVStore interface.
 type CommitKVStore interface {
 	// Get returns the value at last committed state
 	// returns nil iff key doesn't exist. Panics on nil key.
 	Get(key []byte) ([]byte, error)
 
-	// TODO: Get with proof, also historical queries
-	// GetVersionedWithProof(key []byte, version int64) (value []byte)
-
-	// func (b *Bonsai) GetWithProof(key []byte) ([]byte, iavl.KeyProof, error) {
-	//   return b.Tree.GetWithProof(key)
-	// }
-
-	// func (b *Bonsai) GetVersionedWithProof(key []byte, version int64) ([]byte, iavl.KeyProof, error) {
-	//   return b.Tree.GetVersionedWithProof(key, uint64(version))
-	// }
-
 	// Get a CacheWrap to perform actions
 	// TODO: add Batch to atomic writes and efficiency
 	// invisibly inside this CacheWrap???
@@ -166,6 +157,25 @@ type CommitKVStore interface {
 	LoadVersion(ver int64) error
 }
 
+// ProvableKVStore is implemented by a CommitKVStore that can prove the
+// content of the committed state.
+type ProvableKVStore interface {
+	// ProveRange returns a proof of the first count entries stored within
+	// the [start, end) range of the given version. If complete is true,
+	// the proof also ensures that there are no more entries within that
+	// range.
+	// A single key is proven using a [key, key+0x00) range.
+	ProveRange(version int64, start, end []byte, count int, complete bool) (merkle.ProofOp, error)
+}
+
+// HistoricalKVStore is implemented by a CommitKVStore that keeps the history
+// of committed versions.
+type HistoricalKVStore interface {
+	// AtVersion returns a read only view of the given committed version.
+	// ErrNotFound is returned if the version is no longer available.
+	AtVersion(version int64) (ReadOnlyKVStore, error)
+}
+
 // CommitID contains the tree version number and its merkle root.
 type CommitID struct {
 	Version int64
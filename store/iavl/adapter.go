@@ -2,13 +2,14 @@ package iavl
 
 import (
 	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
 )
 
-// TODO: make these configurable?
+// Default values used by a CommitStore unless configured otherwise.
 const (
 	DefaultCacheSize int   = 10000
 	DefaultHistory   int64 = 20
@@ -16,23 +17,73 @@ const (
 
 // CommitStore manages a iavl committed state
 type CommitStore struct {
-	tree       *iavl.MutableTree
-	numHistory int64
+	tree    *iavl.MutableTree
+	pruning PruningStrategy
 }
 
 var _ store.CommitKVStore = CommitStore{}
+var _ store.ProvableKVStore = CommitStore{}
+var _ store.HistoricalKVStore = CommitStore{}
+
+// CommitStoreOption alters the default configuration of a CommitStore.
+type CommitStoreOption func(*commitStoreConfig)
+
+type commitStoreConfig struct {
+	cacheSize int
+	pruning   PruningStrategy
+	backend   string
+}
+
+func newCommitStoreConfig(opts []CommitStoreOption) commitStoreConfig {
+	conf := commitStoreConfig{
+		cacheSize: DefaultCacheSize,
+		pruning:   PruneDefault,
+		backend:   DefaultBackend,
+	}
+	for _, fn := range opts {
+		fn(&conf)
+	}
+	if err := conf.pruning.Validate(); err != nil {
+		panic(err)
+	}
+	return conf
+}
+
+// WithCacheSize sets the number of tree nodes that are cached in memory.
+func WithCacheSize(size int) CommitStoreOption {
+	return func(c *commitStoreConfig) {
+		c.cacheSize = size
+	}
+}
+
+// WithPruning sets the strategy used to delete old versions on commit.
+func WithPruning(p PruningStrategy) CommitStoreOption {
+	return func(c *commitStoreConfig) {
+		c.pruning = p
+	}
+}
+
+// WithBackend sets the name of the database backend that is used to persist
+// the tree. Backend must be registered (see RegisterBackend).
+func WithBackend(name string) CommitStoreOption {
+	return func(c *commitStoreConfig) {
+		c.backend = name
+	}
+}
 
 // NewCommitStore creates a new store with disk backing
-func NewCommitStore(path, name string) CommitStore {
-	// Create the underlying leveldb datastore which will
+func NewCommitStore(path, name string, opts ...CommitStoreOption) CommitStore {
+	conf := newCommitStoreConfig(opts)
+
+	// Create the underlying datastore which will
 	// persist the Merkle tree inner & leaf nodes.
-	db, err := dbm.NewGoLevelDB(name, path)
+	db, err := OpenDB(conf.backend, name, path)
 	if err != nil {
 		panic(err)
 	}
 
-	tree := iavl.NewMutableTree(db, DefaultCacheSize)
-	commit := CommitStore{tree, DefaultHistory}
+	tree := iavl.NewMutableTree(db, conf.cacheSize)
+	commit := CommitStore{tree, conf.pruning}
 
 	err = commit.LoadLatestVersion()
 	if err != nil {
@@ -44,15 +95,17 @@ func NewCommitStore(path, name string) CommitStore {
 
 // NewCommitStoreFromTree accepts a preloaded MutableTree and wraps it
 // Mainly designed for test code... or devs who want full control
-func NewCommitStoreFromTree(tree *iavl.MutableTree) CommitStore {
-	return CommitStore{tree, DefaultHistory}
+func NewCommitStoreFromTree(tree *iavl.MutableTree, opts ...CommitStoreOption) CommitStore {
+	conf := newCommitStoreConfig(opts)
+	return CommitStore{tree, conf.pruning}
 }
 
 // MockCommitStore creates a new in-memory store for testing
-func MockCommitStore() CommitStore {
+func MockCommitStore(opts ...CommitStoreOption) CommitStore {
+	conf := newCommitStoreConfig(opts)
 	var db dbm.DB = dbm.NewMemDB()
-	tree := iavl.NewMutableTree(db, DefaultCacheSize)
-	return CommitStore{tree, DefaultHistory}
+	tree := iavl.NewMutableTree(db, conf.cacheSize)
+	return CommitStore{tree, conf.pruning}
 }
 
 // Get returns the value at last committed state
@@ -74,9 +127,10 @@ func (s CommitStore) Commit() (store.CommitID, error) {
 		panic(err)
 	}
 
-	// Potentially release an old version of history
-	if s.numHistory > 0 && (s.numHistory < version) {
-		toRelease := version - s.numHistory
+	// Potentially release an old version of history. Versions that were
+	// kept by a previously used strategy are not released.
+	toRelease := version - s.pruning.KeepRecent
+	if s.pruning.prunable(toRelease, version) && s.tree.VersionExists(toRelease) {
 		err = s.tree.DeleteVersion(toRelease)
 		if err != nil {
 			panic(err)
@@ -133,9 +187,93 @@ func (s CommitStore) CacheWrap() store.KVCacheWrap {
 	return s.Adapter().CacheWrap()
 }
 
-// func (b *Bonsai) GetVersionedWithProof(key []byte, version int64) ([]byte, iavl.KeyProof, error) {
-//   return b.Tree.GetVersionedWithProof(key, uint64(version))
-// }
+// AtVersion returns a read only view of the given committed version.
+// Only versions kept by the pruning strategy are available.
+func (s CommitStore) AtVersion(version int64) (store.ReadOnlyKVStore, error) {
+	latest := s.tree.Version()
+	if version > latest {
+		return nil, errors.Wrapf(errors.ErrInput, "version %d is not committed yet, latest is %d", version, latest)
+	}
+	if !s.tree.VersionExists(version) {
+		return nil, errors.Wrapf(errors.ErrNotFound, "version %d was pruned", version)
+	}
+	tree, err := s.tree.GetImmutable(version)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return immutableAdapter{tree: tree}, nil
+}
+
+// ProveRange returns a proof of the first count entries stored within the
+// [start, end) range of the given version. If complete is true, the proof
+// also ensures that there are no more entries within that range.
+func (s CommitStore) ProveRange(version int64, start, end []byte, count int, complete bool) (merkle.ProofOp, error) {
+	if !s.tree.VersionExists(version) {
+		return merkle.ProofOp{}, errors.Wrapf(errors.ErrNotFound, "version %d", version)
+	}
+	tree, err := s.tree.GetImmutable(version)
+	if err != nil {
+		return merkle.ProofOp{}, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+
+	op := RangeOp{
+		Start:    start,
+		End:      end,
+		Complete: complete,
+	}
+
+	// Index of the first entry within the range.
+	var first int64
+	if start != nil {
+		first, _ = tree.Get(start)
+	}
+	if first > 0 {
+		key, _ := tree.GetByIndex(first - 1)
+		if op.Before, err = proveKey(tree, key); err != nil {
+			return merkle.ProofOp{}, err
+		}
+	}
+
+	size := tree.Size()
+	for i := first; i < first+int64(count); i++ {
+		if i >= size {
+			return merkle.ProofOp{}, errors.Wrapf(errors.ErrState, "expected %d entries, got %d", count, i-first)
+		}
+		key, value := tree.GetByIndex(i)
+		if !op.contains(key) {
+			return merkle.ProofOp{}, errors.Wrapf(errors.ErrState, "expected %d entries, got %d", count, i-first)
+		}
+		proof, err := proveKey(tree, key)
+		if err != nil {
+			return merkle.ProofOp{}, err
+		}
+		op.Keys = append(op.Keys, key)
+		op.Values = append(op.Values, value)
+		op.Proofs = append(op.Proofs, proof)
+	}
+
+	if next := first + int64(count); complete && next < size {
+		key, _ := tree.GetByIndex(next)
+		if op.contains(key) {
+			return merkle.ProofOp{}, errors.Wrapf(errors.ErrState, "more than %d entries", count)
+		}
+		if op.After, err = proveKey(tree, key); err != nil {
+			return merkle.ProofOp{}, err
+		}
+	}
+	return op.ProofOp(), nil
+}
+
+// proveKey returns an existence proof of given key.
+func proveKey(tree *iavl.ImmutableTree, key []byte) (proof *iavl.RangeProof, err error) {
+	// Tree panics when the key is made of 0xFF bytes only.
+	defer errors.Recover(&err)
+	_, proof, err = tree.GetWithProof(key)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return proof, nil
+}
 
 // TODO: create batch and reader and wrap the rest in btree...
 
@@ -199,3 +337,44 @@ func (a adapter) ReverseIterator(start, end []byte) (store.Iterator, error) {
 
 	return iter, nil
 }
+
+// immutableAdapter converts a saved iavl.ImmutableTree to match the read only
+// store interface.
+type immutableAdapter struct {
+	tree *iavl.ImmutableTree
+}
+
+var _ store.ReadOnlyKVStore = immutableAdapter{}
+
+// Get returns nil iff key doesn't exist. Panics on nil key.
+func (a immutableAdapter) Get(key []byte) ([]byte, error) {
+	_, val := a.tree.Get(key)
+	return val, nil
+}
+
+// Has checks if a key exists. Panics on nil key.
+func (a immutableAdapter) Has(key []byte) (bool, error) {
+	return a.tree.Has(key), nil
+}
+
+// Iterator over a domain of keys in ascending order. End is exclusive.
+// Start must be less than end, or the Iterator is invalid.
+func (a immutableAdapter) Iterator(start, end []byte) (store.Iterator, error) {
+	iter := newLazyIterator()
+	go func() {
+		a.tree.IterateRange(start, end, true, iter.add)
+		iter.Release()
+	}()
+	return iter, nil
+}
+
+// ReverseIterator over a domain of keys in descending order. End is exclusive.
+// Start must be greater than end, or the Iterator is invalid.
+func (a immutableAdapter) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	iter := newLazyIterator()
+	go func() {
+		a.tree.IterateRange(start, end, false, iter.add)
+		iter.Release()
+	}()
+	return iter, nil
+}
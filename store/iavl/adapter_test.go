@@ -73,7 +73,7 @@ func TestCommitOverwrite(t *testing.T) {
 		t.Run(testName, func(t *testing.T) {
 			commit, close := makeCommitStore()
 			// only one to trigger a cleanup
-			commit.numHistory = 1
+			commit.pruning = PruningStrategy{KeepRecent: 1}
 
 			id, err := commit.LatestVersion()
 			assert.Nil(t, err)
@@ -0,0 +1,76 @@
+package iavl
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// DefaultBackend is the name of the database backend used by a CommitStore
+// unless configured otherwise.
+const DefaultBackend = "goleveldb"
+
+// Backend opens a database with given name, located in given directory, that
+// a CommitStore persists its tree nodes in.
+type Backend func(name, dir string) (dbm.DB, error)
+
+var (
+	backendsMu sync.Mutex
+	backends   = map[string]Backend{
+		"goleveldb": func(name, dir string) (dbm.DB, error) {
+			return dbm.NewGoLevelDB(name, dir)
+		},
+	}
+)
+
+// RegisterBackend makes a database backend available under given name, so
+// that it can be selected using WithBackend. This allows to use any embedded
+// database that implements the dbm.DB interface without changing this
+// package.
+//
+// This function panics if a backend with given name is already registered.
+func RegisterBackend(name string, b Backend) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+
+	if _, ok := backends[name]; ok {
+		panic("database backend already registered: " + name)
+	}
+	backends[name] = b
+}
+
+// Backends returns the names of all registered database backends, in
+// alphabetical order.
+func Backends() []string {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// OpenDB opens a database with given name, located in given directory, using
+// the backend registered under given name.
+func OpenDB(backend, name, dir string) (dbm.DB, error) {
+	backendsMu.Lock()
+	open, ok := backends[backend]
+	backendsMu.Unlock()
+
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrInput,
+			"unknown database backend %q, use one of: %s", backend, strings.Join(Backends(), ", "))
+	}
+	db, err := open(name, dir)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrDatabase, "cannot open %s database: %s", backend, err)
+	}
+	return db, nil
+}
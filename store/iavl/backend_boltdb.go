@@ -0,0 +1,16 @@
+//go:build boltdb
+// +build boltdb
+
+package iavl
+
+import (
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// bbolt support requires the boltdb build tag, the same as in the tendermint
+// database package.
+func init() {
+	RegisterBackend("boltdb", func(name, dir string) (dbm.DB, error) {
+		return dbm.NewBoltDB(name, dir)
+	})
+}
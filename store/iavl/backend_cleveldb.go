@@ -0,0 +1,17 @@
+//go:build cleveldb
+// +build cleveldb
+
+package iavl
+
+import (
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// LevelDB C library support requires the cleveldb build tag, the same as in
+// the tendermint database package, and the leveldb headers and library to be
+// installed.
+func init() {
+	RegisterBackend("cleveldb", func(name, dir string) (dbm.DB, error) {
+		return dbm.NewCLevelDB(name, dir)
+	})
+}
@@ -0,0 +1,85 @@
+package iavl
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Memory database is not available outside of tests, because it does not
+// persist the state. It is used to test the backend registration.
+func init() {
+	RegisterBackend("memdb", func(name, dir string) (dbm.DB, error) {
+		return dbm.NewMemDB(), nil
+	})
+}
+
+// TestBackendCompliance runs the store test suite against a commit store
+// persisted using every registered database backend. Backends that require
+// a build tag are tested only when built with that tag.
+func TestBackendCompliance(t *testing.T) {
+	for _, backend := range Backends() {
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			suite := store.NewTestSuite(func() (store.CacheableKVStore, func()) {
+				tmpDir, err := ioutil.TempDir("", "iavl-backend-")
+				if err != nil {
+					panic(err)
+				}
+				commit := NewCommitStore(tmpDir, "base", WithBackend(backend))
+				return commit.Adapter(), func() { os.RemoveAll(tmpDir) }
+			})
+			t.Run("GetSet", suite.GetSet)
+			t.Run("CacheConflicts", suite.CacheConflicts)
+			t.Run("FuzzIterator", suite.FuzzIterator)
+			t.Run("IteratorWithConflicts", suite.IteratorWithConflicts)
+		})
+	}
+}
+
+func TestBackendCommitAndReload(t *testing.T) {
+	for _, backend := range Backends() {
+		if backend == "memdb" {
+			// Memory database is not persisted.
+			continue
+		}
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "iavl-backend-")
+			assert.Nil(t, err)
+			defer os.RemoveAll(tmpDir)
+
+			db, err := OpenDB(backend, "base", tmpDir)
+			assert.Nil(t, err)
+			commit := NewCommitStoreFromTree(iavl.NewMutableTree(db, DefaultCacheSize))
+			assert.Nil(t, commit.Adapter().Set([]byte("key"), []byte("value")))
+			want, err := commit.Commit()
+			assert.Nil(t, err)
+			db.Close()
+
+			db, err = OpenDB(backend, "base", tmpDir)
+			assert.Nil(t, err)
+			defer db.Close()
+			commit = NewCommitStoreFromTree(iavl.NewMutableTree(db, DefaultCacheSize))
+			assert.Nil(t, commit.LoadLatestVersion())
+			got, err := commit.LatestVersion()
+			assert.Nil(t, err)
+			assert.Equal(t, want, got)
+			value, err := commit.Get([]byte("key"))
+			assert.Nil(t, err)
+			assert.Equal(t, "value", string(value))
+		})
+	}
+}
+
+func TestOpenUnknownBackend(t *testing.T) {
+	if _, err := OpenDB("unknown", "base", os.TempDir()); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %v", err)
+	}
+}
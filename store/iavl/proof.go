@@ -0,0 +1,221 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+)
+
+// ProofOpRange is the type of a proof operation created by the RangeOp.
+const ProofOpRange = "weave:iavl:range"
+
+var cdc = amino.NewCodec()
+
+// RangeOp proves the content of a [Start, End) key range of an IAVL tree.
+// Keys and Values are the first entries stored within that range. If
+// Complete is true, they are all the entries stored within that range.
+//
+// A single key lookup is proven using a range that contains only that key.
+// Such proof is a proof of existence if it contains an entry and a proof of
+// absence if it is empty.
+//
+// Every declared entry is proven by a separate existence proof. Because each
+// existence proof reveals the position of the entry in the tree, it can be
+// ensured that declared entries are consecutive. Existence proofs of the
+// entries directly before and after the declared ones are used to prove the
+// range boundaries.
+type RangeOp struct {
+	Start    []byte   `json:"start"`
+	End      []byte   `json:"end"`
+	Complete bool     `json:"complete"`
+	Keys     [][]byte `json:"keys"`
+	Values   [][]byte `json:"values"`
+
+	// Proofs contains an existence proof for each declared entry.
+	Proofs []*iavl.RangeProof `json:"proofs"`
+	// Before is an existence proof of the entry that directly precedes
+	// the range. It is nil if no such entry exists.
+	Before *iavl.RangeProof `json:"before"`
+	// After is an existence proof of the entry that directly follows the
+	// last declared entry. It is nil if no such entry exists or if the
+	// range is not complete.
+	After *iavl.RangeProof `json:"after"`
+}
+
+var _ merkle.ProofOperator = (*RangeOp)(nil)
+
+// RangeOpDecoder decodes a merkle.ProofOp created by the RangeOp. It can be
+// registered with the merkle.ProofRuntime.
+func RangeOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpRange {
+		return nil, errors.Wrapf(errors.ErrType, "unexpected proof operation type %q", pop.Type)
+	}
+	var op RangeOp
+	if err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot decode range proof: %s", err)
+	}
+	return &op, nil
+}
+
+// NewProofRuntime returns a merkle.ProofRuntime that can decode all proof
+// operations created by the CommitStore.
+func NewProofRuntime() *merkle.ProofRuntime {
+	prt := merkle.NewProofRuntime()
+	prt.RegisterOpDecoder(ProofOpRange, RangeOpDecoder)
+	return prt
+}
+
+// ProofOp implements merkle.ProofOperator interface.
+func (op *RangeOp) ProofOp() merkle.ProofOp {
+	return merkle.ProofOp{
+		Type: ProofOpRange,
+		Key:  op.Start,
+		Data: cdc.MustMarshalBinaryLengthPrefixed(op),
+	}
+}
+
+// GetKey implements merkle.ProofOperator interface. It returns the start of
+// the proven range.
+func (op *RangeOp) GetKey() []byte {
+	return op.Start
+}
+
+func (op *RangeOp) String() string {
+	return fmt.Sprintf("RangeOp{%X:%X %d}", op.Start, op.End, len(op.Keys))
+}
+
+// Models returns all proven key/value pairs.
+func (op *RangeOp) Models() []store.Model {
+	res := make([]store.Model, len(op.Keys))
+	for i := range op.Keys {
+		res[i] = store.Model{Key: op.Keys[i], Value: op.Values[i]}
+	}
+	return res
+}
+
+// Run implements merkle.ProofOperator interface. Proven key/value pairs are
+// part of the operation, so no arguments are accepted. On success, a single
+// result that is the root hash of the tree is returned.
+func (op *RangeOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 0 {
+		return nil, errors.Wrap(errors.ErrInput, "range proof does not accept arguments")
+	}
+	root, err := op.verify()
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{root}, nil
+}
+
+// verify checks that the proof is valid for the declared range and the
+// declared key/value pairs. Returned is the root hash of the tree that the
+// proof was created for.
+func (op *RangeOp) verify() ([]byte, error) {
+	if len(op.Keys) != len(op.Values) || len(op.Keys) != len(op.Proofs) {
+		return nil, errors.Wrap(errors.ErrInput, "keys, values and proofs count mismatch")
+	}
+	if !op.Complete && op.After != nil {
+		return nil, errors.Wrap(errors.ErrInput, "incomplete range with an after proof")
+	}
+
+	chain := make([]*iavl.RangeProof, 0, len(op.Proofs)+2)
+	if op.Before != nil {
+		chain = append(chain, op.Before)
+	}
+	chain = append(chain, op.Proofs...)
+	if op.After != nil {
+		chain = append(chain, op.After)
+	}
+	// Only an empty tree, which hash is nil, has no entries at all.
+	if len(chain) == 0 {
+		if op.Complete {
+			return nil, nil
+		}
+		return nil, errors.Wrap(errors.ErrInput, "empty proof")
+	}
+
+	var root []byte
+	for i, p := range chain {
+		if p == nil || len(p.Leaves) != 1 {
+			return nil, errors.Wrapf(errors.ErrInput, "proof %d: not a single entry proof", i)
+		}
+		hash := p.ComputeRootHash()
+		if hash == nil {
+			return nil, errors.Wrapf(errors.ErrInput, "proof %d: malformed", i)
+		}
+		if err := p.Verify(hash); err != nil {
+			return nil, errors.Wrapf(errors.ErrInput, "proof %d: %s", i, err)
+		}
+		if root == nil {
+			root = hash
+		} else if !bytes.Equal(root, hash) {
+			return nil, errors.Wrapf(errors.ErrInput, "proof %d: root mismatch", i)
+		}
+		// Entries must be directly next to each other.
+		if i > 0 && p.LeftIndex() != chain[i-1].LeftIndex()+1 {
+			return nil, errors.Wrapf(errors.ErrInput, "proof %d: entries are not consecutive", i)
+		}
+	}
+
+	for i, p := range op.Proofs {
+		if !bytes.Equal(op.Keys[i], p.Leaves[0].Key) {
+			return nil, errors.Wrapf(errors.ErrInput, "entry %d: key mismatch", i)
+		}
+		if !op.contains(op.Keys[i]) {
+			return nil, errors.Wrapf(errors.ErrInput, "entry %d: out of range", i)
+		}
+		if err := p.VerifyItem(op.Keys[i], op.Values[i]); err != nil {
+			return nil, errors.Wrapf(errors.ErrInput, "entry %d: %s", i, err)
+		}
+	}
+
+	// There must be no entry between the range start and the first
+	// declared entry.
+	if op.Before != nil {
+		if op.Start == nil || bytes.Compare(op.Before.Leaves[0].Key, op.Start) >= 0 {
+			return nil, errors.Wrap(errors.ErrInput, "before entry in range")
+		}
+	} else if chain[0].LeftIndex() != 0 {
+		return nil, errors.Wrap(errors.ErrInput, "range start not proven")
+	}
+
+	// There must be no entry between the last declared entry and the
+	// range end.
+	if op.Complete {
+		if op.After != nil {
+			if op.contains(op.After.Leaves[0].Key) {
+				return nil, errors.Wrap(errors.ErrInput, "after entry in range")
+			}
+		} else if last := chain[len(chain)-1]; last.LeftIndex() != treeSize(last)-1 {
+			return nil, errors.Wrap(errors.ErrInput, "range end not proven")
+		}
+	}
+	return root, nil
+}
+
+// contains returns true if given key belongs to the range.
+func (op *RangeOp) contains(key []byte) bool {
+	if op.Start != nil && bytes.Compare(key, op.Start) < 0 {
+		return false
+	}
+	if op.End != nil && bytes.Compare(key, op.End) >= 0 {
+		return false
+	}
+	return true
+}
+
+// treeSize returns the total number of entries in the tree that given
+// existence proof was created for.
+func treeSize(p *iavl.RangeProof) int64 {
+	if len(p.LeftPath) == 0 {
+		return 1
+	}
+	// First path element is the root node.
+	return p.LeftPath[0].Size
+}
@@ -0,0 +1,146 @@
+package iavl
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestProveRange(t *testing.T) {
+	commit := MockCommitStore()
+	db := commit.CacheWrap()
+	for _, k := range []string{"a", "b", "ba", "bb", "c", "d"} {
+		assert.Nil(t, db.Set([]byte(k), []byte("value-"+k)))
+	}
+	assert.Nil(t, db.Write())
+	id, err := commit.Commit()
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		start    []byte
+		end      []byte
+		count    int
+		complete bool
+		wantKeys []string
+	}{
+		"existing key": {
+			start:    []byte("b"),
+			end:      []byte("b\x00"),
+			count:    1,
+			complete: true,
+			wantKeys: []string{"b"},
+		},
+		"missing key": {
+			start:    []byte("bc"),
+			end:      []byte("bc\x00"),
+			complete: true,
+		},
+		"missing key before all": {
+			start:    []byte("0"),
+			end:      []byte("0\x00"),
+			complete: true,
+		},
+		"missing key after all": {
+			start:    []byte("x"),
+			end:      []byte("x\x00"),
+			complete: true,
+		},
+		"prefix range": {
+			start:    []byte("b"),
+			end:      []byte("c"),
+			count:    3,
+			complete: true,
+			wantKeys: []string{"b", "ba", "bb"},
+		},
+		"whole tree": {
+			count:    6,
+			complete: true,
+			wantKeys: []string{"a", "b", "ba", "bb", "c", "d"},
+		},
+		"partial read": {
+			start:    []byte("ab"),
+			count:    2,
+			wantKeys: []string{"b", "ba"},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			pop, err := commit.ProveRange(id.Version, tc.start, tc.end, tc.count, tc.complete)
+			assert.Nil(t, err)
+
+			op, err := NewProofRuntime().Decode(pop)
+			assert.Nil(t, err)
+			root, err := op.Run(nil)
+			assert.Nil(t, err)
+			assert.Equal(t, id.Hash, root[0])
+
+			var keys []string
+			for _, m := range op.(*RangeOp).Models() {
+				keys = append(keys, string(m.Key))
+				assert.Equal(t, "value-"+string(m.Key), string(m.Value))
+			}
+			assert.Equal(t, tc.wantKeys, keys)
+		})
+	}
+}
+
+func TestProveRangeMissingVersion(t *testing.T) {
+	commit := MockCommitStore()
+	_, err := commit.ProveRange(5, nil, nil, 0, true)
+	if !errors.ErrNotFound.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func TestRangeOpTampering(t *testing.T) {
+	commit := MockCommitStore()
+	db := commit.CacheWrap()
+	for _, k := range []string{"a", "b", "c", "d"} {
+		assert.Nil(t, db.Set([]byte(k), []byte("value-"+k)))
+	}
+	assert.Nil(t, db.Write())
+	id, err := commit.Commit()
+	assert.Nil(t, err)
+
+	cases := map[string]func(*RangeOp){
+		"changed value": func(op *RangeOp) {
+			op.Values[1] = []byte("forged")
+		},
+		"hidden entry": func(op *RangeOp) {
+			op.Keys = op.Keys[:1]
+			op.Values = op.Values[:1]
+		},
+		"hidden first entry": func(op *RangeOp) {
+			op.Keys = op.Keys[1:]
+			op.Values = op.Values[1:]
+		},
+		"extended range": func(op *RangeOp) {
+			op.End = []byte("z")
+		},
+		"moved range start": func(op *RangeOp) {
+			op.Start = []byte("0")
+		},
+	}
+
+	for testName, tamper := range cases {
+		t.Run(testName, func(t *testing.T) {
+			pop, err := commit.ProveRange(id.Version, []byte("b"), []byte("d"), 2, true)
+			assert.Nil(t, err)
+			op, err := RangeOpDecoder(pop)
+			assert.Nil(t, err)
+
+			// Untouched proof must be valid.
+			root, err := op.Run(nil)
+			assert.Nil(t, err)
+			assert.Equal(t, id.Hash, root[0])
+
+			tamper(op.(*RangeOp))
+			root, err = op.Run(nil)
+			if err == nil && string(root[0]) == string(id.Hash) {
+				t.Fatal("tampered proof accepted")
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package iavl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// PruningStrategy declares which of the committed versions are kept on
+// disk. Versions that are not kept are deleted as soon as they become too
+// old, after which they can no longer be queried or proven.
+type PruningStrategy struct {
+	// KeepAll disables pruning. Every committed version is kept.
+	KeepAll bool
+	// KeepRecent is the number of most recent versions that are kept.
+	KeepRecent int64
+	// KeepEvery is the interval of checkpoint versions that are never
+	// deleted. Every version that is a multiple of KeepEvery is kept. Zero
+	// disables checkpoints.
+	KeepEvery int64
+}
+
+var (
+	// PruneNothing keeps all committed versions. Useful for archive
+	// nodes.
+	PruneNothing = PruningStrategy{KeepAll: true}
+	// PruneDefault keeps only DefaultHistory most recent versions.
+	PruneDefault = PruningStrategy{KeepRecent: DefaultHistory}
+)
+
+// Validate returns an error if the strategy is not usable.
+func (p PruningStrategy) Validate() error {
+	if p.KeepAll {
+		if p.KeepRecent != 0 || p.KeepEvery != 0 {
+			return errors.Wrap(errors.ErrInput, "keep-all cannot be combined with other options")
+		}
+		return nil
+	}
+	if p.KeepRecent < 1 {
+		return errors.Wrap(errors.ErrInput, "at least one recent version must be kept")
+	}
+	if p.KeepEvery < 0 {
+		return errors.Wrap(errors.ErrInput, "negative keep-every")
+	}
+	return nil
+}
+
+// prunable returns true if given version should be deleted once the latest
+// version is committed.
+func (p PruningStrategy) prunable(version, latest int64) bool {
+	if p.KeepAll || version < 1 || version > latest-p.KeepRecent {
+		return false
+	}
+	return p.KeepEvery == 0 || version%p.KeepEvery != 0
+}
+
+// String returns the textual representation of the strategy, as accepted by
+// ParsePruningStrategy.
+func (p PruningStrategy) String() string {
+	if p.KeepAll {
+		return "keep-all"
+	}
+	s := fmt.Sprintf("keep-recent=%d", p.KeepRecent)
+	if p.KeepEvery != 0 {
+		s += fmt.Sprintf(",keep-every=%d", p.KeepEvery)
+	}
+	return s
+}
+
+// ParsePruningStrategy parses the textual representation of a pruning
+// strategy. Accepted is either "keep-all" or a comma separated list of
+// "keep-recent=<n>" and optionally "keep-every=<m>", for example
+// "keep-recent=100,keep-every=10000".
+func ParsePruningStrategy(s string) (PruningStrategy, error) {
+	var p PruningStrategy
+	for _, opt := range strings.Split(s, ",") {
+		opt = strings.TrimSpace(opt)
+		if opt == "keep-all" {
+			p.KeepAll = true
+			continue
+		}
+
+		chunks := strings.SplitN(opt, "=", 2)
+		if len(chunks) != 2 {
+			return p, errors.Wrapf(errors.ErrInput, "invalid pruning option %q", opt)
+		}
+		n, err := strconv.ParseInt(chunks[1], 10, 64)
+		if err != nil {
+			return p, errors.Wrapf(errors.ErrInput, "invalid %s value %q", chunks[0], chunks[1])
+		}
+		switch chunks[0] {
+		case "keep-recent":
+			p.KeepRecent = n
+		case "keep-every":
+			p.KeepEvery = n
+		default:
+			return p, errors.Wrapf(errors.ErrInput, "unknown pruning option %q", chunks[0])
+		}
+	}
+	if err := p.Validate(); err != nil {
+		return p, err
+	}
+	return p, nil
+}
@@ -0,0 +1,131 @@
+package iavl
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestPruning(t *testing.T) {
+	cases := map[string]struct {
+		pruning   PruningStrategy
+		versions  int64
+		wantKept  []int64
+		wantNever []int64
+	}{
+		"keep all": {
+			pruning:  PruneNothing,
+			versions: 30,
+			wantKept: []int64{1, 2, 15, 30},
+		},
+		"keep recent": {
+			pruning:   PruningStrategy{KeepRecent: 3},
+			versions:  10,
+			wantKept:  []int64{8, 9, 10},
+			wantNever: []int64{1, 5, 7},
+		},
+		"keep recent and every": {
+			pruning:   PruningStrategy{KeepRecent: 2, KeepEvery: 4},
+			versions:  10,
+			wantKept:  []int64{4, 8, 9, 10},
+			wantNever: []int64{1, 2, 3, 5, 6, 7},
+		},
+		"keep only the latest": {
+			pruning:   PruningStrategy{KeepRecent: 1},
+			versions:  5,
+			wantKept:  []int64{5},
+			wantNever: []int64{1, 4},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			commit := MockCommitStore(WithPruning(tc.pruning))
+			for i := int64(1); i <= tc.versions; i++ {
+				assert.Nil(t, commit.Adapter().Set([]byte("key"), []byte{byte(i)}))
+				id, err := commit.Commit()
+				assert.Nil(t, err)
+				assert.Equal(t, i, id.Version)
+			}
+
+			for _, v := range tc.wantKept {
+				db, err := commit.AtVersion(v)
+				if err != nil {
+					t.Fatalf("version %d: %s", v, err)
+				}
+				value, err := db.Get([]byte("key"))
+				assert.Nil(t, err)
+				assert.Equal(t, []byte{byte(v)}, value)
+			}
+			for _, v := range tc.wantNever {
+				if _, err := commit.AtVersion(v); !errors.ErrNotFound.Is(err) {
+					t.Fatalf("version %d: unexpected error: %v", v, err)
+				}
+			}
+		})
+	}
+}
+
+func TestParsePruningStrategy(t *testing.T) {
+	cases := map[string]struct {
+		raw     string
+		want    PruningStrategy
+		wantErr *errors.Error
+	}{
+		"keep all": {
+			raw:  "keep-all",
+			want: PruneNothing,
+		},
+		"keep recent": {
+			raw:  "keep-recent=20",
+			want: PruneDefault,
+		},
+		"keep recent and every": {
+			raw:  "keep-recent=100, keep-every=1000",
+			want: PruningStrategy{KeepRecent: 100, KeepEvery: 1000},
+		},
+		"keep every without keep recent": {
+			raw:     "keep-every=1000",
+			wantErr: errors.ErrInput,
+		},
+		"keep all with other options": {
+			raw:     "keep-all,keep-recent=4",
+			wantErr: errors.ErrInput,
+		},
+		"zero recent": {
+			raw:     "keep-recent=0",
+			wantErr: errors.ErrInput,
+		},
+		"unknown option": {
+			raw:     "keep-some=4",
+			wantErr: errors.ErrInput,
+		},
+		"invalid number": {
+			raw:     "keep-recent=many",
+			wantErr: errors.ErrInput,
+		},
+		"empty": {
+			raw:     "",
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			p, err := ParsePruningStrategy(tc.raw)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tc.want, p)
+
+			// String representation must be parsable.
+			again, err := ParsePruningStrategy(p.String())
+			assert.Nil(t, err)
+			assert.Equal(t, p, again)
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package iavl
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// SnapshotFormat is the version of the snapshot format created by the
+// ExportSnapshot function.
+const SnapshotFormat = 1
+
+// DefaultSnapshotChunkSize is the default maximum size of a single snapshot
+// chunk file in bytes.
+const DefaultSnapshotChunkSize = 16 << 20
+
+// SnapshotManifestFile is the name of the file within the snapshot directory
+// that the manifest is stored in.
+const SnapshotManifestFile = "manifest.json"
+
+// The hash of an IAVL tree depends not only on the stored key/value pairs,
+// but also on the shape of the tree and the version of every node. To be
+// able to rebuild a tree with exactly the same root hash, a snapshot
+// contains all tree nodes, as stored by the IAVL library.
+var (
+	nodeKeyFormat = iavl.NewKeyFormat('n', tmhash.Size) // n<hash>
+	rootKeyFormat = iavl.NewKeyFormat('r', 8)           // r<version>
+)
+
+// SnapshotManifest describes the content of a snapshot directory.
+type SnapshotManifest struct {
+	Format int `json:"format"`
+	// Version is the tree version that the snapshot was created from.
+	Version int64 `json:"version"`
+	// Hash is the root hash of the tree. It is empty for an empty tree.
+	Hash []byte `json:"hash"`
+	// Chunks lists all chunk files in the order they must be imported.
+	Chunks []SnapshotChunk `json:"chunks"`
+}
+
+// SnapshotChunk describes a single chunk file of a snapshot.
+type SnapshotChunk struct {
+	// File is the name of the chunk file, relative to the snapshot
+	// directory.
+	File string `json:"file"`
+	// Hash is the SHA256 checksum of the chunk file content.
+	Hash []byte `json:"hash"`
+	// Nodes is the number of tree nodes stored in the chunk.
+	Nodes int64 `json:"nodes"`
+}
+
+// ExportSnapshot writes all nodes of the tree of the given version, as
+// stored in the given database, into the dir directory. Nodes are written in
+// the post order into chunk files that are no bigger than chunkSize bytes
+// (unless a single node is bigger). Each node is stored as its uvarint
+// encoded length followed by the node serialized by the IAVL library.
+//
+// Returned manifest is also written into the dir directory.
+func ExportSnapshot(db dbm.DB, version int64, dir string, chunkSize int) (*SnapshotManifest, error) {
+	root := db.Get(rootKeyFormat.Key(version))
+	if root == nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "version %d", version)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+
+	w := &chunkWriter{dir: dir, chunkSize: chunkSize}
+	if len(root) != 0 {
+		if err := exportNode(db, root, w); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.flush(); err != nil {
+		return nil, err
+	}
+
+	manifest := &SnapshotManifest{
+		Format:  SnapshotFormat,
+		Version: version,
+		Hash:    root,
+		Chunks:  w.chunks,
+	}
+	raw, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrState, err.Error())
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, SnapshotManifestFile), raw, 0644); err != nil {
+		return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return manifest, nil
+}
+
+// exportNode writes the node with given hash and all its descendants.
+func exportNode(db dbm.DB, hash []byte, w *chunkWriter) error {
+	raw := db.Get(nodeKeyFormat.Key(hash))
+	if raw == nil {
+		return errors.Wrapf(errors.ErrNotFound, "node %X", hash)
+	}
+	n, err := decodeNode(raw)
+	if err != nil {
+		return errors.Wrapf(err, "node %X", hash)
+	}
+	if !n.isLeaf() {
+		if err := exportNode(db, n.leftHash, w); err != nil {
+			return err
+		}
+		if err := exportNode(db, n.rightHash, w); err != nil {
+			return err
+		}
+	}
+	return w.write(raw)
+}
+
+// chunkWriter writes nodes into consecutive chunk files.
+type chunkWriter struct {
+	dir       string
+	chunkSize int
+	buf       bytes.Buffer
+	nodes     int64
+	chunks    []SnapshotChunk
+}
+
+func (w *chunkWriter) write(raw []byte) error {
+	var size [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(size[:], uint64(len(raw)))
+	if w.buf.Len() > 0 && w.buf.Len()+n+len(raw) > w.chunkSize {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	w.buf.Write(size[:n])
+	w.buf.Write(raw)
+	w.nodes++
+	return nil
+}
+
+// flush writes all buffered nodes into a new chunk file.
+func (w *chunkWriter) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	name := fmt.Sprintf("chunk-%06d.bin", len(w.chunks))
+	if err := ioutil.WriteFile(filepath.Join(w.dir, name), w.buf.Bytes(), 0644); err != nil {
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	sum := sha256.Sum256(w.buf.Bytes())
+	w.chunks = append(w.chunks, SnapshotChunk{File: name, Hash: sum[:], Nodes: w.nodes})
+	w.buf.Reset()
+	w.nodes = 0
+	return nil
+}
+
+// ReadSnapshotManifest loads the manifest of the snapshot stored in the dir
+// directory.
+func ReadSnapshotManifest(dir string) (*SnapshotManifest, error) {
+	raw, err := ioutil.ReadFile(filepath.Join(dir, SnapshotManifestFile))
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrNotFound, err.Error())
+	}
+	var manifest SnapshotManifest
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot decode manifest: %s", err)
+	}
+	if manifest.Format != SnapshotFormat {
+		return nil, errors.Wrapf(errors.ErrInput, "unsupported snapshot format %d", manifest.Format)
+	}
+	if manifest.Version < 1 {
+		return nil, errors.Wrapf(errors.ErrInput, "invalid version %d", manifest.Version)
+	}
+	return &manifest, nil
+}
+
+// ImportSnapshot rebuilds the tree stored in the snapshot dir directory in
+// the given database, which must not contain any tree yet. The checksum of
+// every chunk and the hash of every node is verified. The root hash of the
+// rebuilt tree must be equal to the given trusted hash, which is the
+// application hash of the snapshot version obtained from a trusted source
+// (for example a block header). The hash declared by the manifest is not
+// trusted, because it is part of the snapshot. After a successful import,
+// the database can be loaded by a CommitStore and the next committed version
+// is the one following the snapshot version.
+func ImportSnapshot(dir string, db dbm.DB, trustedHash []byte) (*SnapshotManifest, error) {
+	if len(trustedHash) == 0 {
+		return nil, errors.Wrap(errors.ErrInput, "trusted hash is required")
+	}
+	manifest, err := ReadSnapshotManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(manifest.Hash, trustedHash) {
+		return nil, errors.Wrapf(errors.ErrInput, "snapshot hash %X is not trusted", manifest.Hash)
+	}
+
+	it := dbm.IteratePrefix(db, rootKeyFormat.Key())
+	empty := !it.Valid()
+	it.Close()
+	if !empty {
+		return nil, errors.Wrap(errors.ErrState, "database already contains a tree")
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	// Nodes are stored in the post order, so the hashes of both children
+	// are always computed before their parent is read.
+	var stack [][]byte
+	for _, c := range manifest.Chunks {
+		raw, err := ioutil.ReadFile(filepath.Join(dir, filepath.Base(c.File)))
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrNotFound, err.Error())
+		}
+		if sum := sha256.Sum256(raw); !bytes.Equal(sum[:], c.Hash) {
+			return nil, errors.Wrapf(errors.ErrInput, "chunk %s: checksum mismatch", c.File)
+		}
+
+		r := bytes.NewReader(raw)
+		var nodes int64
+		for {
+			size, err := binary.ReadUvarint(r)
+			if err == io.EOF {
+				break
+			}
+			if err != nil || size > uint64(len(raw)) {
+				return nil, errors.Wrapf(errors.ErrInput, "chunk %s: malformed", c.File)
+			}
+			nodeRaw := make([]byte, size)
+			if _, err := io.ReadFull(r, nodeRaw); err != nil {
+				return nil, errors.Wrapf(errors.ErrInput, "chunk %s: malformed", c.File)
+			}
+			n, err := decodeNode(nodeRaw)
+			if err != nil {
+				return nil, errors.Wrapf(err, "chunk %s", c.File)
+			}
+			if !n.isLeaf() {
+				if len(stack) < 2 {
+					return nil, errors.Wrapf(errors.ErrInput, "chunk %s: missing child node", c.File)
+				}
+				left, right := stack[len(stack)-2], stack[len(stack)-1]
+				if !bytes.Equal(left, n.leftHash) || !bytes.Equal(right, n.rightHash) {
+					return nil, errors.Wrapf(errors.ErrInput, "chunk %s: child hash mismatch", c.File)
+				}
+				stack = stack[:len(stack)-2]
+			}
+			hash := n.hash()
+			batch.Set(nodeKeyFormat.Key(hash), nodeRaw)
+			stack = append(stack, hash)
+			nodes++
+		}
+		if nodes != c.Nodes {
+			return nil, errors.Wrapf(errors.ErrInput, "chunk %s: expected %d nodes, got %d", c.File, c.Nodes, nodes)
+		}
+	}
+
+	var root []byte
+	switch len(stack) {
+	case 0:
+		root = []byte{}
+	case 1:
+		root = stack[0]
+	default:
+		return nil, errors.Wrapf(errors.ErrInput, "%d root nodes", len(stack))
+	}
+	if !bytes.Equal(root, trustedHash) {
+		return nil, errors.Wrap(errors.ErrInput, "root hash mismatch")
+	}
+	batch.Set(rootKeyFormat.Key(manifest.Version), root)
+	batch.WriteSync()
+	return manifest, nil
+}
+
+// node is a tree node as serialized by the IAVL library.
+type node struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+func (n *node) isLeaf() bool {
+	return n.height == 0
+}
+
+// decodeNode deserializes a node, the same way the IAVL library does.
+func decodeNode(buf []byte) (*node, error) {
+	var n node
+	var c int
+	var err error
+	if n.height, c, err = amino.DecodeInt8(buf); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot decode height: %s", err)
+	}
+	buf = buf[c:]
+	if n.size, c, err = amino.DecodeVarint(buf); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot decode size: %s", err)
+	}
+	buf = buf[c:]
+	if n.version, c, err = amino.DecodeVarint(buf); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot decode version: %s", err)
+	}
+	buf = buf[c:]
+	if n.key, c, err = amino.DecodeByteSlice(buf); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot decode key: %s", err)
+	}
+	buf = buf[c:]
+
+	if n.isLeaf() {
+		if n.value, _, err = amino.DecodeByteSlice(buf); err != nil {
+			return nil, errors.Wrapf(errors.ErrInput, "cannot decode value: %s", err)
+		}
+		return &n, nil
+	}
+	if n.leftHash, c, err = amino.DecodeByteSlice(buf); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot decode left hash: %s", err)
+	}
+	buf = buf[c:]
+	if n.rightHash, _, err = amino.DecodeByteSlice(buf); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot decode right hash: %s", err)
+	}
+	return &n, nil
+}
+
+// hash computes the node hash, the same way the IAVL library does.
+func (n *node) hash() []byte {
+	var buf bytes.Buffer
+	// Writing to a buffer never fails.
+	_ = amino.EncodeInt8(&buf, n.height)
+	_ = amino.EncodeVarint(&buf, n.size)
+	_ = amino.EncodeVarint(&buf, n.version)
+	if n.isLeaf() {
+		_ = amino.EncodeByteSlice(&buf, n.key)
+		_ = amino.EncodeByteSlice(&buf, tmhash.Sum(n.value))
+	} else {
+		_ = amino.EncodeByteSlice(&buf, n.leftHash)
+		_ = amino.EncodeByteSlice(&buf, n.rightHash)
+	}
+	return tmhash.Sum(buf.Bytes())
+}
@@ -0,0 +1,155 @@
+package iavl
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestSnapshotExportImport(t *testing.T) {
+	db := dbm.NewMemDB()
+	commit := NewCommitStoreFromTree(iavl.NewMutableTree(db, DefaultCacheSize), WithPruning(PruneNothing))
+	for v := 0; v < 5; v++ {
+		for i := 0; i < 50; i++ {
+			key := []byte(fmt.Sprintf("key-%d", (v*7+i)%80))
+			assert.Nil(t, commit.Adapter().Set(key, []byte(fmt.Sprintf("value-%d-%d", v, i))))
+		}
+		_, err := commit.Commit()
+		assert.Nil(t, err)
+	}
+
+	cases := map[string]struct {
+		version   int64
+		chunkSize int
+	}{
+		"latest version in a single chunk": {
+			version:   5,
+			chunkSize: DefaultSnapshotChunkSize,
+		},
+		"old version in many chunks": {
+			version:   3,
+			chunkSize: 256,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "snapshot")
+			assert.Nil(t, err)
+			defer os.RemoveAll(dir)
+
+			manifest, err := ExportSnapshot(db, tc.version, dir, tc.chunkSize)
+			assert.Nil(t, err)
+			want, err := commit.tree.GetImmutable(tc.version)
+			assert.Nil(t, err)
+			assert.Equal(t, want.Hash(), manifest.Hash)
+			if tc.chunkSize < DefaultSnapshotChunkSize && len(manifest.Chunks) < 2 {
+				t.Fatalf("want many chunks, got %d", len(manifest.Chunks))
+			}
+
+			target := dbm.NewMemDB()
+			imported, err := ImportSnapshot(dir, target, want.Hash())
+			assert.Nil(t, err)
+			assert.Equal(t, manifest, imported)
+
+			restored := NewCommitStoreFromTree(iavl.NewMutableTree(target, DefaultCacheSize))
+			assert.Nil(t, restored.LoadLatestVersion())
+			id, err := restored.LatestVersion()
+			assert.Nil(t, err)
+			assert.Equal(t, tc.version, id.Version)
+			assert.Equal(t, manifest.Hash, id.Hash)
+
+			want.Iterate(func(key, value []byte) bool {
+				got, err := restored.Get(key)
+				assert.Nil(t, err)
+				assert.Equal(t, value, got)
+				return false
+			})
+
+			// Import into a non empty database is not allowed.
+			if _, err := ImportSnapshot(dir, target, want.Hash()); !errors.ErrState.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			// Restored tree must be usable for creating new versions.
+			assert.Nil(t, restored.Adapter().Set([]byte("new"), []byte("value")))
+			id, err = restored.Commit()
+			assert.Nil(t, err)
+			assert.Equal(t, tc.version+1, id.Version)
+		})
+	}
+}
+
+func TestSnapshotImportTampered(t *testing.T) {
+	db := dbm.NewMemDB()
+	commit := NewCommitStoreFromTree(iavl.NewMutableTree(db, DefaultCacheSize))
+	for i := 0; i < 20; i++ {
+		assert.Nil(t, commit.Adapter().Set([]byte(fmt.Sprint(i)), []byte("value")))
+	}
+	_, err := commit.Commit()
+	assert.Nil(t, err)
+
+	dir, err := ioutil.TempDir("", "snapshot")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	manifest, err := ExportSnapshot(db, 1, dir, DefaultSnapshotChunkSize)
+	assert.Nil(t, err)
+
+	if _, err := ExportSnapshot(db, 2, dir, DefaultSnapshotChunkSize); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	// Modify a leaf value.
+	chunkPath := filepath.Join(dir, manifest.Chunks[0].File)
+	raw, err := ioutil.ReadFile(chunkPath)
+	assert.Nil(t, err)
+	raw[bytes.Index(raw, []byte("value"))] = 'V'
+	assert.Nil(t, ioutil.WriteFile(chunkPath, raw, 0644))
+	if _, err := ImportSnapshot(dir, dbm.NewMemDB(), manifest.Hash); !errors.ErrInput.Is(err) {
+		t.Fatalf("want checksum error, got %+v", err)
+	}
+
+	// Update the chunk checksum as well, so that the node hash check
+	// must fail.
+	sum := sha256.Sum256(raw)
+	manifest.Chunks[0].Hash = sum[:]
+	rawManifest, err := json.Marshal(manifest)
+	assert.Nil(t, err)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, SnapshotManifestFile), rawManifest, 0644))
+	if _, err := ImportSnapshot(dir, dbm.NewMemDB(), manifest.Hash); !errors.ErrInput.Is(err) {
+		t.Fatalf("want hash error, got %+v", err)
+	}
+
+	// A consistent snapshot of a different state verifies against its own
+	// manifest, but not against the trusted hash.
+	other := dbm.NewMemDB()
+	otherCommit := NewCommitStoreFromTree(iavl.NewMutableTree(other, DefaultCacheSize))
+	assert.Nil(t, otherCommit.Adapter().Set([]byte("forged"), []byte("value")))
+	_, err = otherCommit.Commit()
+	assert.Nil(t, err)
+	otherDir, err := ioutil.TempDir("", "snapshot")
+	assert.Nil(t, err)
+	defer os.RemoveAll(otherDir)
+	otherManifest, err := ExportSnapshot(other, 1, otherDir, DefaultSnapshotChunkSize)
+	assert.Nil(t, err)
+	if _, err := ImportSnapshot(otherDir, dbm.NewMemDB(), otherManifest.Hash); err != nil {
+		t.Fatalf("cannot import a valid snapshot: %+v", err)
+	}
+	if _, err := ImportSnapshot(otherDir, dbm.NewMemDB(), manifest.Hash); !errors.ErrInput.Is(err) {
+		t.Fatalf("want untrusted hash error, got %+v", err)
+	}
+	if _, err := ImportSnapshot(otherDir, dbm.NewMemDB(), nil); !errors.ErrInput.Is(err) {
+		t.Fatalf("want missing hash error, got %+v", err)
+	}
+}
@@ -29,6 +29,12 @@ type KVCacheWrap = weave.KVCacheWrap
 // CommitKVStore is an alias to interface in root package
 type CommitKVStore = weave.CommitKVStore
 
+// ProvableKVStore is an alias to interface in root package
+type ProvableKVStore = weave.ProvableKVStore
+
+// HistoricalKVStore is an alias to interface in root package
+type HistoricalKVStore = weave.HistoricalKVStore
+
 // CommitID is an alias to interface in root package
 type CommitID = weave.CommitID
 
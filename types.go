@@ -16,6 +16,10 @@ const (
 // with a custom one at any moment.
 type CommitInfo = abci.LastCommitInfo
 
+// Evidence is a type alias for now, which allows us to override this type
+// with a custom one at any moment.
+type Evidence = abci.Evidence
+
 // ValidatorUpdatesToABCI converts weave validator updates to abci representation.
 func ValidatorUpdatesToABCI(updates ValidatorUpdates) []abci.ValidatorUpdate {
 	res := make([]abci.ValidatorUpdate, len(updates.ValidatorUpdates))
@@ -131,7 +135,7 @@ func StoreValidatorUpdates(store KVStore, vu ValidatorUpdates) error {
 	return errors.Wrap(err, "kvstore save")
 }
 
-func GetValidatorUpdates(store KVStore) (ValidatorUpdates, error) {
+func GetValidatorUpdates(store ReadOnlyKVStore) (ValidatorUpdates, error) {
 	vu := ValidatorUpdates{}
 	b, err := store.Get([]byte(storeKey))
 	if err != nil {
@@ -37,14 +37,26 @@ const (
 	ExecCheckAndDeliver = ExecCheck | ExecDeliver
 )
 
+// genesisTime is the time of the genesis used by the WeaveRunner. Each block
+// is created one second after the previous one, so that block time is always
+// the same for given height and tests are deterministic.
+var genesisTime = time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// BlockTime returns the time of the block at given height created by the
+// WeaveRunner. Height zero is the genesis.
+func BlockTime(height int64) time.Time {
+	return genesisTime.Add(time.Duration(height) * time.Second)
+}
+
 // WeaveRunner provides a translation layer between an ABCI interface and a
 // weave application. It takes care of serializing messages and creating
 // blocks.
 type WeaveRunner struct {
-	chainID string
-	height  int64
-	t       testing.TB
-	app     abci.Application
+	chainID  string
+	height   int64
+	t        testing.TB
+	app      abci.Application
+	endBlock abci.ResponseEndBlock
 }
 
 // NewWeaveRunner creates a WeaveRunner instance that can be used to process
@@ -81,7 +93,7 @@ func (w *WeaveRunner) InitChain(genesis interface{}) {
 		w.t.Fatalf("cannot initialize after a block, height=%d", lastHeight)
 	}
 	w.app.InitChain(abci.RequestInitChain{
-		Time:          time.Now(),
+		Time:          BlockTime(0),
 		ChainId:       w.chainID,
 		AppStateBytes: raw,
 	})
@@ -134,6 +146,7 @@ func (w *WeaveRunner) InBlock(executeTx func(WeaveApp) error) bool {
 		Header: abci.Header{
 			ChainID: w.chainID,
 			Height:  w.height,
+			Time:    BlockTime(w.height),
 		},
 	})
 
@@ -141,9 +154,9 @@ func (w *WeaveRunner) InBlock(executeTx func(WeaveApp) error) bool {
 		w.t.Fatalf("operation failed with %+v", err)
 	}
 
-	// EndBlock returns Validator diffs mainly,
-	// but not important for benchmarks just tests
-	w.app.EndBlock(abci.RequestEndBlock{
+	// EndBlock returns validator diffs and tags. They are not important
+	// for benchmarks, but can be inspected by tests using LastEndBlock.
+	w.endBlock = w.app.EndBlock(abci.RequestEndBlock{
 		Height: w.height,
 	})
 
@@ -153,6 +166,13 @@ func (w *WeaveRunner) InBlock(executeTx func(WeaveApp) error) bool {
 	return !bytes.Equal(initialHash, finalHash)
 }
 
+// LastEndBlock returns the response of the EndBlock call made by the most
+// recently processed block. It contains all tags and validator updates
+// produced by the end blockers of that block.
+func (w *WeaveRunner) LastEndBlock() abci.ResponseEndBlock {
+	return w.endBlock
+}
+
 // ProcessAllTxs will run all included txs, split into blocksize.
 // It will Fail() if any tx returns an error, or if at any block,
 // the appHash does not change (if should change, otherwise, require it stable)
@@ -0,0 +1,34 @@
+package weavetest
+
+import (
+	"github.com/iov-one/weave"
+)
+
+// EndBlocker implements a mock of weave.EndBlocker
+//
+// Use this end blocker in your tests. Set Result to control what EndBlock
+// method call returns. Each method call is counted.
+type EndBlocker struct {
+	call int
+	// Result is returned by EndBlock method.
+	Result weave.TickResult
+	// Exec if set is called by EndBlock method before returning the
+	// result. Use it to modify the state at the end of the block.
+	Exec func(weave.Context, weave.CacheableKVStore)
+}
+
+var _ weave.EndBlocker = (*EndBlocker)(nil)
+
+// EndBlock implements weave.EndBlocker interface.
+func (e *EndBlocker) EndBlock(ctx weave.Context, store weave.CacheableKVStore) weave.TickResult {
+	e.call++
+	if e.Exec != nil {
+		e.Exec(ctx, store)
+	}
+	return e.Result
+}
+
+// CallCount returns how many times EndBlock method was called.
+func (e *EndBlocker) CallCount() int {
+	return e.call
+}
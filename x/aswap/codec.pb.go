@@ -47,6 +47,9 @@ type Swap struct {
 	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,8,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Return task ID holds the ID of the asynchronous task that is scheduled to
+	// return all coins to the source once the swap expires.
+	ReturnTaskID []byte `protobuf:"bytes,9,opt,name=return_task_id,json=returnTaskId,proto3" json:"return_task_id,omitempty"`
 }
 
 func (m *Swap) Reset()         { *m = Swap{} }
@@ -131,6 +134,13 @@ func (m *Swap) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Swap) GetReturnTaskID() []byte {
+	if m != nil {
+		return m.ReturnTaskID
+	}
+	return nil
+}
+
 // CreateMsg creates a Swap with some coins.
 type CreateMsg struct {
 	Metadata *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -358,35 +368,37 @@ func init() {
 func init() { proto.RegisterFile("x/aswap/codec.proto", fileDescriptor_ad79b700d8686a3f) }
 
 var fileDescriptor_ad79b700d8686a3f = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xb3, 0xf9, 0x70, 0x92, 0x49, 0x11, 0x68, 0xe1, 0xb0, 0xca, 0xc1, 0x31, 0x2e, 0x48,
-	0x96, 0x10, 0xb6, 0x54, 0xae, 0x08, 0x44, 0x8a, 0x10, 0x3d, 0xf4, 0xb2, 0xc0, 0x11, 0x55, 0x5b,
-	0x7b, 0xe4, 0xac, 0xc4, 0xee, 0x46, 0xde, 0x75, 0x53, 0xf1, 0x14, 0x3c, 0x16, 0xc7, 0x1e, 0x39,
-	0xa0, 0x08, 0x25, 0x8f, 0xc0, 0xad, 0x27, 0xe4, 0xc4, 0x29, 0x11, 0x1f, 0x87, 0x54, 0xca, 0x6d,
-	0xfc, 0x9f, 0xf9, 0xcf, 0x58, 0xbf, 0xd9, 0x81, 0xfb, 0x97, 0x89, 0xb0, 0x33, 0x31, 0x4d, 0x52,
-	0x93, 0x61, 0x1a, 0x4f, 0x0b, 0xe3, 0x0c, 0xed, 0xac, 0xa4, 0xe1, 0x60, 0x4b, 0x1b, 0xde, 0x4b,
-	0x8d, 0xd4, 0xdb, 0x55, 0xc3, 0x07, 0xb9, 0xc9, 0xcd, 0x2a, 0x4c, 0xaa, 0x68, 0xad, 0x86, 0x3f,
-	0x9b, 0xd0, 0x7e, 0x37, 0x13, 0x53, 0xfa, 0x04, 0x7a, 0x0a, 0x9d, 0xc8, 0x84, 0x13, 0x8c, 0x04,
-	0x24, 0x1a, 0x1c, 0xdd, 0x8d, 0x67, 0x28, 0x2e, 0x30, 0x3e, 0xad, 0x65, 0x7e, 0x53, 0x40, 0x0f,
-	0xe1, 0xce, 0xb4, 0x40, 0xa9, 0x44, 0x8e, 0x67, 0x13, 0x61, 0x27, 0xac, 0x19, 0x90, 0xe8, 0x80,
-	0x1f, 0x6c, 0xc4, 0xb7, 0xc2, 0x4e, 0xe8, 0x73, 0xf0, 0xac, 0x29, 0x8b, 0x14, 0x59, 0xab, 0xca,
-	0x8e, 0x1f, 0x5d, 0xcf, 0x47, 0x41, 0x2e, 0xdd, 0xa4, 0x3c, 0x8f, 0x53, 0xa3, 0x12, 0x69, 0x2e,
-	0x9e, 0x1a, 0x8d, 0xc9, 0x7a, 0xca, 0xab, 0x2c, 0x2b, 0xd0, 0x5a, 0x5e, 0x7b, 0xe8, 0x1b, 0x18,
-	0x64, 0x68, 0x9d, 0xd4, 0xc2, 0x49, 0xa3, 0x59, 0x67, 0x87, 0x16, 0xdb, 0x46, 0xfa, 0x12, 0xba,
-	0x4e, 0x2a, 0x34, 0xa5, 0x63, 0x5e, 0x40, 0xa2, 0xd6, 0xf8, 0xf1, 0xf5, 0x7c, 0xf4, 0xf0, 0xbf,
-	0x3d, 0x3e, 0x68, 0x79, 0xf9, 0x5e, 0x2a, 0xe4, 0x1b, 0x17, 0xa5, 0xd0, 0x56, 0xa8, 0x0c, 0xeb,
-	0x06, 0x24, 0xea, 0xf3, 0x55, 0x4c, 0x5f, 0x40, 0x57, 0xac, 0x87, 0xb1, 0xde, 0x0e, 0x3f, 0xb6,
-	0x31, 0x85, 0xdf, 0x9b, 0xd0, 0x3f, 0x2e, 0x50, 0x38, 0x3c, 0xb5, 0xf9, 0x6e, 0xe8, 0x7f, 0x53,
-	0x6d, 0xde, 0x82, 0xea, 0x5f, 0x8b, 0x6b, 0xfd, 0x63, 0x71, 0x7f, 0xa0, 0x6f, 0xdf, 0x16, 0x7d,
-	0x08, 0x9e, 0x50, 0xa6, 0xd4, 0x8e, 0x75, 0x82, 0x56, 0x34, 0x38, 0x82, 0xb8, 0x7a, 0x94, 0xf1,
-	0xb1, 0x91, 0x9a, 0xd7, 0x99, 0xbd, 0xac, 0x27, 0xfc, 0x0c, 0xc0, 0xf1, 0x13, 0x0a, 0xbb, 0x3b,
-	0xde, 0x43, 0xe8, 0x56, 0xc7, 0x74, 0x26, 0xb3, 0x9a, 0x2f, 0x2c, 0xe6, 0x23, 0xaf, 0xba, 0x90,
-	0x93, 0xd7, 0xdc, 0xab, 0x52, 0x27, 0x19, 0x1d, 0x42, 0x6f, 0x03, 0xac, 0x06, 0x78, 0xf3, 0x1d,
-	0x7e, 0x84, 0x3e, 0x47, 0x57, 0x16, 0x7a, 0x2f, 0xa3, 0xc7, 0xec, 0xeb, 0xc2, 0x27, 0x57, 0x0b,
-	0x9f, 0xfc, 0x58, 0xf8, 0xe4, 0xcb, 0xd2, 0x6f, 0x5c, 0x2d, 0xfd, 0xc6, 0xb7, 0xa5, 0xdf, 0x38,
-	0xf7, 0x56, 0x07, 0xfd, 0xec, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x9e, 0x36, 0x8b, 0x23,
-	0x04, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x38, 0x71, 0x92, 0x49, 0x80, 0x6a, 0xe1, 0xb0, 0xca, 0xc1, 0x31, 0x2e, 0x48,
+	0x96, 0x10, 0xb6, 0x54, 0x24, 0x4e, 0x08, 0x44, 0x5a, 0x21, 0x72, 0xe8, 0x65, 0x29, 0x47, 0x14,
+	0x6d, 0xed, 0x91, 0xb3, 0x2a, 0xf6, 0x46, 0xde, 0x75, 0x53, 0xf1, 0x14, 0xbc, 0x07, 0x2f, 0xc2,
+	0xb1, 0x47, 0x0e, 0x28, 0x42, 0xce, 0x5b, 0xf4, 0x84, 0x6c, 0x27, 0x6d, 0xc4, 0x9f, 0x43, 0x2a,
+	0xf5, 0x36, 0xfb, 0xcd, 0xce, 0x8c, 0xf5, 0xfd, 0x3c, 0x0b, 0x0f, 0x2f, 0x02, 0xae, 0x16, 0x7c,
+	0x1e, 0x84, 0x32, 0xc2, 0xd0, 0x9f, 0x67, 0x52, 0x4b, 0xd2, 0xae, 0xa4, 0x61, 0x7f, 0x4b, 0x1b,
+	0xee, 0x85, 0x52, 0xa4, 0xdb, 0xb7, 0x86, 0x8f, 0x62, 0x19, 0xcb, 0x2a, 0x0c, 0xca, 0xa8, 0x56,
+	0xdd, 0x6f, 0x26, 0xb4, 0x3e, 0x2c, 0xf8, 0x9c, 0x3c, 0x83, 0x6e, 0x82, 0x9a, 0x47, 0x5c, 0x73,
+	0x6a, 0x38, 0x86, 0xd7, 0x3f, 0x78, 0xe0, 0x2f, 0x90, 0x9f, 0xa3, 0x7f, 0xbc, 0x96, 0xd9, 0xf5,
+	0x05, 0xb2, 0x0f, 0xf7, 0xe6, 0x19, 0x8a, 0x84, 0xc7, 0x38, 0x9d, 0x71, 0x35, 0xa3, 0x4d, 0xc7,
+	0xf0, 0x06, 0x6c, 0xb0, 0x11, 0xdf, 0x73, 0x35, 0x23, 0xaf, 0xc0, 0x52, 0x32, 0xcf, 0x42, 0xa4,
+	0x66, 0x99, 0x1d, 0x3f, 0xb9, 0x5a, 0x8e, 0x9c, 0x58, 0xe8, 0x59, 0x7e, 0xea, 0x87, 0x32, 0x09,
+	0x84, 0x3c, 0x7f, 0x2e, 0x53, 0x0c, 0xea, 0x29, 0x6f, 0xa3, 0x28, 0x43, 0xa5, 0xd8, 0xba, 0x86,
+	0xbc, 0x83, 0x7e, 0x84, 0x4a, 0x8b, 0x94, 0x6b, 0x21, 0x53, 0xda, 0xde, 0xa1, 0xc5, 0x76, 0x21,
+	0x79, 0x03, 0x1d, 0x2d, 0x12, 0x94, 0xb9, 0xa6, 0x96, 0x63, 0x78, 0xe6, 0xf8, 0xe9, 0xd5, 0x72,
+	0xf4, 0xf8, 0xbf, 0x3d, 0x3e, 0xa6, 0xe2, 0xe2, 0x44, 0x24, 0xc8, 0x36, 0x55, 0x84, 0x40, 0x2b,
+	0xc1, 0x44, 0xd2, 0x8e, 0x63, 0x78, 0x3d, 0x56, 0xc5, 0xe4, 0x35, 0x74, 0x78, 0x3d, 0x8c, 0x76,
+	0x77, 0xf8, 0xb0, 0x4d, 0x11, 0x79, 0x09, 0xf7, 0x33, 0xd4, 0x79, 0x96, 0x4e, 0x35, 0x57, 0x67,
+	0x53, 0x11, 0xd1, 0x5e, 0xd5, 0x66, 0xaf, 0x58, 0x8e, 0x06, 0xac, 0xca, 0x9c, 0x70, 0x75, 0x36,
+	0x39, 0x62, 0x83, 0xec, 0xe6, 0x14, 0xb9, 0x3f, 0x9b, 0xd0, 0x3b, 0xcc, 0x90, 0x6b, 0x3c, 0x56,
+	0xf1, 0x6e, 0xc8, 0x6e, 0x68, 0x34, 0x6f, 0x41, 0xe3, 0x2f, 0xe0, 0xe6, 0x3f, 0x80, 0xff, 0x81,
+	0xac, 0x75, 0x5b, 0x64, 0x2e, 0x58, 0x3c, 0x91, 0x79, 0xaa, 0x69, 0xdb, 0x31, 0xbd, 0xfe, 0x01,
+	0xf8, 0xe5, 0xcf, 0xec, 0x1f, 0x4a, 0x91, 0xb2, 0x75, 0xe6, 0x4e, 0xb0, 0xba, 0x5f, 0x00, 0x18,
+	0x7e, 0x46, 0xae, 0x76, 0xb7, 0x77, 0x1f, 0x3a, 0xe5, 0x12, 0x96, 0x28, 0x6b, 0x7f, 0xa1, 0x58,
+	0x8e, 0xac, 0x72, 0xb3, 0x26, 0x47, 0xcc, 0x2a, 0x53, 0x93, 0x88, 0x0c, 0xa1, 0xbb, 0x31, 0x6c,
+	0x6d, 0xe0, 0xf5, 0xd9, 0xfd, 0x04, 0xbd, 0x1a, 0xfc, 0x9d, 0x8c, 0x1e, 0xd3, 0xef, 0x85, 0x6d,
+	0x5c, 0x16, 0xb6, 0xf1, 0xab, 0xb0, 0x8d, 0xaf, 0x2b, 0xbb, 0x71, 0xb9, 0xb2, 0x1b, 0x3f, 0x56,
+	0x76, 0xe3, 0xd4, 0xaa, 0x1e, 0x82, 0x17, 0xbf, 0x07, 0x00, 0xcc, 0x8c, 0xcf, 0x4a, 0x5b, 0x04,
+	0x00, 0x00,
 }
 
 func (m *Swap) Marshal() (dAtA []byte, err error) {
@@ -449,6 +461,12 @@ func (m *Swap) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
 		i += copy(dAtA[i:], m.Address)
 	}
+	if len(m.ReturnTaskID) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ReturnTaskID)))
+		i += copy(dAtA[i:], m.ReturnTaskID)
+	}
 	return i, nil
 }
 
@@ -637,6 +655,10 @@ func (m *Swap) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	l = len(m.ReturnTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -981,6 +1003,40 @@ func (m *Swap) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnTaskID = append(m.ReturnTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnTaskID == nil {
+				m.ReturnTaskID = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
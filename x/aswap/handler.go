@@ -20,14 +20,17 @@ const (
 )
 
 // RegisterRoutes will instantiate and register
-// all handlers in this package
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller) {
+// all handlers in this package.
+//
+// Scheduler is used to return the coins to the source once a swap expires.
+// Scheduled ReturnMsg is executed without any authentication.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller, scheduler weave.Scheduler) {
 	r = migration.SchemaMigratingRegistry("aswap", r)
 	bucket := NewBucket()
 
-	r.Handle(&CreateMsg{}, CreateSwapHandler{auth, bucket, cashctrl})
-	r.Handle(&ReleaseMsg{}, ReleaseSwapHandler{auth, bucket, cashctrl})
-	r.Handle(&ReturnMsg{}, ReturnSwapHandler{auth, bucket, cashctrl})
+	r.Handle(&CreateMsg{}, CreateSwapHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReleaseMsg{}, ReleaseSwapHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReturnMsg{}, ReturnSwapHandler{auth, bucket, cashctrl, scheduler})
 }
 
 // RegisterQuery will register this bucket as "/aswaps"
@@ -37,9 +40,10 @@ func RegisterQuery(qr weave.QueryRouter) {
 
 // CreateSwapHandler creates a swap
 type CreateSwapHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.CoinMover
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.CoinMover
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = CreateSwapHandler{}
@@ -68,6 +72,17 @@ func (h CreateSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot acquire key")
 	}
+
+	// Once expired, the coins can be returned to the source by anyone.
+	returnMsg := &ReturnMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		SwapID:   key,
+	}
+	taskID, err := h.scheduler.Schedule(db, msg.Timeout.Time(), nil, returnMsg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule return task")
+	}
+
 	swap := &Swap{
 		Metadata:     &weave.Metadata{Schema: 1},
 		Source:       msg.Source,
@@ -76,6 +91,7 @@ func (h CreateSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave
 		Memo:         msg.Memo,
 		PreimageHash: msg.PreimageHash,
 		Address:      swapAddr(key, msg.PreimageHash),
+		ReturnTaskID: taskID,
 	}
 	if _, err := h.bucket.Put(db, key, swap); err != nil {
 		return nil, errors.Wrap(err, "cannot save swap entity")
@@ -109,9 +125,10 @@ func (h CreateSwapHandler) validate(ctx weave.Context, db weave.KVStore, tx weav
 
 // ReleaseSwapHandler releases the amount to destination.
 type ReleaseSwapHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReleaseSwapHandler{}
@@ -149,6 +166,9 @@ func (h ReleaseSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weav
 	if err := h.bucket.Delete(db, swapID); err != nil {
 		return nil, err
 	}
+	if err := weave.DeleteScheduled(db, h.scheduler, swap.ReturnTaskID); err != nil {
+		return nil, err
+	}
 
 	return &weave.DeliverResult{}, nil
 }
@@ -180,9 +200,10 @@ func (h ReleaseSwapHandler) validate(ctx weave.Context, db weave.KVStore, tx wea
 
 // ReturnSwapHandler returns funds to the sender when swap timed out.
 type ReturnSwapHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReturnSwapHandler{}
@@ -218,6 +239,9 @@ func (h ReturnSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave
 	if err := h.bucket.Delete(db, msg.SwapID); err != nil {
 		return nil, err
 	}
+	if err := weave.DeleteScheduled(db, h.scheduler, swap.ReturnTaskID); err != nil {
+		return nil, err
+	}
 
 	return &weave.DeliverResult{}, nil
 }
@@ -34,10 +34,11 @@ var (
 	r             = app.NewRouter()
 	authenticator = &weavetest.CtxAuth{Key: "auth"}
 	auth          = x.ChainAuth(authenticator)
+	scheduler     = &weavetest.Cron{}
 )
 
 func init() {
-	RegisterRoutes(r, auth, ctrl)
+	RegisterRoutes(r, auth, ctrl, scheduler)
 }
 
 func TestCreateHandler(t *testing.T) {
@@ -368,6 +369,73 @@ func TestReturnHandler(t *testing.T) {
 
 }
 
+func TestReturnTask(t *testing.T) {
+	cases := map[string]struct {
+		Msg  func(swapID []byte) weave.Msg
+		Time time.Time
+	}{
+		"released swap": {
+			Msg: func(swapID []byte) weave.Msg {
+				return &ReleaseMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					SwapID:   swapID,
+					Preimage: preimage,
+				}
+			},
+			Time: blockNow,
+		},
+		"returned swap": {
+			Msg: func(swapID []byte) weave.Msg {
+				return &ReturnMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					SwapID:   swapID,
+				}
+			},
+			Time: blockNow.Add(2 * time.Hour),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "aswap", "cash")
+			setBalance(t, db, alice.Address(), coin.Coins{&swapAmount})
+
+			cron := &weavetest.Cron{}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, ctrl, cron)
+
+			ctx := weave.WithBlockTime(context.Background(), blockNow)
+			createMsg := &CreateMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Source:       alice.Address(),
+				Destination:  bob.Address(),
+				PreimageHash: preimageHash,
+				Amount:       []*coin.Coin{&swapAmount},
+				Timeout:      weave.AsUnixTime(blockNow.Add(time.Hour)),
+			}
+			res, err := rt.Deliver(authenticator.SetConditions(ctx, alice), db, &weavetest.Tx{Msg: createMsg})
+			if err != nil {
+				t.Fatalf("cannot create swap: %s", err)
+			}
+
+			var swap Swap
+			assert.Nil(t, bucket.One(db, res.Data, &swap))
+			if len(swap.ReturnTaskID) == 0 {
+				t.Fatal("return task not scheduled")
+			}
+
+			ctx = weave.WithBlockTime(context.Background(), tc.Time)
+			if _, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: tc.Msg(res.Data)}); err != nil {
+				t.Fatalf("cannot deliver: %s", err)
+			}
+			if err := cron.Delete(db, swap.ReturnTaskID); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("return task must be deleted: %s", err)
+			}
+		})
+	}
+}
+
 func setBalance(t testing.TB, db weave.KVStore, addr weave.Address, coins coin.Coins) {
 	t.Helper()
 
@@ -0,0 +1,120 @@
+package aswap
+
+import (
+	"encoding/binary"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file.
+type Initializer struct {
+	// Scheduler, if set, is used to schedule the return of coins for
+	// swaps loaded without a return task.
+	Scheduler weave.Scheduler
+}
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisSwap struct {
+	ID           uint64         `json:"id"`
+	PreimageHash []byte         `json:"preimage_hash"`
+	Source       weave.Address  `json:"source"`
+	Destination  weave.Address  `json:"destination"`
+	Timeout      weave.UnixTime `json:"timeout"`
+	Memo         string         `json:"memo,omitempty"`
+	// ReturnTaskID is the ID of the task that returns the coins once the
+	// swap expires. It must be loaded by the cron extension.
+	ReturnTaskID []byte `json:"return_task_id,omitempty"`
+}
+
+// FromGenesis loads all swaps, each stored under its ID. Funds held by swaps
+// are part of the cash extension state and are not issued. If a swap has no
+// return task and a scheduler is configured, the return of its coins is
+// scheduled at the swap timeout.
+func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	var swaps []genesisSwap
+	if err := opts.ReadOptions("aswap", &swaps); err != nil {
+		return err
+	}
+	bucket := NewBucket()
+	for n, g := range swaps {
+		if g.ID == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "swap %d: ID", n)
+		}
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, g.ID)
+		switch err := bucket.Has(kv, key); {
+		case err == nil:
+			return errors.Wrapf(errors.ErrDuplicate, "swap %d", n)
+		case !errors.ErrNotFound.Is(err):
+			return errors.Wrapf(err, "swap %d", n)
+		}
+		swap := Swap{
+			Metadata:     &weave.Metadata{Schema: 1},
+			PreimageHash: g.PreimageHash,
+			Source:       g.Source,
+			Destination:  g.Destination,
+			Timeout:      g.Timeout,
+			Memo:         g.Memo,
+			Address:      swapAddr(key, g.PreimageHash),
+			ReturnTaskID: g.ReturnTaskID,
+		}
+		if len(swap.ReturnTaskID) == 0 && i.Scheduler != nil {
+			returnMsg := &ReturnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				SwapID:   key,
+			}
+			taskID, err := i.Scheduler.Schedule(kv, swap.Timeout.Time(), nil, returnMsg)
+			if err != nil {
+				return errors.Wrapf(err, "swap %d: cannot schedule return task", n)
+			}
+			swap.ReturnTaskID = taskID
+		}
+		if _, err := bucket.Put(kv, key, &swap); err != nil {
+			return errors.Wrapf(err, "swap %d", n)
+		}
+		if err := swapSeq.Raise(kv, int64(g.ID)); err != nil {
+			return errors.Wrapf(err, "swap %d: sequence", n)
+		}
+	}
+	return nil
+}
+
+// ToGenesis exports all swaps, together with their IDs and return task IDs.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	swaps := []genesisSwap{}
+	it := orm.IterAll("swap")
+	for {
+		var s Swap
+		switch key, err := it.Next(kv, &s); {
+		case err == nil:
+			if err := orm.ValidateSequence(key); err != nil {
+				return nil, errors.Wrapf(err, "swap %x", key)
+			}
+			if !s.Address.Equals(swapAddr(key, s.PreimageHash)) {
+				return nil, errors.Wrapf(errors.ErrState, "swap %x address is not derived from its ID", key)
+			}
+			swaps = append(swaps, genesisSwap{
+				ID:           binary.BigEndian.Uint64(key),
+				PreimageHash: s.PreimageHash,
+				Source:       s.Source,
+				Destination:  s.Destination,
+				Timeout:      s.Timeout,
+				Memo:         s.Memo,
+				ReturnTaskID: s.ReturnTaskID,
+			})
+		case errors.ErrIteratorDone.Is(err):
+			opts := make(weave.Options)
+			if err := opts.WriteOptions("aswap", swaps); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "swap")
+		}
+	}
+}
@@ -180,6 +180,14 @@ type FeeInfo struct {
 	// field, as the signer order is not guaranteed.
 	Payer github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=payer,proto3,casttype=github.com/iov-one/weave.Address" json:"payer,omitempty"`
 	Fees  *coin.Coin                       `protobuf:"bytes,3,opt,name=fees,proto3" json:"fees,omitempty"`
+	// GasLimit is the maximum amount of gas that processing of the transaction
+	// can consume. Zero means no limit and is only allowed when no minimal gas
+	// price is configured.
+	GasLimit int64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// GasPrice is the price paid for a single unit of consumed gas. The whole
+	// gas limit is charged before the transaction is processed and the price of
+	// the gas that was not consumed is refunded afterwards.
+	GasPrice *coin.Coin `protobuf:"bytes,5,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
 }
 
 func (m *FeeInfo) Reset()         { *m = FeeInfo{} }
@@ -229,6 +237,20 @@ func (m *FeeInfo) GetFees() *coin.Coin {
 	return nil
 }
 
+func (m *FeeInfo) GetGasLimit() int64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *FeeInfo) GetGasPrice() *coin.Coin {
+	if m != nil {
+		return m.GasPrice
+	}
+	return nil
+}
+
 type Configuration struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// Owner is present to implement gconf.OwnedConfig interface
@@ -237,6 +259,9 @@ type Configuration struct {
 	Owner            github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
 	CollectorAddress github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=collector_address,json=collectorAddress,proto3,casttype=github.com/iov-one/weave.Address" json:"collector_address,omitempty"`
 	MinimalFee       coin.Coin                        `protobuf:"bytes,4,opt,name=minimal_fee,json=minimalFee,proto3" json:"minimal_fee"`
+	// MinGasPrice is the lowest gas price a transaction can declare. When set,
+	// all transactions must declare a gas limit.
+	MinGasPrice coin.Coin `protobuf:"bytes,5,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
@@ -300,6 +325,13 @@ func (m *Configuration) GetMinimalFee() coin.Coin {
 	return coin.Coin{}
 }
 
+func (m *Configuration) GetMinGasPrice() coin.Coin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return coin.Coin{}
+}
+
 type UpdateConfigurationMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
@@ -352,46 +384,154 @@ func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
 	return nil
 }
 
+// BalanceRequest is the request of the typed balances query.
+type BalanceRequest struct {
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Ticker optionally limits the result to the coins of a single currency.
+	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+}
+
+func (m *BalanceRequest) Reset()         { *m = BalanceRequest{} }
+func (m *BalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*BalanceRequest) ProtoMessage()    {}
+func (*BalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{5}
+}
+func (m *BalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceRequest.Merge(m, src)
+}
+func (m *BalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceRequest proto.InternalMessageInfo
+
+func (m *BalanceRequest) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *BalanceRequest) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
+}
+
+// BalanceResponse is the response of the typed balances query.
+type BalanceResponse struct {
+	Coins []*coin.Coin `protobuf:"bytes,1,rep,name=coins,proto3" json:"coins,omitempty"`
+}
+
+func (m *BalanceResponse) Reset()         { *m = BalanceResponse{} }
+func (m *BalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*BalanceResponse) ProtoMessage()    {}
+func (*BalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{6}
+}
+func (m *BalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceResponse.Merge(m, src)
+}
+func (m *BalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceResponse proto.InternalMessageInfo
+
+func (m *BalanceResponse) GetCoins() []*coin.Coin {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Set)(nil), "cash.Set")
 	proto.RegisterType((*SendMsg)(nil), "cash.SendMsg")
 	proto.RegisterType((*FeeInfo)(nil), "cash.FeeInfo")
 	proto.RegisterType((*Configuration)(nil), "cash.Configuration")
 	proto.RegisterType((*UpdateConfigurationMsg)(nil), "cash.UpdateConfigurationMsg")
+	proto.RegisterType((*BalanceRequest)(nil), "cash.BalanceRequest")
+	proto.RegisterType((*BalanceResponse)(nil), "cash.BalanceResponse")
 }
 
 func init() { proto.RegisterFile("x/cash/codec.proto", fileDescriptor_7149e4b58e322390) }
 
 var fileDescriptor_7149e4b58e322390 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x13, 0x27, 0x85, 0x31, 0x88, 0xb0, 0x20, 0x64, 0xe5, 0xe0, 0x5a, 0x16, 0x87, 0x20,
-	0x84, 0x2d, 0xc2, 0xad, 0xe2, 0x42, 0x2a, 0x45, 0xe2, 0xd0, 0x03, 0x2e, 0x9c, 0xab, 0xed, 0x7a,
-	0xec, 0xac, 0x14, 0xef, 0x44, 0xf6, 0xa6, 0x85, 0x1f, 0xe0, 0xcc, 0x67, 0xf5, 0xd8, 0x23, 0xa7,
-	0x0a, 0x25, 0x7f, 0xc1, 0x01, 0xa1, 0x5d, 0x5b, 0x55, 0xa2, 0x9e, 0x7c, 0x1b, 0xbf, 0x99, 0xf7,
-	0x3c, 0xef, 0xed, 0x2e, 0xb0, 0xef, 0x89, 0xe0, 0xf5, 0x32, 0x11, 0x94, 0xa1, 0x88, 0xd7, 0x15,
-	0x69, 0x62, 0xae, 0x41, 0x26, 0xde, 0x1e, 0x34, 0x19, 0x0b, 0x92, 0x6a, 0x7f, 0x68, 0xf2, 0xb2,
-	0xa0, 0x82, 0x6c, 0x99, 0x98, 0xaa, 0x41, 0xa3, 0xaf, 0x30, 0x38, 0x47, 0xcd, 0xde, 0xc2, 0xa3,
-	0x12, 0x35, 0xcf, 0xb8, 0xe6, 0xbe, 0x13, 0x3a, 0x53, 0x6f, 0xf6, 0x2c, 0xbe, 0x46, 0x7e, 0x85,
-	0xf1, 0x59, 0x0b, 0xa7, 0xf7, 0x03, 0x2c, 0x84, 0xa1, 0x51, 0xaf, 0xfd, 0x7e, 0x38, 0x98, 0x7a,
-	0x33, 0x88, 0xcd, 0x57, 0x7c, 0x4a, 0x52, 0xa5, 0x4d, 0x23, 0xfa, 0xd9, 0x87, 0xa3, 0x73, 0x54,
-	0xd9, 0x59, 0x5d, 0x74, 0x93, 0xfe, 0x08, 0xa3, 0x9a, 0x36, 0x95, 0x40, 0xbf, 0x1f, 0x3a, 0xd3,
-	0x27, 0xf3, 0xd7, 0x7f, 0xef, 0x8e, 0xc3, 0x42, 0xea, 0xe5, 0xe6, 0x32, 0x16, 0x54, 0x26, 0x92,
-	0xae, 0xde, 0x91, 0xc2, 0xa4, 0x11, 0xf8, 0x94, 0x65, 0x15, 0xd6, 0x75, 0xda, 0x72, 0xd8, 0x02,
-	0xbc, 0x0c, 0x6b, 0x2d, 0x15, 0xd7, 0x92, 0x94, 0x3f, 0xe8, 0x20, 0xb1, 0x4f, 0x64, 0x11, 0x8c,
-	0x78, 0x49, 0x1b, 0xa5, 0x7d, 0xd7, 0x2e, 0xbc, 0xef, 0xb0, 0xed, 0x30, 0x06, 0x6e, 0x89, 0x25,
-	0xf9, 0xc3, 0xd0, 0x99, 0x3e, 0x4e, 0x6d, 0xcd, 0xc6, 0x30, 0xa8, 0x30, 0xf7, 0x47, 0xe6, 0xbf,
-	0xa9, 0x29, 0x23, 0x84, 0xa3, 0x05, 0xe2, 0x67, 0x95, 0x13, 0x3b, 0x81, 0xe1, 0x9a, 0xff, 0xc0,
-	0xaa, 0x93, 0xb3, 0x86, 0xc2, 0x02, 0x70, 0x73, 0xc4, 0xda, 0x3a, 0x3a, 0x5c, 0xc7, 0xe2, 0xd1,
-	0x3f, 0x07, 0x9e, 0x9e, 0x92, 0xca, 0x65, 0xb1, 0xa9, 0x1a, 0x0b, 0x9d, 0x52, 0x3f, 0x81, 0x21,
-	0x5d, 0xab, 0xae, 0xab, 0x59, 0x0a, 0xfb, 0x02, 0xcf, 0x05, 0xad, 0x56, 0x28, 0x34, 0x55, 0x17,
-	0xbc, 0xe9, 0x75, 0x4a, 0x7e, 0x7c, 0x4f, 0x6f, 0x11, 0xf6, 0x1e, 0xbc, 0x52, 0x2a, 0x59, 0xf2,
-	0xd5, 0x45, 0x8e, 0xf8, 0xf0, 0x0c, 0xe6, 0xee, 0xcd, 0xdd, 0x71, 0x2f, 0x85, 0x76, 0x68, 0x81,
-	0x18, 0xad, 0xe1, 0xd5, 0xb7, 0x75, 0xc6, 0x35, 0x1e, 0xa4, 0xd0, 0xf9, 0xfa, 0xbd, 0x31, 0x67,
-	0xa4, 0xc5, 0xd2, 0x06, 0xe1, 0xcd, 0x5e, 0xc4, 0xe6, 0x61, 0xc5, 0x07, 0x9a, 0x69, 0x33, 0x31,
-	0xf7, 0x6f, 0xb6, 0x81, 0x73, 0xbb, 0x0d, 0x9c, 0x3f, 0xdb, 0xc0, 0xf9, 0xb5, 0x0b, 0x7a, 0xb7,
-	0xbb, 0xa0, 0xf7, 0x7b, 0x17, 0xf4, 0x2e, 0x47, 0xf6, 0x65, 0x7d, 0xf8, 0x1f, 0x00, 0x00, 0xff,
-	0xff, 0xe1, 0x8c, 0x17, 0xa5, 0xaa, 0x03, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0xe3, 0x24, 0x6d, 0xc7, 0x7f, 0xff, 0x96, 0x05, 0x55, 0x56, 0x91, 0x5c, 0xcb, 0x42,
+	0x22, 0x08, 0xe1, 0x88, 0x96, 0x53, 0x85, 0x90, 0x48, 0xa5, 0x22, 0x24, 0x2a, 0x81, 0x0b, 0xe7,
+	0x68, 0xbb, 0x9e, 0x38, 0x2b, 0xe2, 0x5d, 0xe3, 0xdd, 0xb4, 0xf0, 0x02, 0x9c, 0x79, 0x0f, 0xae,
+	0x3c, 0x44, 0x8f, 0x3d, 0x72, 0xaa, 0x50, 0xf2, 0x16, 0x9c, 0xd0, 0xda, 0x4e, 0x48, 0x89, 0x40,
+	0xf2, 0x6d, 0xf6, 0x9b, 0xf9, 0x3e, 0xef, 0x7c, 0xb3, 0x63, 0x20, 0x1f, 0x7b, 0x8c, 0xaa, 0x51,
+	0x8f, 0xc9, 0x18, 0x59, 0x98, 0xe5, 0x52, 0x4b, 0xd2, 0x32, 0xc8, 0xae, 0xb3, 0x04, 0xed, 0x6e,
+	0x33, 0xc9, 0xc5, 0x72, 0xd1, 0xee, 0x9d, 0x44, 0x26, 0xb2, 0x08, 0x7b, 0x26, 0x2a, 0xd1, 0xe0,
+	0x2d, 0xd8, 0xa7, 0xa8, 0xc9, 0x43, 0x58, 0x4f, 0x51, 0xd3, 0x98, 0x6a, 0xea, 0x5a, 0xbe, 0xd5,
+	0x75, 0xf6, 0xb7, 0xc2, 0x0b, 0xa4, 0xe7, 0x18, 0x9e, 0x54, 0x70, 0xb4, 0x28, 0x20, 0x3e, 0xb4,
+	0x8d, 0xba, 0x72, 0x9b, 0xbe, 0xdd, 0x75, 0xf6, 0x21, 0x34, 0xa7, 0xf0, 0x48, 0x72, 0x11, 0x95,
+	0x89, 0xe0, 0x73, 0x13, 0xd6, 0x4e, 0x51, 0xc4, 0x27, 0x2a, 0xa9, 0x27, 0xfd, 0x14, 0x3a, 0x4a,
+	0x4e, 0x72, 0x86, 0x6e, 0xd3, 0xb7, 0xba, 0xff, 0xf5, 0xef, 0xfd, 0xbc, 0xde, 0xf3, 0x13, 0xae,
+	0x47, 0x93, 0xb3, 0x90, 0xc9, 0xb4, 0xc7, 0xe5, 0xf9, 0x23, 0x29, 0xb0, 0x57, 0x0a, 0x3c, 0x8f,
+	0xe3, 0x1c, 0x95, 0x8a, 0x2a, 0x0e, 0x39, 0x06, 0x27, 0x46, 0xa5, 0xb9, 0xa0, 0x9a, 0x4b, 0xe1,
+	0xda, 0x35, 0x24, 0x96, 0x89, 0x24, 0x80, 0x0e, 0x4d, 0xe5, 0x44, 0x68, 0xb7, 0xe5, 0x5b, 0x7f,
+	0x74, 0x58, 0x65, 0x08, 0x81, 0x56, 0x8a, 0xa9, 0x74, 0xdb, 0xbe, 0xd5, 0xdd, 0x88, 0x8a, 0x98,
+	0x6c, 0x83, 0x9d, 0xe3, 0xd0, 0xed, 0x98, 0xef, 0x46, 0x26, 0x0c, 0xbe, 0x5a, 0xb0, 0x76, 0x8c,
+	0xf8, 0x52, 0x0c, 0x25, 0x39, 0x84, 0x76, 0x46, 0x3f, 0x61, 0x5e, 0xab, 0xb5, 0x92, 0x42, 0x3c,
+	0x68, 0x0d, 0x11, 0x95, 0x6b, 0xaf, 0xdc, 0xa7, 0xc0, 0xc9, 0x5d, 0xd8, 0x48, 0xa8, 0x1a, 0x8c,
+	0x79, 0xca, 0xcb, 0x4b, 0xdb, 0xd1, 0x7a, 0x42, 0xd5, 0x2b, 0x73, 0x26, 0xf7, 0xcb, 0x64, 0x96,
+	0x73, 0x86, 0x6e, 0x7b, 0x45, 0xc1, 0x14, 0xbe, 0x36, 0xb9, 0xe0, 0x5b, 0x13, 0x36, 0x8f, 0xa4,
+	0x18, 0xf2, 0x64, 0x92, 0x97, 0x4e, 0xd4, 0x1a, 0xde, 0x21, 0xb4, 0xe5, 0x85, 0xa8, 0xdb, 0x60,
+	0x41, 0x21, 0x6f, 0xe0, 0x16, 0x93, 0xe3, 0x31, 0x32, 0x2d, 0xf3, 0x01, 0x2d, 0x73, 0xb5, 0x06,
+	0xb8, 0xbd, 0xa0, 0x57, 0x08, 0x79, 0x0c, 0x4e, 0xca, 0x05, 0x4f, 0xe9, 0x78, 0x30, 0x44, 0x5c,
+	0x1d, 0x65, 0xbf, 0x75, 0x79, 0xbd, 0xd7, 0x88, 0xa0, 0x2a, 0x3a, 0x46, 0x24, 0x4f, 0x60, 0x33,
+	0xe5, 0x62, 0xf0, 0x0f, 0xb7, 0x2a, 0x92, 0x51, 0x7e, 0x31, 0xb7, 0x2d, 0x83, 0x9d, 0x77, 0x59,
+	0x4c, 0x35, 0xde, 0xf0, 0xae, 0xf6, 0xdb, 0x7f, 0x60, 0xde, 0x87, 0x66, 0xa3, 0xc2, 0x3e, 0x67,
+	0xff, 0x76, 0x68, 0xb6, 0x3a, 0xbc, 0xa1, 0x19, 0x95, 0x15, 0xc1, 0x08, 0xfe, 0xef, 0xd3, 0x31,
+	0x15, 0x0c, 0x23, 0xfc, 0x30, 0x41, 0xa5, 0xc9, 0x33, 0x58, 0x9b, 0xbb, 0x66, 0xd5, 0x70, 0x6d,
+	0x4e, 0x22, 0x3b, 0xd0, 0xd1, 0x9c, 0xbd, 0xaf, 0x86, 0xb7, 0x11, 0x55, 0xa7, 0xe0, 0x00, 0xb6,
+	0x16, 0x5f, 0x52, 0x99, 0x14, 0x0a, 0x7f, 0xaf, 0xbf, 0xf5, 0x97, 0xf5, 0xef, 0xbb, 0x97, 0x53,
+	0xcf, 0xba, 0x9a, 0x7a, 0xd6, 0x8f, 0xa9, 0x67, 0x7d, 0x99, 0x79, 0x8d, 0xab, 0x99, 0xd7, 0xf8,
+	0x3e, 0xf3, 0x1a, 0x67, 0x9d, 0xe2, 0xaf, 0x73, 0xf0, 0x6b, 0x00, 0x9a, 0x5b, 0xa9, 0x35, 0xc6,
+	0x04, 0x00, 0x00,
 }
 
 func (m *Set) Marshal() (dAtA []byte, err error) {
@@ -527,6 +667,21 @@ func (m *FeeInfo) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if m.GasLimit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GasLimit))
+	}
+	if m.GasPrice != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GasPrice.Size()))
+		n5, err := m.GasPrice.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	return i, nil
 }
 
@@ -549,11 +704,11 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	if len(m.Owner) > 0 {
 		dAtA[i] = 0x12
@@ -570,11 +725,19 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintCodec(dAtA, i, uint64(m.MinimalFee.Size()))
-	n6, err := m.MinimalFee.MarshalTo(dAtA[i:])
+	n7, err := m.MinimalFee.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.MinGasPrice.Size()))
+	n8, err := m.MinGasPrice.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
 	return i, nil
 }
 
@@ -597,21 +760,81 @@ func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		n9, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n9
 	}
 	if m.Patch != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
-		n8, err := m.Patch.MarshalTo(dAtA[i:])
+		n10, err := m.Patch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *BalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.Ticker) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Ticker)))
+		i += copy(dAtA[i:], m.Ticker)
+	}
+	return i, nil
+}
+
+func (m *BalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, msg := range m.Coins {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
@@ -691,6 +914,13 @@ func (m *FeeInfo) Size() (n int) {
 		l = m.Fees.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCodec(uint64(m.GasLimit))
+	}
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -714,6 +944,8 @@ func (m *Configuration) Size() (n int) {
 	}
 	l = m.MinimalFee.Size()
 	n += 1 + l + sovCodec(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovCodec(uint64(l))
 	return n
 }
 
@@ -734,6 +966,38 @@ func (m *UpdateConfigurationMsg) Size() (n int) {
 	return n
 }
 
+func (m *BalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -1228,6 +1492,61 @@ func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasPrice == nil {
+				m.GasPrice = &coin.Coin{}
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1418,6 +1737,39 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1567,6 +1919,212 @@ func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, &coin.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCodec(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
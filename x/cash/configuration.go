@@ -27,6 +27,14 @@ func (c *Configuration) Validate() error {
 			return errors.Wrap(errors.ErrState, "minimal fee cannot be negative")
 		}
 	}
+	if !c.MinGasPrice.IsZero() {
+		if err := c.MinGasPrice.Validate(); err != nil {
+			return errors.Wrap(err, "minimal gas price")
+		}
+		if !c.MinGasPrice.IsNonNegative() {
+			return errors.Wrap(errors.ErrState, "minimal gas price cannot be negative")
+		}
+	}
 	return nil
 }
 
@@ -17,6 +17,14 @@ an error.
 If a transaction succeeded, and at least RequiredFee was paid, everything is
 committed and we return success
 
+A transaction can additionally declare a gas limit and a gas price. The price
+of the whole gas limit is charged together with the transaction fee and held
+by the gas escrow of the payer. Once the transaction is processed, the price
+of the consumed gas is sent to the collector and the rest is refunded. If the
+transaction fails, the minimum fee and the price of the consumed gas is
+charged. A minimal gas price can be configured via gconf package, in which
+case every transaction must declare a gas limit and pay at least that price.
+
 It also embeds a checkpoint inside, so in the typical application stack:
 
 	cash.NewFeeDecorator(authFn, ctrl),
@@ -58,39 +66,47 @@ func NewDynamicFeeDecorator(auth x.Authenticator, ctrl Controller) DynamicFeeDec
 
 // Check verifies and deducts fees before calling down the stack
 func (d DynamicFeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (cres *weave.CheckResult, cerr error) {
-	fee, payer, cache, err := d.prepare(ctx, store, tx)
+	fee, cache, err := d.prepare(ctx, store, tx)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot prepare")
 	}
 
 	defer func() {
 		if cerr == nil {
-			// If we cannot write the cache, then we return error
-			// here means that nothing got committed. This means
-			// that no change is persisted and the whole check
-			// failed.
-			if err := cache.Write(); err != nil {
+			// If we cannot settle the gas or write the cache, then
+			// we return error here means that nothing got
+			// committed. This means that no change is persisted
+			// and the whole check failed.
+			gasCost, err := d.settleGas(ctx, cache, fee)
+			if err != nil {
+				cache.Discard()
+				cres = nil
+				cerr = err
+			} else if err := cache.Write(); err != nil {
 				cache.Discard()
 				cres = nil
 				cerr = err
 			} else {
-				cres.GasPayment += toPayment(fee)
+				cres.GasPayment += toPayment(fee.amount) + toPayment(gasCost)
 			}
 		} else {
 			cache.Discard()
-			_ = d.chargeMinimalFee(store, payer)
+			_ = d.chargeFailure(ctx, store, fee)
 		}
 	}()
 
-	if err := d.chargeFee(cache, payer, fee); err != nil {
+	if err := d.chargeFee(cache, fee.payer, fee.amount); err != nil {
 		return nil, errors.Wrap(err, "cannot charge fee")
 	}
+	if err := d.chargeGas(cache, fee); err != nil {
+		return nil, errors.Wrap(err, "cannot charge gas")
+	}
 	cres, err = next.Check(ctx, cache, tx)
 	if err != nil {
 		return nil, err
 	}
 	// if we have success, ensure that we paid at least the RequiredFee (IsGTE enforces the same token)
-	if !cres.RequiredFee.IsZero() && !fee.IsGTE(cres.RequiredFee) {
+	if !cres.RequiredFee.IsZero() && !fee.amount.IsGTE(cres.RequiredFee) {
 		return nil, errors.Wrapf(errors.ErrAmount, "fee less than required fee of %q", cres.RequiredFee)
 	}
 	return cres, nil
@@ -98,42 +114,57 @@ func (d DynamicFeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx we
 
 // Deliver verifies and deducts fees before calling down the stack
 func (d DynamicFeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (dres *weave.DeliverResult, derr error) {
-	fee, payer, cache, err := d.prepare(ctx, store, tx)
+	fee, cache, err := d.prepare(ctx, store, tx)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot prepare")
 	}
 
 	defer func() {
 		if derr == nil {
-			// If we cannot write the cache, then we return error
-			// here means that nothing got committed. This means
-			// that no change is persisted and the whole delivery
-			// failed.
-			if err := cache.Write(); err != nil {
+			// If we cannot settle the gas or write the cache, then
+			// we return error here means that nothing got
+			// committed. This means that no change is persisted
+			// and the whole delivery failed.
+			if _, err := d.settleGas(ctx, cache, fee); err != nil {
+				cache.Discard()
+				dres = nil
+				derr = err
+			} else if err := cache.Write(); err != nil {
 				cache.Discard()
 				dres = nil
 				derr = err
 			}
 		} else {
 			cache.Discard()
-			_ = d.chargeMinimalFee(store, payer)
+			_ = d.chargeFailure(ctx, store, fee)
 		}
 	}()
 
-	if err := d.chargeFee(cache, payer, fee); err != nil {
+	if err := d.chargeFee(cache, fee.payer, fee.amount); err != nil {
 		return nil, errors.Wrap(err, "cannot charge fee")
 	}
+	if err := d.chargeGas(cache, fee); err != nil {
+		return nil, errors.Wrap(err, "cannot charge gas")
+	}
 	res, err := next.Deliver(ctx, cache, tx)
 	if err != nil {
 		return res, err
 	}
 	// if we have success, ensure that we paid at least the RequiredFee (IsGTE enforces the same token)
-	if !res.RequiredFee.IsZero() && !fee.IsGTE(res.RequiredFee) {
+	if !res.RequiredFee.IsZero() && !fee.amount.IsGTE(res.RequiredFee) {
 		return nil, errors.Wrapf(errors.ErrAmount, "Fee less than required fee of %#v", res.RequiredFee)
 	}
 	return res, nil
 }
 
+// txFee is the fee declared by a transaction.
+type txFee struct {
+	payer    weave.Address
+	amount   coin.Coin
+	gasLimit int64
+	gasPrice coin.Coin
+}
+
 func (d DynamicFeeDecorator) chargeFee(store weave.KVStore, src weave.Address, amount coin.Coin) error {
 	if amount.IsZero() {
 		return nil
@@ -154,34 +185,117 @@ func (d DynamicFeeDecorator) chargeMinimalFee(store weave.KVStore, src weave.Add
 	return d.chargeFee(store, src, fee)
 }
 
+// GasEscrowCondition returns the condition of the account that holds the
+// price of the gas limit charged from given payer, until the gas consumed by
+// the transaction is settled.
+func GasEscrowCondition(payer weave.Address) weave.Condition {
+	return weave.NewCondition("cash", "gas", payer)
+}
+
+// chargeGas moves the price of the whole gas limit to the gas escrow of the
+// payer. Gas settlement is not metered, so that it succeeds even if all the
+// gas is consumed.
+func (d DynamicFeeDecorator) chargeGas(store weave.KVStore, fee txFee) error {
+	cost, err := fee.gasPrice.Multiply(fee.gasLimit)
+	if err != nil {
+		return errors.Wrap(err, "gas cost")
+	}
+	if cost.IsZero() {
+		return nil
+	}
+	escrow := GasEscrowCondition(fee.payer).Address()
+	return d.ctrl.MoveCoins(weave.UnmeteredKVStore(store), fee.payer, escrow, cost)
+}
+
+// settleGas releases the gas escrow of the payer. The price of the consumed
+// gas is sent to the collector and the rest is returned to the payer. The
+// price of the consumed gas is returned.
+func (d DynamicFeeDecorator) settleGas(ctx weave.Context, store weave.KVStore, fee txFee) (coin.Coin, error) {
+	consumed := gasConsumed(ctx, fee.gasLimit)
+	cost, err := fee.gasPrice.Multiply(consumed)
+	if err != nil {
+		return cost, errors.Wrap(err, "gas cost")
+	}
+	refund, err := fee.gasPrice.Multiply(fee.gasLimit - consumed)
+	if err != nil {
+		return cost, errors.Wrap(err, "gas refund")
+	}
+	db := weave.UnmeteredKVStore(store)
+	escrow := GasEscrowCondition(fee.payer).Address()
+	if !cost.IsZero() {
+		dest := mustLoadConf(db).CollectorAddress
+		if err := d.ctrl.MoveCoins(db, escrow, dest, cost); err != nil {
+			return cost, errors.Wrap(err, "gas payment")
+		}
+	}
+	if !refund.IsZero() {
+		if err := d.ctrl.MoveCoins(db, escrow, fee.payer, refund); err != nil {
+			return cost, errors.Wrap(err, "gas refund")
+		}
+	}
+	return cost, nil
+}
+
+// chargeFailure deducts the minimal fee and the price of the consumed gas
+// after a transaction failed.
+func (d DynamicFeeDecorator) chargeFailure(ctx weave.Context, store weave.KVStore, fee txFee) error {
+	db := weave.UnmeteredKVStore(store)
+	if err := d.chargeMinimalFee(db, fee.payer); err != nil {
+		return err
+	}
+	cost, err := fee.gasPrice.Multiply(gasConsumed(ctx, fee.gasLimit))
+	if err != nil {
+		return errors.Wrap(err, "gas cost")
+	}
+	return d.chargeFee(db, fee.payer, cost)
+}
+
+// gasConsumed returns the amount of gas consumed by the transaction, but not
+// more than the declared limit. If the consumption is not measured, the whole
+// limit is returned.
+func gasConsumed(ctx weave.Context, limit int64) int64 {
+	if limit == 0 {
+		return 0
+	}
+	meter, ok := weave.GetGasMeter(ctx)
+	if !ok || meter.GasConsumed() > limit {
+		return limit
+	}
+	return meter.GasConsumed()
+}
+
 // prepare is all shared setup between Check and Deliver. It computes the fee
 // for the transaction, ensures that the payer is authenticated and prepares
 // the database transaction.
-func (d DynamicFeeDecorator) prepare(ctx weave.Context, store weave.KVStore, tx weave.Tx) (fee coin.Coin, payer weave.Address, cache weave.KVCacheWrap, err error) {
+func (d DynamicFeeDecorator) prepare(ctx weave.Context, store weave.KVStore, tx weave.Tx) (fee txFee, cache weave.KVCacheWrap, err error) {
 	finfo, err := d.extractFee(ctx, tx, store)
 	if err != nil {
-		return fee, payer, cache, errors.Wrap(err, "cannot extract fee")
+		return fee, cache, errors.Wrap(err, "cannot extract fee")
 	}
 	// Dererefence the fees (handling nil).
 	if pfee := finfo.GetFees(); pfee != nil {
-		fee = *pfee
+		fee.amount = *pfee
+	}
+	if price := finfo.GetGasPrice(); price != nil {
+		fee.gasPrice = *price
 	}
-	payer = finfo.GetPayer()
+	fee.gasLimit = finfo.GetGasLimit()
+	fee.payer = finfo.GetPayer()
 
 	// Verify we have access to the money.
-	if !d.auth.HasAddress(ctx, payer) {
+	if !d.auth.HasAddress(ctx, fee.payer) {
 		err := errors.Wrap(errors.ErrUnauthorized, "fee payer signature missing")
-		return fee, payer, cache, err
+		return fee, cache, err
 	}
 
 	// Ensure we can execute subtransactions (see check on utils.Savepoint).
 	cstore, ok := store.(weave.CacheableKVStore)
 	if !ok {
 		err = errors.Wrap(errors.ErrHuman, "need cachable kvstore")
-		return fee, payer, cache, err
+		return fee, cache, err
 	}
 	cache = cstore.CacheWrap()
-	return fee, payer, cache, nil
+	return fee, cache, nil
 }
 
 // this returns the fee info to deduct and the error if incorrectly set
@@ -193,10 +307,14 @@ func (d DynamicFeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store we
 		finfo = ftx.GetFees().DefaultPayer(payer)
 	}
 
+	conf := mustLoadConf(store)
+	if err := validateGas(finfo, conf.MinGasPrice); err != nil {
+		return nil, errors.Wrap(err, "invalid gas")
+	}
+
 	txFee := finfo.GetFees()
 	if coin.IsEmpty(txFee) {
-		minFee := mustLoadConf(store).MinimalFee
-		if minFee.IsZero() {
+		if conf.MinimalFee.IsZero() {
 			return finfo, nil
 		}
 		return nil, errors.Wrap(errors.ErrAmount, "zero transaction fee is not allowed")
@@ -206,7 +324,7 @@ func (d DynamicFeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store we
 		return nil, errors.Wrap(err, "invalid fee")
 	}
 
-	minFee := mustLoadConf(store).MinimalFee
+	minFee := conf.MinimalFee
 	if minFee.IsZero() {
 		return finfo, nil
 	}
@@ -217,10 +335,48 @@ func (d DynamicFeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store we
 		err := errors.Wrapf(errors.ErrCurrency,
 			"min fee is %s and tx fee is %s", minFee.Ticker, txFee.Ticker)
 		return nil, err
-
 	}
 	if !txFee.IsGTE(minFee) {
 		return nil, errors.Wrapf(errors.ErrAmount, "transaction fee less than minimum %q", txFee)
 	}
 	return finfo, nil
 }
+
+// validateGas ensures that the declared gas limit and gas price are valid
+// and that at least the minimal gas price is paid.
+func validateGas(finfo *FeeInfo, minPrice coin.Coin) error {
+	limit := finfo.GetGasLimit()
+	if limit < 0 {
+		return errors.Wrap(errors.ErrInput, "negative gas limit")
+	}
+	var price coin.Coin
+	if p := finfo.GetGasPrice(); p != nil {
+		price = *p
+	}
+	if !price.IsZero() {
+		if err := price.Validate(); err != nil {
+			return errors.Wrap(err, "gas price")
+		}
+		if !price.IsNonNegative() {
+			return errors.Wrap(errors.ErrAmount, "negative gas price")
+		}
+		if limit == 0 {
+			return errors.Wrap(errors.ErrInput, "gas price requires gas limit")
+		}
+	}
+
+	if minPrice.IsZero() {
+		return nil
+	}
+	if limit == 0 {
+		return errors.Wrap(errors.ErrInput, "gas limit is required")
+	}
+	if !price.SameType(minPrice) {
+		return errors.Wrapf(errors.ErrCurrency,
+			"min gas price is %s and gas price is %s", minPrice.Ticker, price.Ticker)
+	}
+	if !price.IsGTE(minPrice) {
+		return errors.Wrapf(errors.ErrAmount, "gas price less than minimum %q", minPrice)
+	}
+	return nil
+}
@@ -304,6 +304,187 @@ func TestDynamicFeeDecorator(t *testing.T) {
 	}
 }
 
+func TestDynamicFeeDecoratorGas(t *testing.T) {
+	payer := weavetest.NewCondition()
+	collector := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		minGasPrice coin.Coin
+		gasLimit    int64
+		gasPrice    *coin.Coin
+		handler     *gasHandler
+
+		wantErr       *errors.Error
+		wantCollected coin.Coin
+	}{
+		"unused gas is refunded": {
+			gasLimit:      1000,
+			gasPrice:      coin.NewCoinp(0, 1, "IOV"),
+			handler:       &gasHandler{consume: 300},
+			wantCollected: coin.NewCoin(0, 100+300, "IOV"),
+		},
+		"transaction without gas pays only the fee": {
+			handler:       &gasHandler{consume: 300},
+			wantCollected: coin.NewCoin(0, 100, "IOV"),
+		},
+		"on a handler failure minimum fee and consumed gas is charged": {
+			gasLimit:      1000,
+			gasPrice:      coin.NewCoinp(0, 1, "IOV"),
+			handler:       &gasHandler{consume: 300, err: ErrTestingError},
+			wantErr:       ErrTestingError,
+			wantCollected: coin.NewCoin(0, 10+300, "IOV"),
+		},
+		"out of gas charges minimum fee and the whole gas limit": {
+			gasLimit:      1000,
+			gasPrice:      coin.NewCoinp(0, 1, "IOV"),
+			handler:       &gasHandler{consume: 5000},
+			wantErr:       errors.ErrOutOfGas,
+			wantCollected: coin.NewCoin(0, 10+1000, "IOV"),
+		},
+		"gas price at least minimal gas price": {
+			minGasPrice:   coin.NewCoin(0, 2, "IOV"),
+			gasLimit:      1000,
+			gasPrice:      coin.NewCoinp(0, 3, "IOV"),
+			handler:       &gasHandler{consume: 100},
+			wantCollected: coin.NewCoin(0, 100+300, "IOV"),
+		},
+		"gas price lower than minimal gas price": {
+			minGasPrice: coin.NewCoin(0, 2, "IOV"),
+			gasLimit:    1000,
+			gasPrice:    coin.NewCoinp(0, 1, "IOV"),
+			handler:     &gasHandler{},
+			wantErr:     errors.ErrAmount,
+		},
+		"gas price in a different currency than minimal gas price": {
+			minGasPrice: coin.NewCoin(0, 2, "IOV"),
+			gasLimit:    1000,
+			gasPrice:    coin.NewCoinp(0, 2, "ETH"),
+			handler:     &gasHandler{},
+			wantErr:     errors.ErrCurrency,
+		},
+		"gas limit is required when minimal gas price is set": {
+			minGasPrice: coin.NewCoin(0, 2, "IOV"),
+			gasPrice:    coin.NewCoinp(0, 2, "IOV"),
+			handler:     &gasHandler{},
+			wantErr:     errors.ErrInput,
+		},
+		"gas price requires gas limit": {
+			gasPrice: coin.NewCoinp(0, 2, "IOV"),
+			handler:  &gasHandler{},
+			wantErr:  errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: payer}
+			ctrl := NewController(NewBucket())
+			d := NewDynamicFeeDecorator(auth, ctrl)
+
+			raw := store.MemStore()
+			migration.MustInitPkg(raw, "cash")
+			config := Configuration{
+				CollectorAddress: collector.Address(),
+				MinimalFee:       coin.NewCoin(0, 10, "IOV"),
+				MinGasPrice:      tc.minGasPrice,
+			}
+			if err := gconf.Save(raw, "cash", &config); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+			initial := coin.NewCoin(1, 0, "IOV")
+			if err := ctrl.CoinMint(raw, payer.Address(), initial); err != nil {
+				t.Fatalf("cannot mint: %s", err)
+			}
+
+			// Store operations are free, so that only the gas
+			// consumed by the handler is charged.
+			meter := weave.NewGasMeter(tc.gasLimit)
+			ctx := weave.WithGasMeter(context.Background(), meter)
+			db := weave.NewGasKVStore(raw, meter, weave.GasConfig{})
+
+			tx := &txMock{info: &FeeInfo{
+				Fees:     coin.NewCoinp(0, 100, "IOV"),
+				GasLimit: tc.gasLimit,
+				GasPrice: tc.gasPrice,
+			}}
+			if tc.wantErr == nil {
+				// Check reports the fee and the price of the
+				// consumed gas as the gas payment.
+				checkMeter := weave.NewGasMeter(tc.gasLimit)
+				checkCtx := weave.WithGasMeter(context.Background(), checkMeter)
+				checkDB := weave.NewGasKVStore(raw.CacheWrap(), checkMeter, weave.GasConfig{})
+				cres, err := d.Check(checkCtx, checkDB, tx, tc.handler)
+				if err != nil {
+					t.Fatalf("unexpected check error: %+v", err)
+				}
+				if want := toPayment(tc.wantCollected); cres.GasPayment != want {
+					t.Fatalf("want %d gas payment, got %d", want, cres.GasPayment)
+				}
+			}
+
+			if _, err := d.Deliver(ctx, db, tx, tc.handler); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+
+			escrowed, err := ctrl.Balance(raw, GasEscrowCondition(payer.Address()).Address())
+			if err == nil && !escrowed.IsEmpty() {
+				t.Fatalf("gas escrow not settled: %v", escrowed)
+			}
+
+			collected, err := ctrl.Balance(raw, collector.Address())
+			if tc.wantCollected.IsZero() {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want nothing collected, got %v, %v", collected, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cannot get collector balance: %s", err)
+			}
+			if !collected.Equals(coin.Coins{&tc.wantCollected}) {
+				t.Fatalf("want %v collected, got %v", tc.wantCollected, collected)
+			}
+			balance, err := ctrl.Balance(raw, payer.Address())
+			if err != nil {
+				t.Fatalf("cannot get payer balance: %s", err)
+			}
+			left, err := initial.Subtract(tc.wantCollected)
+			if err != nil {
+				t.Fatalf("cannot compute balance: %s", err)
+			}
+			if !balance.Equals(coin.Coins{&left}) {
+				t.Fatalf("want %v left, got %v", left, balance)
+			}
+		})
+	}
+}
+
+// gasHandler consumes given amount of gas and returns the configured error.
+type gasHandler struct {
+	consume int64
+	err     error
+}
+
+func (h *gasHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if err := weave.ConsumeGas(ctx, h.consume, "test"); err != nil {
+		return nil, err
+	}
+	if h.err != nil {
+		return nil, h.err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *gasHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	if err := weave.ConsumeGas(ctx, h.consume, "test"); err != nil {
+		return nil, err
+	}
+	if h.err != nil {
+		return nil, h.err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
 // ensureWallets persist state of given wallet objects in the database. If
 // a wallet already exist it is overwritten.
 func ensureWallets(t *testing.T, db weave.KVStore, wallets []orm.Object) {
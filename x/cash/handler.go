@@ -17,9 +17,12 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, control Controller)
 	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
 }
 
-// RegisterQuery will register this bucket as "/wallets"
+// RegisterQuery will register this bucket as "/wallets" and the typed
+// balances query as "/balances"
 func RegisterQuery(qr weave.QueryRouter) {
-	NewBucket().Register("wallets", qr)
+	bucket := NewBucket()
+	bucket.Register("wallets", qr)
+	qr.RegisterTyped("/balances", &balanceQuery{bucket: bucket})
 }
 
 // SendHandler will handle sending coins
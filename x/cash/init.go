@@ -4,6 +4,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 )
 
 // GenesisAccount is used to parse the json from genesis file
@@ -18,6 +19,7 @@ type GenesisAccount struct {
 type Initializer struct{}
 
 var _ weave.Initializer = Initializer{}
+var _ weave.Exporter = Initializer{}
 
 // FromGenesis will parse initial account info from genesis
 // and save it to the database
@@ -47,3 +49,32 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 
 	return nil
 }
+
+// ToGenesis will export all wallets and the configuration from the
+// database.
+func (Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	opts, err := gconf.ExportConfig(kv, "cash", &Configuration{})
+	if err != nil {
+		return nil, errors.Wrap(err, "export config")
+	}
+
+	accts := []GenesisAccount{}
+	it := orm.IterAll(BucketName)
+	for {
+		var set Set
+		switch key, err := it.Next(kv, &set); {
+		case err == nil:
+			accts = append(accts, GenesisAccount{
+				Address: weave.Address(key),
+				Set:     Set{Coins: set.Coins},
+			})
+		case errors.ErrIteratorDone.Is(err):
+			if err := opts.WriteOptions("cash", accts); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "wallet")
+		}
+	}
+}
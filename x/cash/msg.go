@@ -77,8 +77,10 @@ func (f *FeeInfo) DefaultPayer(addr []byte) *FeeInfo {
 		return f
 	}
 	return &FeeInfo{
-		Payer: addr,
-		Fees:  f.GetFees(),
+		Payer:    addr,
+		Fees:     f.GetFees(),
+		GasLimit: f.GetGasLimit(),
+		GasPrice: f.GetGasPrice(),
 	}
 }
 
@@ -102,6 +104,20 @@ func (f *FeeInfo) Validate() error {
 	}
 	errs = errors.AppendField(errs, "Payer", f.Payer.Validate())
 
+	if f.GetGasLimit() < 0 {
+		errs = errors.Append(errs, errors.Field("GasLimit", errors.ErrInput, "negative gas limit"))
+	}
+	if price := f.GetGasPrice(); price != nil {
+		errs = errors.AppendField(errs, "GasPrice", price.Validate())
+
+		if !price.IsNonNegative() {
+			errs = errors.Append(errs, errors.Field("GasPrice", errors.ErrAmount, "negative gas price"))
+		}
+		if !price.IsZero() && f.GetGasLimit() == 0 {
+			errs = errors.Append(errs, errors.Field("GasLimit", errors.ErrInput, "required when gas price is set"))
+		}
+	}
+
 	return errs
 }
 
@@ -124,6 +140,13 @@ func (m *UpdateConfigurationMsg) Validate() error {
 			errs = errors.Append(errs, errors.Field("MinimalFee", errors.ErrState, "cannot be negative"))
 		}
 	}
+	if !c.MinGasPrice.IsZero() {
+		errs = errors.AppendField(errs, "MinGasPrice", c.MinGasPrice.Validate())
+
+		if !c.MinGasPrice.IsNonNegative() {
+			errs = errors.Append(errs, errors.Field("MinGasPrice", errors.ErrState, "cannot be negative"))
+		}
+	}
 	return errs
 }
 
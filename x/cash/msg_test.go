@@ -135,6 +135,40 @@ func TestValidateFeeTx(t *testing.T) {
 			},
 			wantErr: errors.ErrCurrency,
 		},
+		"with gas": {
+			info: &FeeInfo{
+				Fees:     coin.NewCoinp(1, 0, "IOV"),
+				Payer:    addr1,
+				GasLimit: 1000,
+				GasPrice: coin.NewCoinp(0, 1, "IOV"),
+			},
+			wantErr: nil,
+		},
+		"negative gas limit": {
+			info: &FeeInfo{
+				Fees:     coin.NewCoinp(1, 0, "IOV"),
+				Payer:    addr1,
+				GasLimit: -1,
+			},
+			wantErr: errors.ErrInput,
+		},
+		"negative gas price": {
+			info: &FeeInfo{
+				Fees:     coin.NewCoinp(1, 0, "IOV"),
+				Payer:    addr1,
+				GasLimit: 1000,
+				GasPrice: coin.NewCoinp(0, -1, "IOV"),
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"gas price without gas limit": {
+			info: &FeeInfo{
+				Fees:     coin.NewCoinp(1, 0, "IOV"),
+				Payer:    addr1,
+				GasPrice: coin.NewCoinp(0, 1, "IOV"),
+			},
+			wantErr: errors.ErrInput,
+		},
 	}
 
 	for testName, tc := range cases {
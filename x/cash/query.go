@@ -0,0 +1,55 @@
+package cash
+
+import (
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+)
+
+// Validate ensures the balance request is valid.
+func (r *BalanceRequest) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Address", r.Address.Validate())
+	if r.Ticker != "" && !coin.IsCC(r.Ticker) {
+		errs = errors.Append(errs, errors.Field("Ticker", errors.ErrCurrency, "invalid ticker"))
+	}
+	return errs
+}
+
+// Validate ensures the balance response is valid.
+func (r *BalanceResponse) Validate() error {
+	return errors.Field("Coins", coin.Coins(r.Coins).Validate(), "")
+}
+
+// balanceQuery is a typed query that returns coins owned by an address.
+type balanceQuery struct {
+	bucket Bucket
+}
+
+var _ weave.TypedQueryHandler = (*balanceQuery)(nil)
+
+func (balanceQuery) NewRequest() weave.Persistent {
+	return &BalanceRequest{}
+}
+
+func (balanceQuery) NewResponse() weave.Persistent {
+	return &BalanceResponse{}
+}
+
+func (q *balanceQuery) Query(db weave.ReadOnlyKVStore, r weave.Persistent) (weave.Persistent, error) {
+	req := r.(*BalanceRequest)
+	obj, err := q.bucket.Get(db, req.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load wallet")
+	}
+	var resp BalanceResponse
+	if obj == nil {
+		return &resp, nil
+	}
+	for _, c := range AsCoins(obj) {
+		if req.Ticker == "" || c.Ticker == req.Ticker {
+			resp.Coins = append(resp.Coins, c)
+		}
+	}
+	return &resp, nil
+}
@@ -0,0 +1,72 @@
+package cash
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestBalanceQuery(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+
+	owner := weavetest.NewCondition().Address()
+	wallet, err := WalletWith(owner, coin.NewCoinp(5, 0, "IOV"), coin.NewCoinp(2, 0, "ETH"))
+	assert.Nil(t, err)
+	assert.Nil(t, NewBucket().Save(db, wallet))
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	h := qr.Handler("/balances")
+
+	cases := map[string]struct {
+		Req       BalanceRequest
+		WantCoins []*coin.Coin
+		WantErr   *errors.Error
+	}{
+		"all coins": {
+			Req:       BalanceRequest{Address: owner},
+			WantCoins: []*coin.Coin{coin.NewCoinp(2, 0, "ETH"), coin.NewCoinp(5, 0, "IOV")},
+		},
+		"single ticker": {
+			Req:       BalanceRequest{Address: owner, Ticker: "IOV"},
+			WantCoins: []*coin.Coin{coin.NewCoinp(5, 0, "IOV")},
+		},
+		"unknown wallet": {
+			Req:       BalanceRequest{Address: weavetest.NewCondition().Address()},
+			WantCoins: nil,
+		},
+		"invalid ticker": {
+			Req:     BalanceRequest{Address: owner, Ticker: "x"},
+			WantErr: errors.ErrCurrency,
+		},
+		"invalid address": {
+			Req:     BalanceRequest{Address: weave.Address{1, 2}},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			data, err := tc.Req.Marshal()
+			assert.Nil(t, err)
+			models, err := h.Query(db, weave.KeyQueryMod, data)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.WantErr != nil {
+				return
+			}
+			assert.Equal(t, 1, len(models))
+			var resp BalanceResponse
+			assert.Nil(t, resp.Unmarshal(models[0].Value))
+			assert.Equal(t, tc.WantCoins, resp.Coins)
+		})
+	}
+}
@@ -111,31 +111,236 @@ func (m *TaskResult) GetExecHeight() int64 {
 	return 0
 }
 
+// Task holds the schedule of a queued task. The task data, as serialized by
+// the TaskMarshaler, is kept in the queue.
+type Task struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Authenticators contains all conditions that authenticate the task
+	// execution. Those conditions own the task and only together they can
+	// cancel it.
+	Authenticators []github_com_iov_one_weave.Condition `protobuf:"bytes,2,rep,name=authenticators,proto3,casttype=github.com/iov-one/weave.Condition" json:"authenticators,omitempty"`
+	// Run at is the time of the next execution.
+	RunAt github_com_iov_one_weave.UnixTime `protobuf:"varint,3,opt,name=run_at,json=runAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"run_at,omitempty"`
+	// Interval is the time between executions of a recurring task. Zero value
+	// means that the task is executed only once.
+	Interval github_com_iov_one_weave.UnixDuration `protobuf:"varint,4,opt,name=interval,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"interval,omitempty"`
+	// End time, if set, is the time after which a recurring task is no longer
+	// executed.
+	EndTime github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"end_time,omitempty"`
+	// Max runs, if set, limits the number of executions of a recurring task.
+	MaxRuns int64 `protobuf:"varint,6,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// Runs is the number of times this task was executed.
+	Runs int64 `protobuf:"varint,7,opt,name=runs,proto3" json:"runs,omitempty"`
+	// Run at height, if set, is the block height of the next execution. A task
+	// scheduled by height has no run at time.
+	RunAtHeight int64 `protobuf:"varint,8,opt,name=run_at_height,json=runAtHeight,proto3" json:"run_at_height,omitempty"`
+	// Height interval is the number of blocks between executions of a
+	// recurring task scheduled by height. Zero value means that the task is
+	// executed only once.
+	HeightInterval int64 `protobuf:"varint,9,opt,name=height_interval,json=heightInterval,proto3" json:"height_interval,omitempty"`
+	// End height, if set, is the block height after which a recurring task
+	// scheduled by height is no longer executed.
+	EndHeight int64 `protobuf:"varint,10,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *Task) Reset()         { *m = Task{} }
+func (m *Task) String() string { return proto.CompactTextString(m) }
+func (*Task) ProtoMessage()    {}
+func (*Task) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed99bc993a5d5798, []int{1}
+}
+func (m *Task) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Task) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Task.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Task) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Task.Merge(m, src)
+}
+func (m *Task) XXX_Size() int {
+	return m.Size()
+}
+func (m *Task) XXX_DiscardUnknown() {
+	xxx_messageInfo_Task.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Task proto.InternalMessageInfo
+
+func (m *Task) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Task) GetAuthenticators() []github_com_iov_one_weave.Condition {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
+func (m *Task) GetRunAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.RunAt
+	}
+	return 0
+}
+
+func (m *Task) GetInterval() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Task) GetEndTime() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *Task) GetMaxRuns() int64 {
+	if m != nil {
+		return m.MaxRuns
+	}
+	return 0
+}
+
+func (m *Task) GetRuns() int64 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
+}
+
+func (m *Task) GetRunAtHeight() int64 {
+	if m != nil {
+		return m.RunAtHeight
+	}
+	return 0
+}
+
+func (m *Task) GetHeightInterval() int64 {
+	if m != nil {
+		return m.HeightInterval
+	}
+	return 0
+}
+
+func (m *Task) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// CancelTaskMsg removes a task from the queue before it is executed. It must
+// be authorized by all conditions that authenticate the task execution.
+type CancelTaskMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TaskID   []byte          `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *CancelTaskMsg) Reset()         { *m = CancelTaskMsg{} }
+func (m *CancelTaskMsg) String() string { return proto.CompactTextString(m) }
+func (*CancelTaskMsg) ProtoMessage()    {}
+func (*CancelTaskMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed99bc993a5d5798, []int{2}
+}
+func (m *CancelTaskMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelTaskMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelTaskMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelTaskMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelTaskMsg.Merge(m, src)
+}
+func (m *CancelTaskMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelTaskMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelTaskMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelTaskMsg proto.InternalMessageInfo
+
+func (m *CancelTaskMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CancelTaskMsg) GetTaskID() []byte {
+	if m != nil {
+		return m.TaskID
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TaskResult)(nil), "cron.TaskResult")
+	proto.RegisterType((*Task)(nil), "cron.Task")
+	proto.RegisterType((*CancelTaskMsg)(nil), "cron.CancelTaskMsg")
 }
 
 func init() { proto.RegisterFile("x/cron/codec.proto", fileDescriptor_ed99bc993a5d5798) }
 
 var fileDescriptor_ed99bc993a5d5798 = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xcf, 0x41, 0x4b, 0xfb, 0x30,
-	0x18, 0xc7, 0xf1, 0xe6, 0xbf, 0xfe, 0xa5, 0x7b, 0x7a, 0x10, 0x82, 0x87, 0xb0, 0x43, 0x56, 0x05,
-	0xa1, 0x20, 0xb6, 0xa0, 0xef, 0xa0, 0x27, 0x2f, 0x5e, 0xc2, 0x3c, 0x4b, 0x97, 0x3d, 0x6b, 0x83,
-	0x6b, 0x1f, 0x59, 0xd3, 0xd9, 0x97, 0xe1, 0xcb, 0xf2, 0x38, 0x3c, 0x79, 0x12, 0x69, 0xdf, 0x85,
-	0x27, 0x49, 0x27, 0xb2, 0xdb, 0x97, 0x0f, 0xc9, 0x2f, 0x04, 0x78, 0x97, 0xea, 0x2d, 0xd5, 0xa9,
-	0xa6, 0x15, 0xea, 0xe4, 0x79, 0x4b, 0x96, 0xb8, 0xef, 0x64, 0x16, 0x1e, 0xd1, 0xec, 0xac, 0xa0,
-	0x82, 0xc6, 0x4c, 0x5d, 0x1d, 0xf4, 0xe2, 0x9d, 0x01, 0x2c, 0xf2, 0xe6, 0x49, 0x61, 0xd3, 0x6e,
-	0x2c, 0xbf, 0x82, 0xa0, 0x42, 0x9b, 0xaf, 0x72, 0x9b, 0x0b, 0x16, 0xb1, 0x38, 0xbc, 0x39, 0x4d,
-	0x5e, 0x30, 0xdf, 0x61, 0x72, 0xff, 0xcb, 0xea, 0xef, 0x00, 0x97, 0x00, 0x4d, 0xab, 0x35, 0x36,
-	0xcd, 0xba, 0xdd, 0x88, 0x7f, 0x11, 0x8b, 0x03, 0x75, 0x24, 0x9c, 0x83, 0x6f, 0xea, 0x35, 0x89,
-	0x49, 0xc4, 0xe2, 0xa9, 0x1a, 0x9b, 0x67, 0x30, 0xc5, 0x0e, 0xf5, 0xa3, 0x35, 0x15, 0x0a, 0x3f,
-	0x62, 0xf1, 0x24, 0xbb, 0xfc, 0xfe, 0x9c, 0x9f, 0x17, 0xc6, 0x96, 0xed, 0x32, 0xd1, 0x54, 0xa5,
-	0x86, 0x76, 0xd7, 0x54, 0x63, 0x7a, 0x78, 0xf7, 0xa1, 0x36, 0xdd, 0xc2, 0x54, 0xa8, 0x02, 0x77,
-	0xcf, 0x15, 0x9f, 0x43, 0x38, 0x6e, 0x94, 0x68, 0x8a, 0xd2, 0x8a, 0xff, 0x6e, 0x45, 0x81, 0xa3,
-	0xbb, 0x51, 0x32, 0xf1, 0xd6, 0x4b, 0xb6, 0xef, 0x25, 0xfb, 0xea, 0x25, 0x7b, 0x1d, 0xa4, 0xb7,
-	0x1f, 0xa4, 0xf7, 0x31, 0x48, 0x6f, 0x79, 0x32, 0xfe, 0xfa, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0x76, 0x03, 0x46, 0xbc, 0x34, 0x01, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
+	0x1c, 0x6d, 0x36, 0x6d, 0x9a, 0xfe, 0xba, 0x7f, 0x60, 0xf0, 0x10, 0x17, 0x4c, 0x62, 0x65, 0x35,
+	0x22, 0x36, 0xa0, 0x57, 0x0f, 0x9a, 0x5d, 0xc1, 0x1e, 0xd6, 0x43, 0x58, 0xcf, 0x61, 0x36, 0x99,
+	0x4d, 0x87, 0x6d, 0x66, 0x24, 0x33, 0x53, 0xf3, 0x31, 0xfc, 0x58, 0x1e, 0x17, 0x4f, 0x9e, 0x8a,
+	0xb4, 0xf8, 0x25, 0x7a, 0x92, 0x99, 0x74, 0x4b, 0x11, 0x5c, 0xe8, 0xed, 0xf1, 0x7e, 0x7f, 0xf2,
+	0xde, 0xef, 0x65, 0x00, 0x35, 0x71, 0x5e, 0x73, 0x16, 0xe7, 0xbc, 0x20, 0xf9, 0xf8, 0x6b, 0xcd,
+	0x25, 0x47, 0x5d, 0xcd, 0x9c, 0x0e, 0x77, 0xa8, 0xd3, 0x47, 0x25, 0x2f, 0xb9, 0x81, 0xb1, 0x46,
+	0x2d, 0x3b, 0xfa, 0x69, 0x01, 0x5c, 0x61, 0x71, 0x9b, 0x12, 0xa1, 0x66, 0x12, 0xbd, 0x02, 0xb7,
+	0x22, 0x12, 0x17, 0x58, 0x62, 0xcf, 0x0a, 0xad, 0x68, 0xf8, 0xe6, 0x64, 0xfc, 0x8d, 0xe0, 0x39,
+	0x19, 0x5f, 0x6e, 0xe8, 0x74, 0xdb, 0x80, 0x7c, 0x00, 0xa1, 0xf2, 0x9c, 0x08, 0x71, 0xa3, 0x66,
+	0xde, 0x41, 0x68, 0x45, 0x6e, 0xba, 0xc3, 0x20, 0x04, 0x5d, 0xca, 0x6e, 0xb8, 0x67, 0x87, 0x56,
+	0x34, 0x48, 0x0d, 0x46, 0x09, 0x0c, 0x48, 0x43, 0xf2, 0x4c, 0xd2, 0x8a, 0x78, 0xdd, 0xd0, 0x8a,
+	0xec, 0xe4, 0x6c, 0xbd, 0x08, 0x9e, 0x96, 0x54, 0x4e, 0xd5, 0xf5, 0x38, 0xe7, 0x55, 0x4c, 0xf9,
+	0xfc, 0x35, 0x67, 0x24, 0x6e, 0xbf, 0xfb, 0x85, 0xd1, 0xe6, 0x8a, 0x56, 0x24, 0x75, 0xf5, 0x9c,
+	0x46, 0x28, 0x80, 0xa1, 0xd9, 0x31, 0x25, 0xb4, 0x9c, 0x4a, 0xaf, 0xa7, 0xb7, 0xa4, 0xa0, 0xa9,
+	0x4f, 0x86, 0x19, 0xfd, 0xb1, 0xa1, 0xab, 0x4d, 0xed, 0x67, 0xe7, 0x33, 0x1c, 0x63, 0x25, 0xa7,
+	0x84, 0x49, 0x9a, 0x63, 0xc9, 0x6b, 0xe1, 0x1d, 0x84, 0x76, 0x74, 0x98, 0x3c, 0x5f, 0x2f, 0x82,
+	0xd1, 0x7f, 0xf5, 0x9d, 0x73, 0x56, 0x50, 0x49, 0x39, 0x4b, 0xff, 0x99, 0x46, 0xef, 0xc0, 0xa9,
+	0x15, 0xcb, 0xb0, 0xf4, 0xec, 0x7d, 0x7c, 0xf6, 0x6a, 0xc5, 0x3e, 0x48, 0xf4, 0x11, 0x5c, 0xca,
+	0x24, 0xa9, 0xe7, 0x78, 0xb6, 0xb9, 0xd3, 0xcb, 0xf5, 0x22, 0x38, 0x7b, 0x70, 0xfe, 0x42, 0xd5,
+	0xd8, 0x48, 0xd9, 0x8e, 0xa2, 0xf7, 0xe0, 0x12, 0x56, 0xb4, 0xe7, 0xee, 0xed, 0x23, 0xa3, 0x4f,
+	0x58, 0xa1, 0x01, 0x7a, 0x0c, 0x6e, 0x85, 0x9b, 0xac, 0x56, 0x4c, 0x78, 0x8e, 0x39, 0x75, 0xbf,
+	0xc2, 0x4d, 0xaa, 0x98, 0xd0, 0x01, 0x1b, 0xba, 0x6f, 0x68, 0x83, 0xd1, 0x08, 0x8e, 0x5a, 0xd7,
+	0xf7, 0xf1, 0xb8, 0xa6, 0x38, 0x34, 0xae, 0xda, 0x7c, 0xd0, 0x0b, 0x38, 0x69, 0x8b, 0xd9, 0xd6,
+	0xe2, 0xc0, 0x74, 0x1d, 0xb7, 0xf4, 0xe4, 0x5e, 0xfd, 0x13, 0x00, 0xad, 0x7e, 0xb3, 0x09, 0x4c,
+	0xcf, 0x80, 0xb0, 0x62, 0x93, 0x33, 0x86, 0xa3, 0x73, 0xcc, 0x72, 0x32, 0xd3, 0x61, 0x5f, 0x8a,
+	0x72, 0xbf, 0xbc, 0x9f, 0x41, 0x5f, 0x62, 0x71, 0x9b, 0xd1, 0xc2, 0xfc, 0xbb, 0x87, 0x09, 0x2c,
+	0x17, 0x81, 0xa3, 0x57, 0x4d, 0x2e, 0x52, 0x47, 0x97, 0x26, 0x45, 0xe2, 0xfd, 0x58, 0xfa, 0xd6,
+	0xdd, 0xd2, 0xb7, 0x7e, 0x2f, 0x7d, 0xeb, 0xfb, 0xca, 0xef, 0xdc, 0xad, 0xfc, 0xce, 0xaf, 0x95,
+	0xdf, 0xb9, 0x76, 0xcc, 0x03, 0x7a, 0xfb, 0x77, 0x00, 0xbb, 0x8d, 0x5a, 0x41, 0x7f, 0x03, 0x00,
+	0x00,
 }
 
 func (m *TaskResult) Marshal() (dAtA []byte, err error) {
@@ -192,6 +397,116 @@ func (m *TaskResult) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Task) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Task) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.RunAt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RunAt))
+	}
+	if m.Interval != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Interval))
+	}
+	if m.EndTime != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EndTime))
+	}
+	if m.MaxRuns != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxRuns))
+	}
+	if m.Runs != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Runs))
+	}
+	if m.RunAtHeight != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RunAtHeight))
+	}
+	if m.HeightInterval != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.HeightInterval))
+	}
+	if m.EndHeight != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EndHeight))
+	}
+	return i, nil
+}
+
+func (m *CancelTaskMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelTaskMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.TaskID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskID)))
+		i += copy(dAtA[i:], m.TaskID)
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -227,6 +542,66 @@ func (m *TaskResult) Size() (n int) {
 	return n
 }
 
+func (m *Task) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.RunAt != 0 {
+		n += 1 + sovCodec(uint64(m.RunAt))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovCodec(uint64(m.Interval))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovCodec(uint64(m.EndTime))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovCodec(uint64(m.MaxRuns))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovCodec(uint64(m.Runs))
+	}
+	if m.RunAtHeight != 0 {
+		n += 1 + sovCodec(uint64(m.RunAtHeight))
+	}
+	if m.HeightInterval != 0 {
+		n += 1 + sovCodec(uint64(m.HeightInterval))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovCodec(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *CancelTaskMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -419,6 +794,402 @@ func (m *TaskResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Task) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, make([]byte, postIndex-iNdEx))
+			copy(m.Authenticators[len(m.Authenticators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAt", wireType)
+			}
+			m.RunAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+			}
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAtHeight", wireType)
+			}
+			m.RunAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightInterval", wireType)
+			}
+			m.HeightInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeightInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelTaskMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelTaskMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelTaskMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskID = append(m.TaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskID == nil {
+				m.TaskID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCodec(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package cron
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"fmt"
@@ -31,12 +32,16 @@ type TaskMarshaler interface {
 //
 // Always use the same marshaler for ticker and scheduler.
 func NewScheduler(enc TaskMarshaler) *Scheduler {
-	return &Scheduler{enc: enc}
+	return &Scheduler{
+		enc:   enc,
+		tasks: NewTaskBucket(),
+	}
 }
 
 // Scheduler is the weave.Scheduler implementation.
 type Scheduler struct {
-	enc TaskMarshaler
+	enc   TaskMarshaler
+	tasks orm.ModelBucket
 }
 
 var _ weave.Scheduler = (*Scheduler)(nil)
@@ -44,49 +49,146 @@ var _ weave.Scheduler = (*Scheduler)(nil)
 // Schedule implements weave.Scheduler interface.
 //
 // Due to the implementation details, transaction is guaranteed to be executed
-// after given time, but not exactly at given time. If another transaction is
-// already scheduled for the exact same time, execution of this transaction is
-// delayed until the next free slot.
+// after given time, but not exactly at given time. Tasks scheduled for the
+// same time are executed in the order they were scheduled.
 //
 // Time granularity is second.
 func (s *Scheduler) Schedule(db weave.KVStore, runAt time.Time, auth []weave.Condition, msg weave.Msg) ([]byte, error) {
+	return s.schedule(db, runAt, Recurrence{}, auth, msg)
+}
+
+// Recurrence declares how a recurring task is repeated.
+type Recurrence struct {
+	// Interval is the time between two executions. It must be greater
+	// than zero.
+	Interval weave.UnixDuration
+	// EndTime, if set, is the time after which the task is no longer
+	// executed.
+	EndTime weave.UnixTime
+	// MaxRuns, if set, limits the number of executions.
+	MaxRuns int64
+}
+
+// ScheduleRecurring queues given message in the database to be executed for
+// the first time at given time and then repeatedly, as declared by the
+// recurrence. Message is executed with context containing provided
+// authentication addresses. When successful, returns the scheduled task ID
+// that remains the same for all executions.
+//
+// Executions that are due while no block is created are not caught up. The
+// task is executed once and the next execution is scheduled after the
+// current block time.
+func (s *Scheduler) ScheduleRecurring(db weave.KVStore, runAt time.Time, rec Recurrence, auth []weave.Condition, msg weave.Msg) ([]byte, error) {
+	if rec.Interval <= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "interval must be greater than zero")
+	}
+	if rec.MaxRuns < 0 {
+		return nil, errors.Wrap(errors.ErrInput, "max runs must not be negative")
+	}
+	return s.schedule(db, runAt, rec, auth, msg)
+}
+
+// HeightRecurrence declares how a recurring task scheduled by block height is
+// repeated.
+type HeightRecurrence struct {
+	// Interval is the number of blocks between two executions. It must be
+	// greater than zero.
+	Interval int64
+	// EndHeight, if set, is the block height after which the task is no
+	// longer executed.
+	EndHeight int64
+	// MaxRuns, if set, limits the number of executions.
+	MaxRuns int64
+}
+
+// ScheduleAtHeight queues given message in the database to be executed once,
+// at the beginning of the block with given height. A block height that was
+// already reached is executed with the next block. When successful, returns
+// the scheduled task ID.
+func (s *Scheduler) ScheduleAtHeight(db weave.KVStore, height int64, auth []weave.Condition, msg weave.Msg) ([]byte, error) {
+	if height <= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "height must be greater than zero")
+	}
+	task := Task{
+		Metadata:       &weave.Metadata{Schema: 1},
+		Authenticators: auth,
+		RunAtHeight:    height,
+	}
+	return s.queue(db, &task, msg)
+}
+
+// ScheduleRecurringAtHeight queues given message in the database to be
+// executed for the first time at the block with given height and then
+// repeatedly, as declared by the recurrence. When successful, returns the
+// scheduled task ID that remains the same for all executions.
+//
+// Executions that are due while the task is not processed, for example
+// because too many tasks are queued, are not caught up.
+func (s *Scheduler) ScheduleRecurringAtHeight(db weave.KVStore, height int64, rec HeightRecurrence, auth []weave.Condition, msg weave.Msg) ([]byte, error) {
+	if height <= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "height must be greater than zero")
+	}
+	if rec.Interval <= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "interval must be greater than zero")
+	}
+	if rec.EndHeight < 0 {
+		return nil, errors.Wrap(errors.ErrInput, "end height must not be negative")
+	}
+	if rec.MaxRuns < 0 {
+		return nil, errors.Wrap(errors.ErrInput, "max runs must not be negative")
+	}
+	task := Task{
+		Metadata:       &weave.Metadata{Schema: 1},
+		Authenticators: auth,
+		RunAtHeight:    height,
+		HeightInterval: rec.Interval,
+		EndHeight:      rec.EndHeight,
+		MaxRuns:        rec.MaxRuns,
+	}
+	return s.queue(db, &task, msg)
+}
+
+func (s *Scheduler) schedule(db weave.KVStore, runAt time.Time, rec Recurrence, auth []weave.Condition, msg weave.Msg) ([]byte, error) {
 	const granularity = time.Second
 	runAt = roundT(runAt, granularity)
 
-	raw, err := s.enc.MarshalTask(auth, msg)
+	task := Task{
+		Metadata:       &weave.Metadata{Schema: 1},
+		Authenticators: auth,
+		RunAt:          weave.AsUnixTime(runAt),
+		Interval:       rec.Interval,
+		EndTime:        rec.EndTime,
+		MaxRuns:        rec.MaxRuns,
+	}
+	return s.queue(db, &task, msg)
+}
+
+// queue stores the schedule of a new task and queues the task for its first
+// execution.
+func (s *Scheduler) queue(db weave.KVStore, task *Task, msg weave.Msg) ([]byte, error) {
+	raw, err := s.enc.MarshalTask(task.Authenticators, msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "marshal task")
 	}
 
-	// We use execution time as the queue value and to keep it unique, we
-	// increment the execution time if a taks is already scheduled at given
-	// moment.
-	// As a security measure add a limit for how many keys can be tried to
-	// avoid a potential spam issue.
-	const maxTries = 100
-	for try := 0; try < maxTries; try++ {
-		key := queueKey(runAt)
-		if ok, err := db.Has(key); err != nil {
-			return nil, errors.Wrap(err, "cannot check key existance")
-		} else if ok {
-			// If the key is already in use, instead of storing a
-			// list of messages under each key, which is a very
-			// unlikely to happen, increase the execution time by
-			// the smallest duration.
-			// Message is guaranteed to be executed not earlier
-			// than given time, NOT at exactly given time.
-			runAt = runAt.Add(granularity)
-			continue
-		}
-
-		if err := db.Set(key, raw); err != nil {
-			return nil, errors.Wrap(err, "cannot store in queue")
-		}
-		return key, nil
+	// Each task is assigned a unique ID that is also part of the queue
+	// key. This keeps the queue key unique even if several tasks are
+	// scheduled for the same time.
+	taskID, err := taskSeq.NextVal(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot acquire task ID")
+	}
+	if _, err := s.tasks.Put(db, taskID, task); err != nil {
+		return nil, errors.Wrap(err, "cannot store task")
+	}
+	if err := db.Set(taskQueueKey(task, taskID), raw); err != nil {
+		return nil, errors.Wrap(err, "cannot store in queue")
 	}
-	return nil, errors.Wrap(errors.ErrState, "too many tasks scheduled for this time")
+	return taskID, nil
 }
 
+var taskSeq = orm.NewSequence("crontask", "id")
+
 // roundT returns given time, rounded up to given granularity. Returned time is
 // never before the given one.
 func roundT(t time.Time, granularity time.Duration) time.Time {
@@ -100,18 +202,91 @@ func roundT(t time.Time, granularity time.Duration) time.Time {
 	return rounded
 }
 
-func queueKey(t time.Time) []byte {
+const queuePrefix = "_crontask:runat:"
+
+// queueKey returns the key that a task with given ID, executed at given time,
+// is stored under in the queue. Keys are ordered by the execution time and
+// then by the task ID.
+//
+// Tasks scheduled before task IDs were introduced use the execution time only
+// and the whole queue key is their ID.
+func queueKey(t time.Time, taskID []byte) []byte {
 	rawTime := make([]byte, 8)
 	// Zero time does not need to put any data as the bytes are already set
 	// to zero.
 	if !t.IsZero() {
 		binary.BigEndian.PutUint64(rawTime, uint64(t.UnixNano()))
 	}
-	return append([]byte("_crontask:runat:"), rawTime...)
+	key := append([]byte(queuePrefix), rawTime...)
+	return append(key, taskID...)
+}
+
+const heightQueuePrefix = "_crontask:runheight:"
+
+// heightQueueKey returns the key that a task with given ID, executed at given
+// block height, is stored under in the queue. Keys are ordered by the height
+// and then by the task ID.
+func heightQueueKey(height int64, taskID []byte) []byte {
+	rawHeight := make([]byte, 8)
+	binary.BigEndian.PutUint64(rawHeight, uint64(height))
+	key := append([]byte(heightQueuePrefix), rawHeight...)
+	return append(key, taskID...)
+}
+
+// taskQueueKey returns the key that given task, stored under given ID, is
+// queued under.
+func taskQueueKey(task *Task, taskID []byte) []byte {
+	if task.RunAtHeight != 0 {
+		return heightQueueKey(task.RunAtHeight, taskID)
+	}
+	return queueKey(task.RunAt.Time(), taskID)
+}
+
+// lastHeightKey is the key that the height of the last processed block is
+// stored under, as long as tasks scheduled by height are queued. It allows to
+// rebase their schedule when the state is exported.
+var lastHeightKey = []byte("_crontask:height")
+
+// taskIDFromQueueKey returns the ID of the task stored under given queue key.
+func taskIDFromQueueKey(key []byte) []byte {
+	if bytes.HasPrefix(key, []byte(heightQueuePrefix)) {
+		return key[len(heightQueuePrefix)+8:]
+	}
+	if len(key) <= len(queuePrefix)+8 {
+		// Queue key of a task scheduled before task IDs were
+		// introduced.
+		return key
+	}
+	return key[len(queuePrefix)+8:]
 }
 
 // Delete implements weave.Scheduler interface.
 func (s *Scheduler) Delete(db weave.KVStore, taskID []byte) error {
+	return deleteTask(db, s.tasks, taskID)
+}
+
+// deleteTask removes the task with given ID from the queue.
+func deleteTask(db weave.KVStore, tasks orm.ModelBucket, taskID []byte) error {
+	var task Task
+	switch err := tasks.One(db, taskID, &task); {
+	case err == nil:
+		if err := db.Delete(taskQueueKey(&task, taskID)); err != nil {
+			return errors.Wrap(err, "cannot delete from queue")
+		}
+		if err := tasks.Delete(db, taskID); err != nil {
+			return errors.Wrap(err, "cannot delete task")
+		}
+		return nil
+	case errors.ErrNotFound.Is(err):
+		// Task scheduled before task IDs were introduced is
+		// identified by its queue key.
+	default:
+		return errors.Wrap(err, "cannot load task")
+	}
+
+	if !bytes.HasPrefix(taskID, []byte(queuePrefix)) {
+		return errors.Wrap(errors.ErrNotFound, "no task")
+	}
 	if ok, err := db.Has(taskID); err != nil {
 		return errors.Wrap(err, "failed to check existence of key")
 	} else if !ok {
@@ -133,6 +308,7 @@ func NewTicker(h weave.Handler, enc TaskMarshaler) *Ticker {
 		hn:      h,
 		enc:     enc,
 		results: NewTaskResultBucket(),
+		tasks:   NewTaskBucket(),
 	}
 }
 
@@ -142,6 +318,7 @@ type Ticker struct {
 	hn      weave.Handler
 	enc     TaskMarshaler
 	results orm.ModelBucket
+	tasks   orm.ModelBucket
 }
 
 var _ weave.Ticker = (*Ticker)(nil)
@@ -212,7 +389,7 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 	// run.
 	const maxExecuted = 50
 	for proc := 0; proc < maxExecuted; proc++ {
-		switch key, raw, err := peek(db, now); {
+		switch key, raw, err := peek(db, now, blockHeight); {
 		case err == nil:
 			// Each task is processed using its own cache instance
 			// to ensure changes are atomic and task processing
@@ -251,7 +428,10 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 				}
 			}
 
-			if _, err := t.results.Put(cache, key, &res); err != nil {
+			// Result of a recurring task is overwritten by every
+			// execution.
+			taskID := taskIDFromQueueKey(key)
+			if _, err := t.results.Put(cache, taskID, &res); err != nil {
 				cache.Discard()
 				return tags, vDiff, errors.Wrap(err, "cannot store result")
 			}
@@ -261,6 +441,10 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 				cache.Discard()
 				return tags, vDiff, errors.Wrap(err, "cannot delete task")
 			}
+			if err := t.reschedule(cache, taskID, raw, now, blockHeight); err != nil {
+				cache.Discard()
+				return tags, vDiff, errors.Wrap(err, "cannot reschedule task")
+			}
 			if err := cache.Write(); err != nil {
 				cache.Discard()
 				return tags, vDiff, errors.Wrap(err, "cannot write cache")
@@ -273,27 +457,109 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 			tags = append(tags, taskTags...)
 			tags = append(tags, common.KVPair{
 				Key:   []byte("cron"),
-				Value: key,
+				Value: taskID,
 			})
 			vDiff = append(vDiff, taskDiff...)
 		case errors.ErrEmpty.Is(err):
 			// No more messages queued for execution at this time.
-			return tags, vDiff, nil
+			return tags, vDiff, trackHeight(db, blockHeight)
 		default:
 			return tags, vDiff, errors.Wrap(err, "cannot pop queue")
 		}
 	}
 
-	return tags, vDiff, nil
+	return tags, vDiff, trackHeight(db, blockHeight)
+}
+
+// trackHeight stores the current block height if any task scheduled by
+// height is queued. Otherwise the stored height is removed.
+func trackHeight(db weave.KVStore, height int64) error {
+	it, err := db.Iterator([]byte(heightQueuePrefix), prefixEnd(heightQueuePrefix))
+	if err != nil {
+		return errors.Wrap(err, "cannot create iterator")
+	}
+	_, _, err = it.Next()
+	it.Release()
+	switch {
+	case err == nil:
+		raw := make([]byte, 8)
+		binary.BigEndian.PutUint64(raw, uint64(height))
+		if err := db.Set(lastHeightKey, raw); err != nil {
+			return errors.Wrap(err, "cannot store height")
+		}
+	case errors.ErrIteratorDone.Is(err):
+		if err := db.Delete(lastHeightKey); err != nil {
+			return errors.Wrap(err, "cannot delete height")
+		}
+	default:
+		return errors.Wrap(err, "cannot get next item")
+	}
+	return nil
+}
+
+// prefixEnd returns the first key that does not start with given prefix.
+func prefixEnd(prefix string) []byte {
+	end := []byte(prefix)
+	end[len(end)-1]++
+	return end
+}
+
+// reschedule queues the next execution of a recurring task that was just
+// executed. The schedule of a task that is not executed anymore is deleted.
+func (t *Ticker) reschedule(db weave.KVStore, taskID, raw []byte, now time.Time, height int64) error {
+	var task Task
+	switch err := t.tasks.One(db, taskID, &task); {
+	case err == nil:
+		// Continue below.
+	case errors.ErrNotFound.Is(err):
+		// Either a task scheduled before task IDs were introduced or
+		// a task that was cancelled during its execution.
+		return nil
+	default:
+		return errors.Wrap(err, "cannot load task")
+	}
+
+	task.Runs++
+	var ok bool
+	if task.RunAtHeight != 0 {
+		task.RunAtHeight, ok = task.nextRunHeight(height)
+	} else {
+		var next time.Time
+		next, ok = task.nextRun(now)
+		task.RunAt = weave.AsUnixTime(next)
+	}
+	if !ok {
+		if err := t.tasks.Delete(db, taskID); err != nil {
+			return errors.Wrap(err, "cannot delete task")
+		}
+		return nil
+	}
+	if _, err := t.tasks.Put(db, taskID, &task); err != nil {
+		return errors.Wrap(err, "cannot store task")
+	}
+	if err := db.Set(taskQueueKey(&task, taskID), raw); err != nil {
+		return errors.Wrap(err, "cannot store in queue")
+	}
+	return nil
+}
+
+// peek reads from the queue a single task that reached its execution time or
+// block height and returns its queue key and encoded value. It returns
+// ErrEmpty if there is no message suitable for processing.
+// Tasks scheduled by time are consumed first, in order of execution time,
+// starting with the oldest. Tasks scheduled by height are consumed next, in
+// order of the execution height.
+func peek(db weave.KVStore, now time.Time, height int64) (id, raw []byte, err error) {
+	since := queueKey(time.Time{}, nil) // Zero time is early enough.
+	until := queueKey(now, nil)
+	if key, value, err := peekRange(db, since, until); !errors.ErrEmpty.Is(err) {
+		return key, value, err
+	}
+	return peekRange(db, heightQueueKey(0, nil), heightQueueKey(height+1, nil))
 }
 
-// peek reads from the queue a single task that reached its execution time and
-// returns it encoded value and ID. It returns ErrEmpty if there is no message
-// suitable for processing.
-// Tasks are consumed in order of execution time, starting with the oldest.
-func peek(db weave.KVStore, now time.Time) (id, raw []byte, err error) {
-	since := queueKey(time.Time{}) // Zero time is early enough.
-	until := queueKey(now)
+// peekRange returns the first queued task stored under a key in given range.
+func peekRange(db weave.KVStore, since, until []byte) (id, raw []byte, err error) {
 	it, err := db.Iterator(since, until)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cannot create iterator")
@@ -14,11 +14,13 @@ import (
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/tendermint/tendermint/libs/common"
 )
 
 func TestSchedulerDelete(t *testing.T) {
 	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
 	now := time.Now()
 
 	enc := NewTestTaskMarshaler(&weavetest.Msg{})
@@ -92,6 +94,7 @@ func TestRoundT(t *testing.T) {
 func TestTaskQueue(t *testing.T) {
 	now := time.Now()
 	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
 
 	enc := NewTestTaskMarshaler(&weavetest.Msg{})
 	s := NewScheduler(enc)
@@ -106,7 +109,7 @@ func TestTaskQueue(t *testing.T) {
 		t.Fatalf("cannot schedule third message: %s", err)
 	}
 
-	if key, _, err := peek(db, now.Add(-time.Hour)); !errors.ErrEmpty.Is(err) {
+	if key, _, err := peek(db, now.Add(-time.Hour), 0); !errors.ErrEmpty.Is(err) {
 		t.Logf("key: %q", key)
 		t.Fatalf("want no task, got %+v", err)
 	}
@@ -119,7 +122,7 @@ func TestTaskQueue(t *testing.T) {
 		"test/2",
 	}
 	for _, want := range wantPaths {
-		key, raw, err := peek(db, now)
+		key, raw, err := peek(db, now, 0)
 		if err != nil {
 			t.Fatalf("want task with message path %q, got %+v", want, err)
 		}
@@ -369,6 +372,245 @@ func TestTicker(t *testing.T) {
 	}
 }
 
+func TestRecurringTask(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+
+	cases := map[string]struct {
+		Recurrence Recurrence
+		// Ticks is a list of times, relative to now, the ticker is
+		// called at.
+		Ticks []time.Duration
+		// WantExec declares for each tick if the task is executed.
+		WantExec []bool
+		// WantDeleted is true if the task is not executed anymore.
+		WantDeleted bool
+	}{
+		"limited number of runs": {
+			Recurrence: Recurrence{
+				Interval: weave.AsUnixDuration(10 * time.Second),
+				MaxRuns:  3,
+			},
+			Ticks:       []time.Duration{time.Second, 5 * time.Second, 11 * time.Second, 21 * time.Second, time.Hour},
+			WantExec:    []bool{true, false, true, true, false},
+			WantDeleted: true,
+		},
+		"limited by the end time": {
+			Recurrence: Recurrence{
+				Interval: weave.AsUnixDuration(10 * time.Second),
+				EndTime:  weave.AsUnixTime(now.Add(15 * time.Second)),
+			},
+			Ticks:       []time.Duration{time.Second, 11 * time.Second, 21 * time.Second},
+			WantExec:    []bool{true, true, false},
+			WantDeleted: true,
+		},
+		"missed executions are not caught up": {
+			Recurrence: Recurrence{
+				Interval: weave.AsUnixDuration(10 * time.Second),
+			},
+			Ticks:       []time.Duration{time.Second, 35 * time.Second, 36 * time.Second, 39 * time.Second, 41 * time.Second},
+			WantExec:    []bool{true, true, false, false, true},
+			WantDeleted: false,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cron")
+			enc := NewTestTaskMarshaler(&weavetest.Msg{})
+			scheduler := NewScheduler(enc)
+			ticker := NewTicker(&cronHandler{}, enc)
+
+			tid, err := scheduler.ScheduleRecurring(db, now, tc.Recurrence, nil, &weavetest.Msg{RoutePath: "test/1"})
+			if err != nil {
+				t.Fatalf("cannot schedule: %s", err)
+			}
+
+			for i, tick := range tc.Ticks {
+				ctx := weave.WithBlockTime(context.Background(), now.Add(tick))
+				ctx = weave.WithHeight(ctx, int64(i+1))
+				tags, _, err := ticker.tick(ctx, db)
+				if err != nil {
+					t.Fatalf("tick %d: %s", i, err)
+				}
+				if got := containsPairValue(tags, tid); got != tc.WantExec[i] {
+					t.Fatalf("tick %d: want execution %v, got %v", i, tc.WantExec[i], got)
+				}
+			}
+
+			// Once all executions are done, the task is deleted.
+			if err := NewTaskBucket().Has(db, tid); tc.WantDeleted != errors.ErrNotFound.Is(err) {
+				t.Fatalf("want task deleted %v, got %+v", tc.WantDeleted, err)
+			}
+		})
+	}
+}
+
+func TestScheduleRecurringValidation(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+	s := NewScheduler(NewTestTaskMarshaler(&weavetest.Msg{}))
+
+	if _, err := s.ScheduleRecurring(db, time.Now(), Recurrence{}, nil, &weavetest.Msg{}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for zero interval, got %+v", err)
+	}
+	rec := Recurrence{Interval: weave.AsUnixDuration(time.Hour), MaxRuns: -1}
+	if _, err := s.ScheduleRecurring(db, time.Now(), rec, nil, &weavetest.Msg{}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for negative max runs, got %+v", err)
+	}
+}
+
+func TestHeightRecurringTask(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+
+	cases := map[string]struct {
+		Height     int64
+		Recurrence HeightRecurrence
+		// Ticks is a list of block heights the ticker is called at.
+		Ticks []int64
+		// WantExec declares for each tick if the task is executed.
+		WantExec []bool
+		// WantDeleted is true if the task is not executed anymore.
+		WantDeleted bool
+	}{
+		"limited number of runs": {
+			Height:      3,
+			Recurrence:  HeightRecurrence{Interval: 2, MaxRuns: 3},
+			Ticks:       []int64{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			WantExec:    []bool{false, false, true, false, true, false, true, false, false},
+			WantDeleted: true,
+		},
+		"limited by the end height": {
+			Height:      2,
+			Recurrence:  HeightRecurrence{Interval: 3, EndHeight: 6},
+			Ticks:       []int64{2, 3, 4, 5, 6, 7, 8},
+			WantExec:    []bool{true, false, false, true, false, false, false},
+			WantDeleted: true,
+		},
+		"missed executions are not caught up": {
+			Height:      2,
+			Recurrence:  HeightRecurrence{Interval: 2},
+			Ticks:       []int64{3, 7, 8, 9, 10},
+			WantExec:    []bool{true, true, true, false, true},
+			WantDeleted: false,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cron")
+			enc := NewTestTaskMarshaler(&weavetest.Msg{})
+			scheduler := NewScheduler(enc)
+			ticker := NewTicker(&cronHandler{}, enc)
+
+			tid, err := scheduler.ScheduleRecurringAtHeight(db, tc.Height, tc.Recurrence, nil, &weavetest.Msg{RoutePath: "test/1"})
+			if err != nil {
+				t.Fatalf("cannot schedule: %s", err)
+			}
+
+			for i, height := range tc.Ticks {
+				ctx := weave.WithBlockTime(context.Background(), now.Add(time.Duration(height)*time.Second))
+				ctx = weave.WithHeight(ctx, height)
+				tags, _, err := ticker.tick(ctx, db)
+				if err != nil {
+					t.Fatalf("tick %d: %s", i, err)
+				}
+				if got := containsPairValue(tags, tid); got != tc.WantExec[i] {
+					t.Fatalf("tick %d: want execution %v, got %v", i, tc.WantExec[i], got)
+				}
+			}
+
+			// Once all executions are done, the task is deleted.
+			if err := NewTaskBucket().Has(db, tid); tc.WantDeleted != errors.ErrNotFound.Is(err) {
+				t.Fatalf("want task deleted %v, got %+v", tc.WantDeleted, err)
+			}
+			// The last block height is tracked only while a task
+			// scheduled by height is queued.
+			if ok, err := db.Has(lastHeightKey); err != nil || ok == tc.WantDeleted {
+				t.Fatalf("want last height stored %v, got %v (%v)", !tc.WantDeleted, ok, err)
+			}
+		})
+	}
+}
+
+func TestScheduleAtHeight(t *testing.T) {
+	now := time.Now()
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+	enc := NewTestTaskMarshaler(&weavetest.Msg{})
+	s := NewScheduler(enc)
+
+	byHeight, err := s.ScheduleAtHeight(db, 4, nil, &weavetest.Msg{RoutePath: "test/1"})
+	assert.Nil(t, err)
+	byTime, err := s.Schedule(db, now.Add(time.Hour), nil, &weavetest.Msg{RoutePath: "test/2"})
+	assert.Nil(t, err)
+
+	ticker := NewTicker(&cronHandler{}, enc)
+	tick := func(height int64, at time.Time) []common.KVPair {
+		t.Helper()
+		ctx := weave.WithBlockTime(context.Background(), at)
+		ctx = weave.WithHeight(ctx, height)
+		tags, _, err := ticker.tick(ctx, db)
+		assert.Nil(t, err)
+		return tags
+	}
+
+	assert.Equal(t, false, containsPairValue(tick(3, now), byHeight))
+	tags := tick(5, now)
+	assert.Equal(t, true, containsPairValue(tags, byHeight))
+	assert.Equal(t, false, containsPairValue(tags, byTime))
+
+	// Task scheduled by height is executed only once.
+	if err := NewTaskBucket().Has(db, byHeight); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("task must be deleted: %+v", err)
+	}
+	assert.Equal(t, 0, len(tick(6, now)))
+	assert.Equal(t, true, containsPairValue(tick(7, now.Add(2*time.Hour)), byTime))
+
+	if _, err := s.ScheduleAtHeight(db, 0, nil, &weavetest.Msg{}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for zero height, got %+v", err)
+	}
+	if _, err := s.ScheduleRecurringAtHeight(db, 10, HeightRecurrence{}, nil, &weavetest.Msg{}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for zero interval, got %+v", err)
+	}
+	rec := HeightRecurrence{Interval: 2, EndHeight: -1}
+	if _, err := s.ScheduleRecurringAtHeight(db, 10, rec, nil, &weavetest.Msg{}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for negative end height, got %+v", err)
+	}
+}
+
+func TestLegacyQueueKey(t *testing.T) {
+	now := time.Now()
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+	enc := NewTestTaskMarshaler(&weavetest.Msg{})
+
+	// Tasks scheduled before task IDs were introduced are stored under
+	// the execution time only.
+	raw, err := enc.MarshalTask(nil, &weavetest.Msg{RoutePath: "test/1"})
+	assert.Nil(t, err)
+	executed := queueKey(now.Add(-time.Hour), nil)
+	assert.Nil(t, db.Set(executed, raw))
+	deleted := queueKey(now.Add(-time.Minute), nil)
+	assert.Nil(t, db.Set(deleted, raw))
+
+	if err := NewScheduler(enc).Delete(db, deleted); err != nil {
+		t.Fatalf("cannot delete task: %s", err)
+	}
+
+	ctx := weave.WithBlockTime(context.Background(), now)
+	ctx = weave.WithHeight(ctx, 1)
+	tags, _, err := NewTicker(&cronHandler{}, enc).tick(ctx, db)
+	assert.Nil(t, err)
+	assert.Equal(t, true, containsPairValue(tags, executed))
+	assert.Equal(t, false, containsPairValue(tags, deleted))
+
+	var tr TaskResult
+	assert.Nil(t, NewTaskResultBucket().One(db, executed, &tr))
+	assert.Equal(t, true, tr.Successful)
+}
+
 func containsPairValue(pairs []common.KVPair, item []byte) bool {
 	for _, p := range pairs {
 		if bytes.Equal(p.Value, item) {
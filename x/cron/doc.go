@@ -4,5 +4,21 @@ Package cron implements delayed message execution.
 This package provides a queue implementation for scheduling message for
 execution in the future and weave.Ticker compatible task runner.
 
+Each scheduled task is assigned a unique ID. The queue is ordered by the
+execution time and then by the task ID, so any number of tasks can be
+scheduled for the same time. A task can be recurring. It is then executed
+repeatedly, in declared intervals, until its end time or maximum number of
+runs is reached.
+
+A task can be scheduled by block height instead of time. Such task is executed
+at the beginning of the block with that height, after all tasks that are due by
+time. Its recurrence interval is declared in blocks. When the state is
+exported, heights are rebased onto the new chain, so that the number of blocks
+remaining until the next execution is preserved.
+
+Pending tasks can be listed by the address of any of the conditions that
+authenticate their execution. Those conditions together can cancel a task
+using CancelTaskMsg. Tasks executed without authentication can be cancelled
+only by the extension that scheduled them.
 */
 package cron
@@ -0,0 +1,69 @@
+package cron
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
+)
+
+const (
+	cancelTaskCost = 0
+)
+
+// RegisterRoutes registers handlers for cron message processing.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+	r = migration.SchemaMigratingRegistry("cron", r)
+	r.Handle(&CancelTaskMsg{}, &cancelTaskHandler{
+		auth:  auth,
+		tasks: NewTaskBucket(),
+	})
+}
+
+type cancelTaskHandler struct {
+	auth  x.Authenticator
+	tasks orm.ModelBucket
+}
+
+var _ weave.Handler = (*cancelTaskHandler)(nil)
+
+func (h *cancelTaskHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: cancelTaskCost}, nil
+}
+
+func (h *cancelTaskHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := deleteTask(db, h.tasks, msg.TaskID); err != nil {
+		return nil, errors.Wrap(err, "cannot cancel task")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *cancelTaskHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*CancelTaskMsg, error) {
+	var msg CancelTaskMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	var task Task
+	if err := h.tasks.One(db, msg.TaskID, &task); err != nil {
+		return nil, errors.Wrap(err, "cannot load task")
+	}
+	// A task that is executed without authentication is owned by the
+	// extension that scheduled it and cannot be cancelled by anyone else.
+	if len(task.Authenticators) == 0 {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "task is not owned by any condition")
+	}
+	for _, c := range task.Authenticators {
+		if !h.auth.HasAddress(ctx, c.Address()) {
+			return nil, errors.Wrapf(errors.ErrUnauthorized, "%s condition is required", c)
+		}
+	}
+	return &msg, nil
+}
@@ -0,0 +1,215 @@
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/sigs"
+)
+
+func TestCancelTask(t *testing.T) {
+	alice := weavetest.NewCondition()
+	bob := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		TaskAuth []weave.Condition
+		Signers  []weave.Condition
+		TaskID   []byte
+		WantErr  *errors.Error
+	}{
+		"task owner can cancel": {
+			TaskAuth: []weave.Condition{alice},
+			Signers:  []weave.Condition{alice},
+			WantErr:  nil,
+		},
+		"all task owners must authorize": {
+			TaskAuth: []weave.Condition{alice, bob},
+			Signers:  []weave.Condition{alice},
+			WantErr:  errors.ErrUnauthorized,
+		},
+		"all task owners together can cancel": {
+			TaskAuth: []weave.Condition{alice, bob},
+			Signers:  []weave.Condition{alice, bob},
+			WantErr:  nil,
+		},
+		"task without owner cannot be cancelled": {
+			TaskAuth: nil,
+			Signers:  []weave.Condition{alice},
+			WantErr:  errors.ErrUnauthorized,
+		},
+		"task not found": {
+			TaskAuth: []weave.Condition{alice},
+			Signers:  []weave.Condition{alice},
+			TaskID:   weavetest.SequenceID(1234),
+			WantErr:  errors.ErrNotFound,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cron")
+
+			now := time.Now()
+			enc := NewTestTaskMarshaler(&weavetest.Msg{})
+			tid, err := NewScheduler(enc).Schedule(db, now.Add(time.Hour), tc.TaskAuth, &weavetest.Msg{RoutePath: "test/1"})
+			if err != nil {
+				t.Fatalf("cannot schedule: %s", err)
+			}
+			if tc.TaskID != nil {
+				tid = tc.TaskID
+			}
+
+			rt := app.NewRouter()
+			RegisterRoutes(rt, &weavetest.Auth{Signers: tc.Signers})
+			tx := &weavetest.Tx{Msg: &CancelTaskMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				TaskID:   tid,
+			}}
+			ctx := weave.WithBlockTime(context.Background(), now)
+			cache := db.CacheWrap()
+			if _, err := rt.Check(ctx, cache, tx); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			cache.Discard()
+			if _, err := rt.Deliver(ctx, db, tx); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+			if tc.WantErr != nil {
+				return
+			}
+
+			if err := NewTaskBucket().Has(db, tid); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("task must be deleted: %s", err)
+			}
+			// Cancelled task is never executed.
+			ctx = weave.WithBlockTime(context.Background(), now.Add(2*time.Hour))
+			ctx = weave.WithHeight(ctx, 1)
+			tags, _, err := NewTicker(nil, enc).tick(ctx, db)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(tags))
+		})
+	}
+}
+
+func TestCancelTaskSignedByOwner(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron", "sigs")
+
+	alice := weavetest.NewKey()
+	bob := weavetest.NewKey()
+
+	enc := NewTestTaskMarshaler(&weavetest.Msg{})
+	owners := []weave.Condition{alice.PublicKey().Condition()}
+	tid, err := NewScheduler(enc).ScheduleRecurringAtHeight(db, 10, HeightRecurrence{Interval: 5}, owners, &weavetest.Msg{RoutePath: "test/1"})
+	if err != nil {
+		t.Fatalf("cannot schedule: %s", err)
+	}
+
+	// Signers are authenticated by verifying transaction signatures, the
+	// same as for any user transaction.
+	rt := app.NewRouter()
+	RegisterRoutes(rt, x.ChainAuth(sigs.Authenticate{}))
+	h := app.ChainDecorators(sigs.NewDecorator()).WithHandler(rt)
+
+	const chainID = "test-chain"
+	ctx := weave.WithChainID(context.Background(), chainID)
+	ctx = weave.WithHeight(ctx, 5)
+	cancel := func(signer crypto.Signer) error {
+		t.Helper()
+		tx := &signedTx{Tx: weavetest.Tx{Msg: &CancelTaskMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			TaskID:   tid,
+		}}}
+		seq, err := sigs.NextNonce(db, signer.PublicKey().Condition().Address())
+		if err != nil {
+			t.Fatalf("cannot get nonce: %s", err)
+		}
+		sig, err := sigs.SignTx(signer, tx, chainID, seq)
+		if err != nil {
+			t.Fatalf("cannot sign: %s", err)
+		}
+		tx.Signatures = []*sigs.StdSignature{sig}
+		_, err = h.Deliver(ctx, db, tx)
+		return err
+	}
+
+	if err := cancel(bob); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	if err := NewTaskBucket().Has(db, tid); err != nil {
+		t.Fatalf("task must not be deleted: %s", err)
+	}
+
+	if err := cancel(alice); err != nil {
+		t.Fatalf("owner cannot cancel: %+v", err)
+	}
+	if err := NewTaskBucket().Has(db, tid); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("task must be deleted: %s", err)
+	}
+	// Cancelled task is never executed.
+	ticker := NewTicker(nil, enc)
+	for _, height := range []int64{10, 15} {
+		tctx := weave.WithBlockTime(context.Background(), time.Now())
+		tctx = weave.WithHeight(tctx, height)
+		tags, _, err := ticker.tick(tctx, db)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(tags))
+	}
+}
+
+// signedTx is a transaction authenticated by the signatures of its message.
+type signedTx struct {
+	weavetest.Tx
+	Signatures []*sigs.StdSignature
+}
+
+var _ sigs.SignedTx = (*signedTx)(nil)
+
+func (tx *signedTx) GetSignatures() []*sigs.StdSignature {
+	return tx.Signatures
+}
+
+func (tx *signedTx) GetSignBytes() ([]byte, error) {
+	return tx.Msg.Marshal()
+}
+
+func TestQueryTasksByOwner(t *testing.T) {
+	alice := weavetest.NewCondition()
+	bob := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+
+	now := time.Now()
+	s := NewScheduler(NewTestTaskMarshaler(&weavetest.Msg{}))
+	for _, auth := range [][]weave.Condition{{alice}, {alice, bob}, {bob}, nil} {
+		if _, err := s.Schedule(db, now, auth, &weavetest.Msg{}); err != nil {
+			t.Fatalf("cannot schedule: %s", err)
+		}
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+	h := qr.Handler("/crontasks/owner")
+	if h == nil {
+		t.Fatal("owner query not registered")
+	}
+
+	models, err := h.Query(db, "", alice.Address())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(models))
+	models, err = h.Query(db, "", bob.Address())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(models))
+}
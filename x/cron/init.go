@@ -0,0 +1,270 @@
+package cron
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file.
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisCron struct {
+	Tasks []genesisTask `json:"tasks"`
+	// LegacyTasks are tasks scheduled before task IDs were introduced.
+	// They are identified by their queue key.
+	LegacyTasks []genesisLegacyTask `json:"legacy_tasks,omitempty"`
+	Results     []genesisResult     `json:"results"`
+}
+
+type genesisTask struct {
+	ID             []byte             `json:"id"`
+	Authenticators []weave.Condition  `json:"authenticators,omitempty"`
+	RunAt          weave.UnixTime     `json:"run_at,omitempty"`
+	Interval       weave.UnixDuration `json:"interval,omitempty"`
+	EndTime        weave.UnixTime     `json:"end_time,omitempty"`
+	// RunAtHeight and EndHeight are heights of the chain that is created
+	// from the genesis, which starts with block 1.
+	RunAtHeight    int64 `json:"run_at_height,omitempty"`
+	HeightInterval int64 `json:"height_interval,omitempty"`
+	EndHeight      int64 `json:"end_height,omitempty"`
+	MaxRuns        int64 `json:"max_runs,omitempty"`
+	Runs           int64 `json:"runs,omitempty"`
+	// Data is the task serialized by the TaskMarshaler.
+	Data []byte `json:"data"`
+}
+
+type genesisLegacyTask struct {
+	Key  []byte `json:"key"`
+	Data []byte `json:"data"`
+}
+
+type genesisResult struct {
+	TaskID     []byte         `json:"task_id"`
+	Successful bool           `json:"successful"`
+	Info       string         `json:"info,omitempty"`
+	ExecTime   weave.UnixTime `json:"exec_time"`
+	ExecHeight int64          `json:"exec_height"`
+}
+
+// FromGenesis loads queued tasks and task results. Tasks keep their IDs, so
+// that they can be referenced by other extensions. The task sequence is
+// raised above the highest loaded ID.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	var genesis genesisCron
+	if err := opts.ReadOptions("cron", &genesis); err != nil {
+		return err
+	}
+
+	tasks := NewTaskBucket()
+	for i, g := range genesis.Tasks {
+		if err := orm.ValidateSequence(g.ID); err != nil {
+			return errors.Wrapf(err, "task %d", i)
+		}
+		switch err := tasks.Has(kv, g.ID); {
+		case err == nil:
+			return errors.Wrapf(errors.ErrDuplicate, "task %d", i)
+		case !errors.ErrNotFound.Is(err):
+			return errors.Wrapf(err, "task %d", i)
+		}
+		if len(g.Data) == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "task %d: data", i)
+		}
+		task := Task{
+			Metadata:       &weave.Metadata{Schema: 1},
+			Authenticators: g.Authenticators,
+			RunAt:          g.RunAt,
+			Interval:       g.Interval,
+			EndTime:        g.EndTime,
+			RunAtHeight:    g.RunAtHeight,
+			HeightInterval: g.HeightInterval,
+			EndHeight:      g.EndHeight,
+			MaxRuns:        g.MaxRuns,
+			Runs:           g.Runs,
+		}
+		if _, err := tasks.Put(kv, g.ID, &task); err != nil {
+			return errors.Wrapf(err, "task %d", i)
+		}
+		if err := kv.Set(taskQueueKey(&task, g.ID), g.Data); err != nil {
+			return errors.Wrapf(err, "task %d: queue", i)
+		}
+		if err := taskSeq.Raise(kv, int64(binary.BigEndian.Uint64(g.ID))); err != nil {
+			return errors.Wrapf(err, "task %d: sequence", i)
+		}
+	}
+
+	for i, g := range genesis.LegacyTasks {
+		if len(g.Key) != len(queuePrefix)+8 || !bytes.HasPrefix(g.Key, []byte(queuePrefix)) {
+			return errors.Wrapf(errors.ErrInput, "legacy task %d: invalid key", i)
+		}
+		if len(g.Data) == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "legacy task %d: data", i)
+		}
+		if err := kv.Set(g.Key, g.Data); err != nil {
+			return errors.Wrapf(err, "legacy task %d", i)
+		}
+	}
+
+	results := NewTaskResultBucket()
+	for i, g := range genesis.Results {
+		if len(g.TaskID) == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "result %d: task ID", i)
+		}
+		res := TaskResult{
+			Metadata:   &weave.Metadata{Schema: 1},
+			Successful: g.Successful,
+			Info:       g.Info,
+			ExecTime:   g.ExecTime,
+			ExecHeight: g.ExecHeight,
+		}
+		if _, err := results.Put(kv, g.TaskID, &res); err != nil {
+			return errors.Wrapf(err, "result %d", i)
+		}
+	}
+	return nil
+}
+
+// ToGenesis exports all queued tasks, together with their serialized data,
+// and all task results. Export fails if the queue does not match the task
+// schedules.
+//
+// Block heights of tasks scheduled by height are rebased, so that they are
+// relative to the height of the last processed block. A task that is due at
+// the next block is executed at the first block of the new chain.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	genesis := genesisCron{
+		Tasks:   []genesisTask{},
+		Results: []genesisResult{},
+	}
+
+	var lastHeight int64
+	switch raw, err := kv.Get(lastHeightKey); {
+	case err != nil:
+		return nil, errors.Wrap(err, "last height")
+	case len(raw) == 8:
+		lastHeight = int64(binary.BigEndian.Uint64(raw))
+	case raw != nil:
+		return nil, errors.Wrap(errors.ErrState, "invalid last height")
+	}
+
+	queued := make(map[string][]byte)
+	it := orm.IterAll("task")
+	for {
+		var t Task
+		key, err := it.Next(kv, &t)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "task")
+		}
+		qkey := taskQueueKey(&t, key)
+		raw, err := kv.Get(qkey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "task %X", key)
+		}
+		if raw == nil {
+			return nil, errors.Wrapf(errors.ErrState, "task %X is not queued", key)
+		}
+		queued[string(key)] = qkey
+		genesis.Tasks = append(genesis.Tasks, genesisTask{
+			ID:             key,
+			Authenticators: t.Authenticators,
+			RunAt:          t.RunAt,
+			Interval:       t.Interval,
+			EndTime:        t.EndTime,
+			RunAtHeight:    rebaseHeight(t.RunAtHeight, lastHeight),
+			HeightInterval: t.HeightInterval,
+			EndHeight:      rebaseHeight(t.EndHeight, lastHeight),
+			MaxRuns:        t.MaxRuns,
+			Runs:           t.Runs,
+			Data:           raw,
+		})
+	}
+
+	for _, prefix := range []string{queuePrefix, heightQueuePrefix} {
+		legacy, err := checkQueue(kv, prefix, queued)
+		if err != nil {
+			return nil, err
+		}
+		genesis.LegacyTasks = append(genesis.LegacyTasks, legacy...)
+	}
+
+	it = orm.IterAll("trs")
+	for {
+		var r TaskResult
+		key, err := it.Next(kv, &r)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "task result")
+		}
+		genesis.Results = append(genesis.Results, genesisResult{
+			TaskID:     key,
+			Successful: r.Successful,
+			Info:       r.Info,
+			ExecTime:   r.ExecTime,
+			ExecHeight: r.ExecHeight,
+		})
+	}
+
+	opts := make(weave.Options)
+	if err := opts.WriteOptions("cron", genesis); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// checkQueue ensures that each task queued under given prefix is queued
+// according to its schedule. Queued keys of tasks are provided by their IDs.
+// Returned are tasks scheduled before task IDs were introduced.
+func checkQueue(kv weave.ReadOnlyKVStore, prefix string, queued map[string][]byte) ([]genesisLegacyTask, error) {
+	it, err := kv.Iterator([]byte(prefix), prefixEnd(prefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "queue")
+	}
+	defer it.Release()
+
+	var legacy []genesisLegacyTask
+	for {
+		key, value, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			return legacy, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "queue")
+		}
+		taskID := taskIDFromQueueKey(key)
+		if bytes.Equal(taskID, key) {
+			legacy = append(legacy, genesisLegacyTask{
+				Key:  key,
+				Data: value,
+			})
+			continue
+		}
+		if qkey, ok := queued[string(taskID)]; !ok || !bytes.Equal(key, qkey) {
+			return nil, errors.Wrapf(errors.ErrState, "queued task %X has no schedule", taskID)
+		}
+	}
+}
+
+// rebaseHeight returns given block height as a height of a chain that starts
+// with the block following the block with given last height. Zero height is
+// not set and is not changed.
+func rebaseHeight(height, lastHeight int64) int64 {
+	if height == 0 {
+		return 0
+	}
+	if height <= lastHeight {
+		return 1
+	}
+	return height - lastHeight
+}
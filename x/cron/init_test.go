@@ -0,0 +1,161 @@
+package cron
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	now := time.Now()
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+
+	enc := NewTestTaskMarshaler(&weavetest.Msg{})
+	s := NewScheduler(enc)
+
+	executed, err := s.Schedule(db, now.Add(-time.Minute), nil, &weavetest.Msg{RoutePath: "test/1"})
+	assert.Nil(t, err)
+	ctx := weave.WithBlockTime(context.Background(), now)
+	ctx = weave.WithHeight(ctx, 5)
+	_, _, err = NewTicker(&cronHandler{}, enc).tick(ctx, db)
+	assert.Nil(t, err)
+
+	owner := weavetest.NewCondition()
+	_, err = s.ScheduleRecurring(db, now.Add(time.Hour), Recurrence{Interval: 60, MaxRuns: 3}, []weave.Condition{owner}, &weavetest.Msg{RoutePath: "test/2"})
+	assert.Nil(t, err)
+	_, err = s.Schedule(db, now.Add(time.Hour), nil, &weavetest.Msg{RoutePath: "test/3"})
+	assert.Nil(t, err)
+
+	raw, err := enc.MarshalTask(nil, &weavetest.Msg{RoutePath: "test/4"})
+	assert.Nil(t, err)
+	legacy := queueKey(now.Add(time.Hour).Round(time.Second), nil)
+	assert.Nil(t, db.Set(legacy, raw))
+
+	var ini Initializer
+	opts, err := ini.ToGenesis(db)
+	assert.Nil(t, err)
+
+	// Exported state must survive serialization, as it does when written
+	// to a genesis file.
+	rawOpts, err := json.Marshal(opts)
+	assert.Nil(t, err)
+	var reopts weave.Options
+	assert.Nil(t, json.Unmarshal(rawOpts, &reopts))
+
+	redb := store.MemStore()
+	migration.MustInitPkg(redb, "cron")
+	assert.Nil(t, ini.FromGenesis(reopts, weave.GenesisParams{}, redb))
+	assert.Equal(t, dumpStore(t, db), dumpStore(t, redb))
+
+	var res TaskResult
+	assert.Nil(t, NewTaskResultBucket().One(redb, executed, &res))
+	assert.Equal(t, int64(5), res.ExecHeight)
+
+	// Task sequence is raised, so that a new task is not assigned an ID
+	// of a loaded one.
+	id, err := s.Schedule(redb, now, nil, &weavetest.Msg{RoutePath: "test/5"})
+	assert.Nil(t, err)
+	assert.Equal(t, weavetest.SequenceID(4), id)
+}
+
+func TestGenesisRebaseHeight(t *testing.T) {
+	now := time.Now()
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+
+	enc := NewTestTaskMarshaler(&weavetest.Msg{})
+	s := NewScheduler(enc)
+	owner := weavetest.NewCondition()
+	rec := HeightRecurrence{Interval: 5, EndHeight: 30, MaxRuns: 4}
+	recurring, err := s.ScheduleRecurringAtHeight(db, 10, rec, []weave.Condition{owner}, &weavetest.Msg{RoutePath: "test/1"})
+	assert.Nil(t, err)
+
+	// Last processed block is at height 4.
+	ctx := weave.WithBlockTime(context.Background(), now)
+	_, _, err = NewTicker(&cronHandler{}, enc).tick(weave.WithHeight(ctx, 4), db)
+	assert.Nil(t, err)
+
+	// Task scheduled for a height that was already reached is due with
+	// the next block.
+	overdue, err := s.ScheduleAtHeight(db, 3, nil, &weavetest.Msg{RoutePath: "test/2"})
+	assert.Nil(t, err)
+
+	var ini Initializer
+	opts, err := ini.ToGenesis(db)
+	assert.Nil(t, err)
+	rawOpts, err := json.Marshal(opts)
+	assert.Nil(t, err)
+	var reopts weave.Options
+	assert.Nil(t, json.Unmarshal(rawOpts, &reopts))
+
+	redb := store.MemStore()
+	migration.MustInitPkg(redb, "cron")
+	assert.Nil(t, ini.FromGenesis(reopts, weave.GenesisParams{}, redb))
+
+	// Heights are relative to the first block of the new chain.
+	var task Task
+	assert.Nil(t, NewTaskBucket().One(redb, recurring, &task))
+	assert.Equal(t, int64(6), task.RunAtHeight)
+	assert.Equal(t, int64(5), task.HeightInterval)
+	assert.Equal(t, int64(26), task.EndHeight)
+	assert.Equal(t, int64(4), task.MaxRuns)
+	assert.Equal(t, []weave.Condition{owner}, task.Authenticators)
+	assert.Nil(t, NewTaskBucket().One(redb, overdue, &task))
+	assert.Equal(t, int64(1), task.RunAtHeight)
+
+	// Overdue task is executed with the first block.
+	ticker := NewTicker(&cronHandler{}, enc)
+	tags, _, err := ticker.tick(weave.WithHeight(ctx, 1), redb)
+	assert.Nil(t, err)
+	assert.Equal(t, true, containsPairValue(tags, overdue))
+	assert.Equal(t, false, containsPairValue(tags, recurring))
+	tags, _, err = ticker.tick(weave.WithHeight(ctx, 6), redb)
+	assert.Nil(t, err)
+	assert.Equal(t, true, containsPairValue(tags, recurring))
+}
+
+func TestGenesisExportUnqueuedTask(t *testing.T) {
+	now := time.Now()
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+
+	id, err := NewScheduler(NewTestTaskMarshaler(&weavetest.Msg{})).Schedule(db, now, nil, &weavetest.Msg{})
+	assert.Nil(t, err)
+	var task Task
+	assert.Nil(t, NewTaskBucket().One(db, id, &task))
+	assert.Nil(t, db.Delete(queueKey(task.RunAt.Time(), id)))
+
+	var ini Initializer
+	if _, err := ini.ToGenesis(db); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+}
+
+// dumpStore returns all key value pairs stored in given database.
+func dumpStore(t testing.TB, db weave.ReadOnlyKVStore) map[string]string {
+	t.Helper()
+	it, err := db.Iterator(nil, nil)
+	assert.Nil(t, err)
+	defer it.Release()
+
+	res := make(map[string]string)
+	for {
+		switch key, value, err := it.Next(); {
+		case err == nil:
+			res[string(key)] = string(value)
+		case errors.ErrIteratorDone.Is(err):
+			return res
+		default:
+			t.Fatalf("cannot iterate: %s", err)
+		}
+	}
+}
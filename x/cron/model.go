@@ -1,6 +1,8 @@
 package cron
 
 import (
+	"time"
+
 	weave "github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
@@ -9,6 +11,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &TaskResult{}, migration.NoModification)
+	migration.MustRegister(1, &Task{}, migration.NoModification)
 }
 
 var _ orm.CloneableData = (*TaskResult)(nil)
@@ -33,4 +36,121 @@ func NewTaskResultBucket() orm.ModelBucket {
 
 func RegisterQuery(qr weave.QueryRouter) {
 	NewTaskResultBucket().Register("crontaskresults", qr)
+	NewTaskBucket().Register("crontasks", qr)
+}
+
+var _ orm.CloneableData = (*Task)(nil)
+
+func (t *Task) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", t.Metadata.Validate())
+	for _, c := range t.Authenticators {
+		errs = errors.AppendField(errs, "Authenticators", c.Validate())
+	}
+	errs = errors.AppendField(errs, "RunAt", t.RunAt.Validate())
+	if t.Interval < 0 {
+		errs = errors.Append(errs, errors.Field("Interval", errors.ErrInput, "must not be negative"))
+	}
+	if t.EndTime != 0 {
+		errs = errors.AppendField(errs, "EndTime", t.EndTime.Validate())
+	}
+	if t.MaxRuns < 0 {
+		errs = errors.Append(errs, errors.Field("MaxRuns", errors.ErrInput, "must not be negative"))
+	}
+	if t.Runs < 0 {
+		errs = errors.Append(errs, errors.Field("Runs", errors.ErrInput, "must not be negative"))
+	}
+	switch {
+	case t.RunAtHeight < 0:
+		errs = errors.Append(errs, errors.Field("RunAtHeight", errors.ErrInput, "must not be negative"))
+	case t.RunAtHeight > 0:
+		if t.RunAt != 0 || t.Interval != 0 || t.EndTime != 0 {
+			errs = errors.Append(errs, errors.Field("RunAtHeight", errors.ErrInput, "task scheduled by height cannot declare time schedule"))
+		}
+	case t.HeightInterval != 0 || t.EndHeight != 0:
+		errs = errors.Append(errs, errors.Field("RunAtHeight", errors.ErrEmpty, "required by height recurrence"))
+	}
+	if t.HeightInterval < 0 {
+		errs = errors.Append(errs, errors.Field("HeightInterval", errors.ErrInput, "must not be negative"))
+	}
+	if t.EndHeight < 0 {
+		errs = errors.Append(errs, errors.Field("EndHeight", errors.ErrInput, "must not be negative"))
+	}
+	return errs
+}
+
+// nextRun returns the time of the next execution of a recurring task. It
+// returns false if the task should not be executed anymore.
+//
+// Executions that were missed, because no block was created on time, are
+// skipped so that a recurring task is never executed more than once per
+// block.
+func (t *Task) nextRun(now time.Time) (time.Time, bool) {
+	if t.Interval <= 0 {
+		return time.Time{}, false
+	}
+	if t.MaxRuns > 0 && t.Runs >= t.MaxRuns {
+		return time.Time{}, false
+	}
+	interval := t.Interval.Duration()
+	next := t.RunAt.Time().Add(interval)
+	if !next.After(now) {
+		missed := now.Sub(next)/interval + 1
+		next = next.Add(missed * interval)
+	}
+	if t.EndTime != 0 && next.After(t.EndTime.Time()) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// nextRunHeight returns the block height of the next execution of a recurring
+// task scheduled by height. It returns false if the task should not be
+// executed anymore.
+//
+// Same as with nextRun, missed executions are skipped.
+func (t *Task) nextRunHeight(height int64) (int64, bool) {
+	if t.HeightInterval <= 0 {
+		return 0, false
+	}
+	if t.MaxRuns > 0 && t.Runs >= t.MaxRuns {
+		return 0, false
+	}
+	next := t.RunAtHeight + t.HeightInterval
+	if next <= height {
+		missed := (height-next)/t.HeightInterval + 1
+		next += missed * t.HeightInterval
+	}
+	if t.EndHeight != 0 && next > t.EndHeight {
+		return 0, false
+	}
+	return next, true
+}
+
+// NewTaskBucket returns a bucket for storing the schedule of queued tasks.
+// Tasks are indexed by the address of each authentication condition, so that
+// it is possible to list all pending tasks owned by a condition.
+func NewTaskBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("task", &Task{},
+		orm.WithIndex("owner", taskOwnerIndexer, false),
+	)
+	return migration.NewModelBucket("cron", b)
+}
+
+func taskOwnerIndexer(obj orm.Object) ([][]byte, error) {
+	t, ok := obj.Value().(*Task)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrModel, "invalid type: %T", obj.Value())
+	}
+	idxs := make([][]byte, 0, len(t.Authenticators))
+	seen := make(map[string]struct{}, len(t.Authenticators))
+	for _, c := range t.Authenticators {
+		addr := c.Address()
+		if _, ok := seen[string(addr)]; ok {
+			continue
+		}
+		seen[string(addr)] = struct{}{}
+		idxs = append(idxs, addr)
+	}
+	return idxs, nil
 }
@@ -0,0 +1,26 @@
+package cron
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &CancelTaskMsg{}, migration.NoModification)
+}
+
+var _ weave.Msg = (*CancelTaskMsg)(nil)
+
+func (m *CancelTaskMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.TaskID) == 0 {
+		errs = errors.AppendField(errs, "TaskID", errors.ErrEmpty)
+	}
+	return errs
+}
+
+func (*CancelTaskMsg) Path() string {
+	return "cron/cancel_task"
+}
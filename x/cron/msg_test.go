@@ -0,0 +1,44 @@
+package cron
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestValidateCancelTaskMsg(t *testing.T) {
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid message": {
+			Msg: &CancelTaskMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				TaskID:   weavetest.SequenceID(1),
+			},
+			WantErr: nil,
+		},
+		"missing metadata": {
+			Msg: &CancelTaskMsg{
+				TaskID: weavetest.SequenceID(1),
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"missing task ID": {
+			Msg: &CancelTaskMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package currency
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -9,14 +11,17 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisToken struct {
+	Ticker string `json:"ticker"`
+	Name   string `json:"name"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	var tokens []struct {
-		Ticker string `json:"ticker"`
-		Name   string `json:"name"`
-	}
+	var tokens []genesisToken
 	if err := opts.ReadOptions("currencies", &tokens); err != nil {
 		return err
 	}
@@ -30,3 +35,27 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 	}
 	return nil
 }
+
+// ToGenesis will export all token information from the database.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	tokens := []genesisToken{}
+	it := orm.IterAll("tokeninfo")
+	for {
+		var t TokenInfo
+		switch key, err := it.Next(kv, &t); {
+		case err == nil:
+			tokens = append(tokens, genesisToken{
+				Ticker: string(key),
+				Name:   t.Name,
+			})
+		case errors.ErrIteratorDone.Is(err):
+			opts := make(weave.Options)
+			if err := opts.WriteOptions("currencies", tokens); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "token info")
+		}
+	}
+}
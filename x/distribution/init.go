@@ -1,8 +1,11 @@
 package distribution
 
 import (
+	"encoding/binary"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -10,18 +13,22 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisDestination struct {
+	Address weave.Address `json:"address"`
+	Weight  int32         `json:"weight"`
+}
+
+type genesisRevenue struct {
+	Admin        weave.Address        `json:"admin"`
+	Destinations []genesisDestination `json:"destinations"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type destination struct {
-		Address weave.Address `json:"address"`
-		Weight  int32         `json:"weight"`
-	}
-	var revenues []struct {
-		Admin        weave.Address `json:"admin"`
-		Destinations []destination `json:"destinations"`
-	}
+	var revenues []genesisRevenue
 	if err := opts.ReadOptions("distribution", &revenues); err != nil {
 		return errors.Wrap(err, "cannot load distribution")
 	}
@@ -51,3 +58,39 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 	}
 	return nil
 }
+
+// ToGenesis will export all revenues from the database. Revenues are
+// exported in the order of their IDs, so that they are assigned the same
+// IDs when loaded again.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	var revenues []genesisRevenue
+	it := orm.IterAll("revenue")
+	for {
+		var r Revenue
+		switch key, err := it.Next(kv, &r); {
+		case err == nil:
+			if len(key) != 8 || binary.BigEndian.Uint64(key) != uint64(len(revenues)+1) {
+				return nil, errors.Wrapf(errors.ErrState, "revenue %x cannot be assigned the same ID", key)
+			}
+			destinations := make([]genesisDestination, 0, len(r.Destinations))
+			for _, d := range r.Destinations {
+				destinations = append(destinations, genesisDestination{
+					Address: d.Address,
+					Weight:  d.Weight,
+				})
+			}
+			revenues = append(revenues, genesisRevenue{
+				Admin:        r.Admin,
+				Destinations: destinations,
+			})
+		case errors.ErrIteratorDone.Is(err):
+			opts := make(weave.Options)
+			if err := opts.WriteOptions("distribution", revenues); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "revenue")
+		}
+	}
+}
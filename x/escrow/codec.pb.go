@@ -46,6 +46,9 @@ type Escrow struct {
 	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,7,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Return task ID holds the ID of the asynchronous task that is scheduled to
+	// return all coins to the source once the escrow expires.
+	ReturnTaskID []byte `protobuf:"bytes,8,opt,name=return_task_id,json=returnTaskId,proto3" json:"return_task_id,omitempty"`
 }
 
 func (m *Escrow) Reset()         { *m = Escrow{} }
@@ -130,6 +133,13 @@ func (m *Escrow) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Escrow) GetReturnTaskID() []byte {
+	if m != nil {
+		return m.ReturnTaskID
+	}
+	return nil
+}
+
 // CreateMsg is a request to create an Escrow with some tokens.
 // Message must be authorized by the source.
 type CreateMsg struct {
@@ -437,34 +447,36 @@ func init() {
 func init() { proto.RegisterFile("x/escrow/codec.proto", fileDescriptor_36017ee554579951) }
 
 var fileDescriptor_36017ee554579951 = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x54, 0xc1, 0x6a, 0xdb, 0x40,
-	0x14, 0xb4, 0x2c, 0x47, 0x8e, 0x9f, 0x0b, 0x0d, 0x22, 0x07, 0xe1, 0x82, 0xa2, 0x8a, 0x16, 0x04,
-	0xa5, 0x12, 0xa4, 0xd7, 0xd2, 0x52, 0x87, 0x16, 0x7a, 0x08, 0x94, 0xa5, 0x3e, 0x97, 0xb5, 0xf6,
-	0xe1, 0x2e, 0x54, 0xfb, 0xc2, 0xee, 0x2a, 0x09, 0xfd, 0x8a, 0x7c, 0x43, 0xbf, 0xa6, 0xc7, 0x1c,
-	0x7b, 0x0a, 0xc5, 0xfe, 0x88, 0x42, 0x4e, 0xc5, 0x5a, 0xa7, 0xd1, 0x25, 0x07, 0x35, 0xbe, 0xe5,
-	0x36, 0x8c, 0x76, 0xde, 0x32, 0x6f, 0x46, 0x0b, 0xfb, 0xe7, 0x05, 0x9a, 0x52, 0xd3, 0x59, 0x51,
-	0x92, 0xc0, 0x32, 0x3f, 0xd1, 0x64, 0x29, 0x0c, 0x1c, 0x37, 0x19, 0xb7, 0xc8, 0xc9, 0x5e, 0x49,
-	0x52, 0xb5, 0x8f, 0x4d, 0xf6, 0x17, 0xb4, 0xa0, 0x06, 0x16, 0x6b, 0xe4, 0xd8, 0xf4, 0xc2, 0x87,
-	0xe0, 0x7d, 0xa3, 0x0f, 0x5f, 0xc0, 0x6e, 0x85, 0x96, 0x0b, 0x6e, 0x79, 0xe4, 0x25, 0x5e, 0x36,
-	0x3e, 0x7c, 0x9c, 0x9f, 0x21, 0x3f, 0xc5, 0xfc, 0x78, 0x43, 0xb3, 0x7f, 0x07, 0xc2, 0xd7, 0x10,
-	0x18, 0xaa, 0x75, 0x89, 0x51, 0x3f, 0xf1, 0xb2, 0x47, 0xd3, 0x67, 0xd7, 0x57, 0x07, 0xc9, 0x42,
-	0xda, 0xaf, 0xf5, 0x3c, 0x2f, 0xa9, 0x2a, 0x24, 0x9d, 0xbe, 0x24, 0x85, 0x85, 0x1b, 0xf0, 0x4e,
-	0x08, 0x8d, 0xc6, 0xb0, 0x8d, 0x26, 0x7c, 0x03, 0x43, 0xae, 0xe7, 0xd2, 0xa2, 0x8e, 0xfc, 0x0e,
-	0xf2, 0x1b, 0x51, 0xf8, 0x01, 0xc6, 0x02, 0x8d, 0x95, 0x8a, 0x5b, 0x49, 0x2a, 0x1a, 0x74, 0x98,
-	0xd1, 0x16, 0x86, 0x6f, 0x61, 0x68, 0x65, 0x85, 0x54, 0xdb, 0x68, 0x27, 0xf1, 0x32, 0x7f, 0xfa,
-	0xfc, 0xfa, 0xea, 0xe0, 0xe9, 0x9d, 0x33, 0x66, 0x4a, 0x9e, 0x7f, 0x96, 0x15, 0xb2, 0x1b, 0x55,
-	0x18, 0xc2, 0xa0, 0xc2, 0x8a, 0xa2, 0x20, 0xf1, 0xb2, 0x11, 0x6b, 0x70, 0x63, 0xce, 0x5d, 0x16,
-	0x0d, 0x3b, 0x99, 0x73, 0x20, 0xfd, 0xd3, 0x87, 0xd1, 0x91, 0x46, 0x6e, 0xf1, 0xd8, 0x2c, 0x1e,
-	0x62, 0x2a, 0x29, 0x04, 0xbc, 0xa2, 0x5a, 0xad, 0x43, 0xf1, 0xb3, 0xf1, 0x21, 0xe4, 0xeb, 0x32,
-	0xe7, 0x47, 0x24, 0x15, 0xdb, 0x7c, 0x69, 0x27, 0x17, 0xdc, 0x2b, 0xb9, 0xe1, 0x6d, 0x72, 0xe9,
-	0x77, 0x00, 0x86, 0xdf, 0x90, 0x9b, 0xee, 0x9b, 0x7f, 0x02, 0x23, 0xf7, 0x1b, 0x7e, 0x91, 0xc2,
-	0x2d, 0x9f, 0xed, 0x3a, 0xe2, 0xa3, 0x68, 0x19, 0xf2, 0xef, 0x32, 0x94, 0xce, 0x60, 0xc4, 0xd0,
-	0xd6, 0x5a, 0x6d, 0xf5, 0xea, 0xf4, 0x47, 0x1f, 0xf6, 0x66, 0x27, 0x82, 0x5b, 0xfc, 0xc4, 0xb5,
-	0x95, 0x68, 0xb6, 0xeb, 0xec, 0xb6, 0x70, 0xfe, 0xfd, 0x0a, 0x37, 0xd8, 0x42, 0xe1, 0x76, 0xfe,
-	0xb3, 0x70, 0xd3, 0xe8, 0xe7, 0x32, 0xf6, 0x2e, 0x97, 0xb1, 0xf7, 0x7b, 0x19, 0x7b, 0x17, 0xab,
-	0xb8, 0x77, 0xb9, 0x8a, 0x7b, 0xbf, 0x56, 0x71, 0x6f, 0x1e, 0x34, 0xaf, 0xe4, 0xab, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x4a, 0x80, 0x06, 0xba, 0x7a, 0x05, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x54, 0x41, 0x6b, 0xdb, 0x30,
+	0x18, 0x8d, 0xe3, 0xd4, 0x49, 0xbe, 0x84, 0x2d, 0x88, 0x1e, 0x44, 0x06, 0x8e, 0x67, 0x36, 0x30,
+	0x8c, 0xd9, 0xd0, 0xc1, 0x4e, 0x63, 0x63, 0xe9, 0x36, 0xe8, 0xa1, 0x30, 0x44, 0x73, 0x2e, 0x8a,
+	0xf5, 0x91, 0x89, 0xce, 0x56, 0x91, 0xe4, 0xb6, 0xec, 0x57, 0xec, 0x37, 0xec, 0xd7, 0xf4, 0xd8,
+	0xe3, 0x4e, 0x61, 0x24, 0x3f, 0x62, 0xd0, 0xd3, 0x88, 0x9d, 0x2e, 0xbe, 0xf4, 0x90, 0x35, 0xb7,
+	0xdd, 0x3e, 0x3f, 0xe9, 0x3d, 0xf1, 0xf4, 0x9e, 0x0c, 0xfb, 0x57, 0x09, 0x9a, 0x54, 0xab, 0xcb,
+	0x24, 0x55, 0x02, 0xd3, 0xf8, 0x5c, 0x2b, 0xab, 0x88, 0x57, 0x61, 0xc3, 0x5e, 0x0d, 0x1c, 0x0e,
+	0x52, 0x25, 0xf3, 0xfa, 0xb6, 0xe1, 0xfe, 0x4c, 0xcd, 0x54, 0x39, 0x26, 0xab, 0xa9, 0x42, 0xc3,
+	0x6b, 0x17, 0xbc, 0x8f, 0x25, 0x9f, 0xbc, 0x80, 0x4e, 0x86, 0x96, 0x0b, 0x6e, 0x39, 0x75, 0x02,
+	0x27, 0xea, 0x1d, 0x3c, 0x8e, 0x2f, 0x91, 0x5f, 0x60, 0x7c, 0xbc, 0x86, 0xd9, 0xdf, 0x0d, 0xe4,
+	0x0d, 0x78, 0x46, 0x15, 0x3a, 0x45, 0xda, 0x0c, 0x9c, 0xa8, 0x3f, 0x7e, 0x76, 0x3b, 0x1f, 0x05,
+	0x33, 0x69, 0xbf, 0x14, 0xd3, 0x38, 0x55, 0x59, 0x22, 0xd5, 0xc5, 0x4b, 0x95, 0x63, 0x52, 0x09,
+	0xbc, 0x17, 0x42, 0xa3, 0x31, 0x6c, 0xcd, 0x21, 0x6f, 0xa1, 0xcd, 0xf5, 0x54, 0x5a, 0xd4, 0xd4,
+	0xdd, 0x82, 0x7e, 0x47, 0x22, 0x9f, 0xa0, 0x27, 0xd0, 0x58, 0x99, 0x73, 0x2b, 0x55, 0x4e, 0x5b,
+	0x5b, 0x68, 0xd4, 0x89, 0xe4, 0x1d, 0xb4, 0xad, 0xcc, 0x50, 0x15, 0x96, 0xee, 0x05, 0x4e, 0xe4,
+	0x8e, 0x9f, 0xdf, 0xce, 0x47, 0x4f, 0xef, 0xd5, 0x98, 0xe4, 0xf2, 0xea, 0x44, 0x66, 0xc8, 0xee,
+	0x58, 0x84, 0x40, 0x2b, 0xc3, 0x4c, 0x51, 0x2f, 0x70, 0xa2, 0x2e, 0x2b, 0xe7, 0xd2, 0x5c, 0x75,
+	0x18, 0x6d, 0x6f, 0x65, 0xae, 0x1a, 0xc8, 0x6b, 0x78, 0xa4, 0xd1, 0x16, 0x3a, 0x3f, 0xb5, 0xdc,
+	0x9c, 0x9d, 0x4a, 0x41, 0x3b, 0xa5, 0xcc, 0x60, 0x31, 0x1f, 0xf5, 0x59, 0xb9, 0x72, 0xc2, 0xcd,
+	0xd9, 0xd1, 0x07, 0xd6, 0xd7, 0x9b, 0x2f, 0x11, 0xfe, 0x6e, 0x42, 0xf7, 0x50, 0x23, 0xb7, 0x78,
+	0x6c, 0x66, 0xff, 0x63, 0x9a, 0x21, 0x78, 0x3c, 0x53, 0x45, 0xbe, 0x0a, 0xd3, 0x8d, 0x7a, 0x07,
+	0x10, 0xaf, 0x1e, 0x41, 0x7c, 0xa8, 0x64, 0xce, 0xd6, 0x2b, 0xf5, 0xc4, 0xbd, 0x07, 0x25, 0xde,
+	0xde, 0x24, 0x1e, 0x7e, 0x03, 0x60, 0xf8, 0x15, 0xb9, 0xd9, 0xfe, 0xe6, 0x9f, 0x40, 0xb7, 0x7a,
+	0xbe, 0xab, 0x9c, 0xcb, 0xcb, 0x67, 0x9d, 0x0a, 0x38, 0x12, 0x35, 0x43, 0xee, 0x7d, 0x86, 0xc2,
+	0x09, 0x74, 0xab, 0x4e, 0xec, 0xf4, 0xe8, 0xf0, 0x47, 0x13, 0x06, 0x93, 0x73, 0xc1, 0x2d, 0x7e,
+	0xe6, 0xda, 0x4a, 0x34, 0xbb, 0x75, 0xb6, 0x29, 0x9c, 0xfb, 0xb0, 0xc2, 0xb5, 0x76, 0x50, 0xb8,
+	0xbd, 0x7f, 0x2c, 0xdc, 0x98, 0x5e, 0x2f, 0x7c, 0xe7, 0x66, 0xe1, 0x3b, 0xbf, 0x16, 0xbe, 0xf3,
+	0x7d, 0xe9, 0x37, 0x6e, 0x96, 0x7e, 0xe3, 0xe7, 0xd2, 0x6f, 0x4c, 0xbd, 0xf2, 0xef, 0xfa, 0xea,
+	0xcf, 0x00, 0x47, 0xfa, 0x90, 0xee, 0xb2, 0x05, 0x00, 0x00,
 }
 
 func (m *Escrow) Marshal() (dAtA []byte, err error) {
@@ -527,6 +539,12 @@ func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
 		i += copy(dAtA[i:], m.Address)
 	}
+	if len(m.ReturnTaskID) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ReturnTaskID)))
+		i += copy(dAtA[i:], m.ReturnTaskID)
+	}
 	return i, nil
 }
 
@@ -773,6 +791,10 @@ func (m *Escrow) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	l = len(m.ReturnTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -1148,6 +1170,40 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnTaskID = append(m.ReturnTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnTaskID == nil {
+				m.ReturnTaskID = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
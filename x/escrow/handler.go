@@ -19,14 +19,17 @@ const (
 )
 
 // RegisterRoutes will instantiate and register
-// all handlers in this package
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller) {
+// all handlers in this package.
+//
+// Scheduler is used to return the coins to the source once an escrow expires.
+// Scheduled ReturnMsg is executed without any authentication.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller, scheduler weave.Scheduler) {
 	r = migration.SchemaMigratingRegistry("escrow", r)
 	bucket := NewBucket()
 
-	r.Handle(&CreateMsg{}, CreateEscrowHandler{auth, bucket, cashctrl})
-	r.Handle(&ReleaseMsg{}, ReleaseEscrowHandler{auth, bucket, cashctrl})
-	r.Handle(&ReturnMsg{}, ReturnEscrowHandler{auth, bucket, cashctrl})
+	r.Handle(&CreateMsg{}, CreateEscrowHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReleaseMsg{}, ReleaseEscrowHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReturnMsg{}, ReturnEscrowHandler{auth, bucket, cashctrl, scheduler})
 	r.Handle(&UpdatePartiesMsg{}, UpdateEscrowHandler{auth, bucket})
 }
 
@@ -37,9 +40,10 @@ func RegisterQuery(qr weave.QueryRouter) {
 
 // CreateEscrowHandler will set a name for objects in this bucket
 type CreateEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.CoinMover
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.CoinMover
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = CreateEscrowHandler{}
@@ -71,15 +75,26 @@ func (h CreateEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx wea
 		return nil, errors.Wrap(err, "cannot acquire key")
 	}
 
+	// Once expired, the coins can be returned to the source by anyone.
+	returnMsg := &ReturnMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		EscrowId: key,
+	}
+	taskID, err := h.scheduler.Schedule(db, msg.Timeout.Time(), nil, returnMsg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule return task")
+	}
+
 	// create an escrow object
 	escrow := &Escrow{
-		Metadata:    &weave.Metadata{},
-		Source:      msg.Source,
-		Arbiter:     msg.Arbiter,
-		Destination: msg.Destination,
-		Timeout:     msg.Timeout,
-		Memo:        msg.Memo,
-		Address:     Condition(key).Address(),
+		Metadata:     &weave.Metadata{},
+		Source:       msg.Source,
+		Arbiter:      msg.Arbiter,
+		Destination:  msg.Destination,
+		Timeout:      msg.Timeout,
+		Memo:         msg.Memo,
+		Address:      Condition(key).Address(),
+		ReturnTaskID: taskID,
 	}
 	if _, err := h.bucket.Put(db, key, escrow); err != nil {
 		return nil, errors.Wrap(err, "cannot store escrow")
@@ -109,9 +124,10 @@ func (h CreateEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx we
 
 // ReleaseEscrowHandler will set a name for objects in this bucket.
 type ReleaseEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReleaseEscrowHandler{}
@@ -161,6 +177,9 @@ func (h ReleaseEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx we
 	if err := h.bucket.Delete(db, msg.EscrowId); err != nil {
 		return nil, err
 	}
+	if err := weave.DeleteScheduled(db, h.scheduler, escrow.ReturnTaskID); err != nil {
+		return nil, err
+	}
 	return &weave.DeliverResult{}, nil
 }
 
@@ -190,9 +209,10 @@ func (h ReleaseEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx w
 
 // ReturnEscrowHandler will set a name for objects in this bucket
 type ReturnEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReturnEscrowHandler{}
@@ -229,6 +249,9 @@ func (h ReturnEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx wea
 	if err := h.bucket.Delete(db, key); err != nil {
 		return nil, err
 	}
+	if err := weave.DeleteScheduled(db, h.scheduler, escrow.ReturnTaskID); err != nil {
+		return nil, err
+	}
 	return &weave.DeliverResult{}, nil
 }
 
@@ -500,7 +500,7 @@ func TestHandler(t *testing.T) {
 	auth := authenticator()
 	// create handler objects and query objects
 	router := app.NewRouter()
-	RegisterRoutes(router, auth, ctrl)
+	RegisterRoutes(router, auth, ctrl, &weavetest.Cron{})
 	cash.RegisterRoutes(router, auth, ctrl)
 	qr := weave.NewQueryRouter()
 	cash.RegisterQuery(qr)
@@ -546,6 +546,95 @@ func TestHandler(t *testing.T) {
 	}
 }
 
+func TestReturnTask(t *testing.T) {
+	a := weavetest.NewCondition()
+	b := weavetest.NewCondition()
+	c := weavetest.NewCondition()
+	amount := mustCombineCoins(coin.NewCoin(10, 0, "FOO"))
+
+	cases := map[string]struct {
+		Msg       func(escrowID []byte) weave.Msg
+		Time      time.Time
+		WantTasks int
+	}{
+		"released escrow": {
+			Msg: func(escrowID []byte) weave.Msg {
+				return &ReleaseMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					EscrowId: escrowID,
+				}
+			},
+			Time: blockNow,
+		},
+		"returned escrow": {
+			Msg: func(escrowID []byte) weave.Msg {
+				return &ReturnMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					EscrowId: escrowID,
+				}
+			},
+			Time: Timeout.Time(),
+		},
+		"partially released escrow": {
+			Msg: func(escrowID []byte) weave.Msg {
+				return &ReleaseMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					EscrowId: escrowID,
+					Amount:   mustCombineCoins(coin.NewCoin(1, 0, "FOO")),
+				}
+			},
+			Time:      blockNow,
+			WantTasks: 1,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "escrow", "cash")
+
+			bank := cash.NewBucket()
+			acct, err := cash.WalletWith(a.Address(), amount...)
+			assert.Nil(t, err)
+			assert.Nil(t, bank.Save(db, acct))
+
+			auth := &weavetest.Auth{Signer: a}
+			cron := &weavetest.Cron{}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, cash.NewController(bank), cron)
+
+			ctx := weave.WithBlockTime(context.Background(), blockNow)
+			createMsg := &CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      a.Address(),
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      amount,
+				Timeout:     Timeout,
+			}
+			res, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: createMsg})
+			if err != nil {
+				t.Fatalf("cannot create escrow: %s", err)
+			}
+
+			var escrow Escrow
+			assert.Nil(t, NewBucket().One(db, res.Data, &escrow))
+			if len(escrow.ReturnTaskID) == 0 {
+				t.Fatal("return task not scheduled")
+			}
+
+			ctx = weave.WithBlockTime(context.Background(), tc.Time)
+			if _, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: tc.Msg(res.Data)}); err != nil {
+				t.Fatalf("cannot deliver: %s", err)
+			}
+
+			// Only the scheduled return task can be due at timeout.
+			tick := cron.Tick(weave.WithBlockTime(context.Background(), Timeout.Time()), db)
+			assert.Equal(t, tc.WantTasks, len(tick.Tags))
+		})
+	}
+}
+
 func createAction(source, rcpt, arbiter weave.Condition, amount coin.Coins, memo string) action {
 	return action{
 		perms: []weave.Condition{source},
@@ -614,6 +703,10 @@ func (q query) check(t testing.TB, db weave.ReadOnlyKVStore, qr weave.QueryRoute
 		// parse out value
 		got, err := q.bucket.Parse(nil, mods[i].Value)
 		assert.Nil(t, err)
+		// Scheduler mock returns random task IDs.
+		if e, ok := got.Value().(*Escrow); ok {
+			e.ReturnTaskID = nil
+		}
 		assert.Equal(t, ex.Value(), got.Value())
 	}
 }
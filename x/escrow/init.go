@@ -1,29 +1,46 @@
 package escrow
 
 import (
+	"encoding/binary"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x/cash"
-	"github.com/pkg/errors"
 )
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
 
 // Initializer fulfils the Initializer interface to load data from the genesis file
 type Initializer struct {
 	Minter cash.CoinMinter
+	// Scheduler, if set, is used to schedule the return of coins for
+	// escrows loaded without a return task.
+	Scheduler weave.Scheduler
+}
+
+type genesisEscrow struct {
+	// ID is optional. If set, the escrow is stored under this ID instead
+	// of the next sequence value.
+	ID          uint64         `json:"id,omitempty"`
+	Source      weave.Address  `json:"source"`
+	Arbiter     weave.Address  `json:"arbiter"`
+	Destination weave.Address  `json:"destination"`
+	Timeout     weave.UnixTime `json:"timeout"`
+	Memo        string         `json:"memo,omitempty"`
+	Amount      []*coin.Coin   `json:"amount"`
+	// ReturnTaskID is the ID of the task that returns the coins once the
+	// escrow expires. It must be loaded by the cron extension.
+	ReturnTaskID []byte `json:"return_task_id,omitempty"`
 }
 
 // FromGenesis will parse initial escrow  info from genesis and save it in the database.
+// If an escrow has no return task and a scheduler is configured, the return
+// of its coins is scheduled at the escrow timeout.
 func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	var escrows []struct {
-		Source      weave.Address  `json:"source"`
-		Arbiter     weave.Address  `json:"arbiter"`
-		Destination weave.Address  `json:"destination"`
-		Timeout     weave.UnixTime `json:"timeout"`
-		Amount      []*coin.Coin   `json:"amount"`
-	}
-
+	var escrows []genesisEscrow
 	if err := opts.ReadOptions("escrow", &escrows); err != nil {
 		return err
 	}
@@ -33,13 +50,34 @@ func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams
 		if err != nil {
 			return errors.Wrap(err, "cannot acquire key")
 		}
+		for e.ID != 0 && binary.BigEndian.Uint64(key) < e.ID {
+			if key, err = escrowSeq.NextVal(kv); err != nil {
+				return errors.Wrap(err, "cannot acquire key")
+			}
+		}
+		if e.ID != 0 && binary.BigEndian.Uint64(key) != e.ID {
+			return errors.Wrapf(errors.ErrDuplicate, "escrow ID %d", e.ID)
+		}
 		escrow := Escrow{
-			Metadata:    &weave.Metadata{Schema: 1},
-			Source:      e.Source,
-			Arbiter:     e.Arbiter,
-			Destination: e.Destination,
-			Timeout:     e.Timeout,
-			Address:     Condition(key).Address(),
+			Metadata:     &weave.Metadata{Schema: 1},
+			Source:       e.Source,
+			Arbiter:      e.Arbiter,
+			Destination:  e.Destination,
+			Timeout:      e.Timeout,
+			Memo:         e.Memo,
+			Address:      Condition(key).Address(),
+			ReturnTaskID: e.ReturnTaskID,
+		}
+		if len(escrow.ReturnTaskID) == 0 && i.Scheduler != nil {
+			returnMsg := &ReturnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: key,
+			}
+			taskID, err := i.Scheduler.Schedule(kv, escrow.Timeout.Time(), nil, returnMsg)
+			if err != nil {
+				return errors.Wrap(err, "cannot schedule return task")
+			}
+			escrow.ReturnTaskID = taskID
 		}
 		if _, err := bucket.Put(kv, key, &escrow); err != nil {
 			return errors.Wrap(err, "cannot save escrow")
@@ -52,3 +90,38 @@ func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams
 	}
 	return nil
 }
+
+// ToGenesis will export all escrows from the database, together with their
+// IDs and return task IDs. Funds held by escrows are not exported as an amount, because they are
+// part of the cash extension state. Loading the escrow amount as well would
+// issue those funds twice.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	escrows := []genesisEscrow{}
+	it := orm.IterAll("esc")
+	for {
+		var e Escrow
+		switch key, err := it.Next(kv, &e); {
+		case err == nil:
+			if err := orm.ValidateSequence(key); err != nil {
+				return nil, errors.Wrapf(err, "escrow %x", key)
+			}
+			escrows = append(escrows, genesisEscrow{
+				ID:           binary.BigEndian.Uint64(key),
+				Source:       e.Source,
+				Arbiter:      e.Arbiter,
+				Destination:  e.Destination,
+				Timeout:      e.Timeout,
+				Memo:         e.Memo,
+				ReturnTaskID: e.ReturnTaskID,
+			})
+		case errors.ErrIteratorDone.Is(err):
+			opts := make(weave.Options)
+			if err := opts.WriteOptions("escrow", escrows); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "escrow")
+		}
+	}
+}
@@ -63,3 +63,53 @@ func TestGenesisKey(t *testing.T) {
 	assert.Equal(t, coin.Coin{Ticker: "ALX", Whole: 987654321}, *balance[0])
 	assert.Equal(t, coin.Coin{Ticker: "IOV", Whole: 123456789}, *balance[1])
 }
+
+func TestGenesisReturnTask(t *testing.T) {
+	const genesis = `
+{
+  "escrow": [
+    {
+      "arbiter": "0000000000000000000000000000000000000001",
+      "destination": "C30A2424104F542576EF01FECA2FF558F5EAA61A",
+      "source": "0000000000000000000000000000000000000000",
+      "timeout": "2034-11-10T23:00:00Z"
+    },
+    {
+      "arbiter": "0000000000000000000000000000000000000001",
+      "destination": "C30A2424104F542576EF01FECA2FF558F5EAA61A",
+      "source": "0000000000000000000000000000000000000000",
+      "timeout": "2034-11-10T23:00:00Z",
+      "return_task_id": "AAAAAAAAAAc="
+    }
+  ]}`
+
+	var opts weave.Options
+	assert.Nil(t, json.Unmarshal([]byte(genesis), &opts))
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "escrow", "cash")
+
+	ini := Initializer{
+		Minter:    cash.NewController(cash.NewBucket()),
+		Scheduler: &weavetest.Cron{},
+	}
+	assert.Nil(t, ini.FromGenesis(opts, weave.GenesisParams{}, db))
+
+	bucket := NewBucket()
+	var scheduled Escrow
+	assert.Nil(t, bucket.One(db, weavetest.SequenceID(1), &scheduled))
+	if len(scheduled.ReturnTaskID) == 0 {
+		t.Fatal("return task not scheduled")
+	}
+	var loaded Escrow
+	assert.Nil(t, bucket.One(db, weavetest.SequenceID(2), &loaded))
+	assert.Equal(t, weavetest.SequenceID(7), []byte(loaded.ReturnTaskID))
+
+	exported, err := ini.ToGenesis(db)
+	assert.Nil(t, err)
+	var escrows []genesisEscrow
+	assert.Nil(t, exported.ReadOptions("escrow", &escrows))
+	assert.Equal(t, 2, len(escrows))
+	assert.Equal(t, []byte(scheduled.ReturnTaskID), escrows[0].ReturnTaskID)
+	assert.Equal(t, weavetest.SequenceID(7), escrows[1].ReturnTaskID)
+}
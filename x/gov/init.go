@@ -1,10 +1,12 @@
 package gov
 
 import (
+	"bytes"
 	"encoding/binary"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -12,38 +14,96 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisFraction struct {
+	Numerator   uint32 `json:"numerator"`
+	Denominator uint32 `json:"denominator"`
+}
+
+type genesisElector struct {
+	Address weave.Address `json:"address"`
+	Weight  uint32        `json:"weight"`
+}
+
+type genesisElectorate struct {
+	// Version, if greater than one, declares that this is a new version
+	// of the previously listed electorate.
+	Version  uint32           `json:"version,omitempty"`
+	Admin    weave.Address    `json:"admin"`
+	Title    string           `json:"title"`
+	Electors []genesisElector `json:"electors"`
+}
+
+type genesisRule struct {
+	// Version, if greater than one, declares that this is a new version
+	// of the previously listed election rule.
+	Version      uint32             `json:"version,omitempty"`
+	Admin        weave.Address      `json:"admin"`
+	ElectorateID uint64             `json:"electorate_id"`
+	Title        string             `json:"title"`
+	VotingPeriod weave.UnixDuration `json:"voting_period"`
+	Quorum       genesisFraction    `json:"quorum"`
+	Threshold    genesisFraction    `json:"threshold"`
+}
+
+type genesisProposal struct {
+	ID                  uint64                  `json:"id"`
+	Title               string                  `json:"title"`
+	RawOption           []byte                  `json:"raw_option"`
+	Description         string                  `json:"description,omitempty"`
+	ElectionRuleID      uint64                  `json:"election_rule_id"`
+	ElectionRuleVersion uint32                  `json:"election_rule_version"`
+	ElectorateID        uint64                  `json:"electorate_id"`
+	ElectorateVersion   uint32                  `json:"electorate_version"`
+	VotingStartTime     weave.UnixTime          `json:"voting_start_time"`
+	VotingEndTime       weave.UnixTime          `json:"voting_end_time"`
+	SubmissionTime      weave.UnixTime          `json:"submission_time"`
+	Author              weave.Address           `json:"author"`
+	VoteState           TallyResult             `json:"vote_state"`
+	Status              Proposal_Status         `json:"status"`
+	Result              Proposal_Result         `json:"result"`
+	ExecutorResult      Proposal_ExecutorResult `json:"executor_result"`
+	// TallyTaskID is the ID of the task that creates the tally. It must
+	// be loaded by the cron extension.
+	TallyTaskID []byte `json:"tally_task_id,omitempty"`
+}
+
+type genesisVote struct {
+	ProposalID uint64     `json:"proposal_id"`
+	Elector    Elector    `json:"elector"`
+	Voted      VoteOption `json:"voted"`
+}
+
+type genesisResolution struct {
+	ID                uint64 `json:"id"`
+	ProposalID        uint64 `json:"proposal_id"`
+	ElectorateID      uint64 `json:"electorate_id"`
+	ElectorateVersion uint32 `json:"electorate_version"`
+	Resolution        string `json:"resolution"`
+}
+
+type genesisGovernance struct {
+	Electorate  []genesisElectorate `json:"electorate"`
+	Rules       []genesisRule       `json:"rules"`
+	Proposals   []genesisProposal   `json:"proposals,omitempty"`
+	Votes       []genesisVote       `json:"votes,omitempty"`
+	Resolutions []genesisResolution `json:"resolutions,omitempty"`
+}
 
 // FromGenesis will parse initial governance electorate and election rules from genesis
-// and saves it in the database.
+// and saves it in the database. Proposals, votes and resolutions are loaded
+// as well. They are stored under their IDs and refer to the listed versions
+// of electorates and election rules.
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type fraction struct {
-		Numerator   uint32 `json:"numerator"`
-		Denominator uint32 `json:"denominator"`
-	}
-	var governance struct {
-		Electorate []struct {
-			Admin    weave.Address `json:"admin"`
-			Title    string        `json:"title"`
-			Electors []struct {
-				Address weave.Address `json:"address"`
-				Weight  uint32        `json:"weight"`
-			} `json:"electors"`
-		} `json:"electorate"`
-		Rules []struct {
-			Admin        weave.Address      `json:"admin"`
-			ElectorateID uint64             `json:"electorate_id"`
-			Title        string             `json:"title"`
-			VotingPeriod weave.UnixDuration `json:"voting_period"`
-			Quorum       fraction           `json:"quorum"`
-			Threshold    fraction           `json:"threshold"`
-		} `json:"rules"`
-	}
+	var governance genesisGovernance
 	if err := opts.ReadOptions("governance", &governance); err != nil {
 		return err
 	}
 
 	// handle electorate first, as rules refer to them
 	electBucket := NewElectorateBucket()
+	var last *orm.VersionedIDRef
 	for i, e := range governance.Electorate {
 		ps := make([]Elector, len(e.Electors))
 		var total uint64
@@ -65,21 +125,27 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			return errors.Wrapf(err, "electorate #%d is invalid", i)
 		}
 		sortByAddress(electorate.Electors)
-		if _, err := electBucket.Create(kv, &electorate); err != nil {
-			return err
+		ref, err := storeVersion(kv, electBucket.VersioningBucket, last, e.Version, &electorate)
+		if err != nil {
+			return errors.Wrapf(err, "electorate #%d", i)
 		}
+		last = ref
 	}
 
 	// handle election rules
 	rulesBucket := NewElectionRulesBucket()
+	last = nil
 	for i, r := range governance.Rules {
 		electorateID := encodeSequence(r.ElectorateID)
 		_, _, err := electBucket.GetLatestVersion(kv, electorateID)
 		if err != nil {
 			return errors.Wrapf(err, "failed to load electorate with id: %d", r.ElectorateID)
 		}
-		newRuleID, err := rulesBucket.NextID(kv)
-		if err != nil {
+		var newRuleID []byte
+		if r.Version > 1 && last != nil {
+			// New version of the previously listed election rule.
+			newRuleID = last.ID
+		} else if newRuleID, err = rulesBucket.NextID(kv); err != nil {
 			return errors.Wrap(err, "unable to generate ElectionRule sequence")
 		}
 
@@ -99,14 +165,325 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			return errors.Wrapf(err, "electionRule #%d is invalid", i)
 		}
 
-		if _, err := rulesBucket.CreateWithID(kv, newRuleID, &rule); err != nil {
+		if r.Version > 1 {
+			ref, err := storeVersion(kv, rulesBucket.VersioningBucket, last, r.Version, &rule)
+			if err != nil {
+				return errors.Wrapf(err, "electionRule #%d", i)
+			}
+			last = ref
+			continue
+		}
+		ref, err := rulesBucket.CreateWithID(kv, newRuleID, &rule)
+		if err != nil {
 			return err
 		}
+		last = ref
+	}
+
+	proposals := NewProposalBucket()
+	for i, p := range governance.Proposals {
+		if p.ID == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "proposal #%d: ID", i)
+		}
+		key := encodeSequence(p.ID)
+		switch obj, err := proposals.Get(kv, key); {
+		case err != nil:
+			return errors.Wrapf(err, "proposal #%d", i)
+		case obj != nil:
+			return errors.Wrapf(errors.ErrDuplicate, "proposal #%d", i)
+		}
+		proposal := Proposal{
+			Metadata:        &weave.Metadata{Schema: 1},
+			Title:           p.Title,
+			RawOption:       p.RawOption,
+			Description:     p.Description,
+			ElectionRuleRef: orm.VersionedIDRef{ID: encodeSequence(p.ElectionRuleID), Version: p.ElectionRuleVersion},
+			ElectorateRef:   orm.VersionedIDRef{ID: encodeSequence(p.ElectorateID), Version: p.ElectorateVersion},
+			VotingStartTime: p.VotingStartTime,
+			VotingEndTime:   p.VotingEndTime,
+			SubmissionTime:  p.SubmissionTime,
+			Author:          p.Author,
+			VoteState:       p.VoteState,
+			Status:          p.Status,
+			Result:          p.Result,
+			ExecutorResult:  p.ExecutorResult,
+			TallyTaskID:     p.TallyTaskID,
+		}
+		if _, err := rulesBucket.GetVersion(kv, proposal.ElectionRuleRef); err != nil {
+			return errors.Wrapf(err, "proposal #%d: election rule", i)
+		}
+		if _, err := electBucket.GetVersion(kv, proposal.ElectorateRef); err != nil {
+			return errors.Wrapf(err, "proposal #%d: electorate", i)
+		}
+		if err := proposals.Update(kv, key, &proposal); err != nil {
+			return errors.Wrapf(err, "proposal #%d", i)
+		}
+		seq := proposals.Sequence("id")
+		if err := seq.Raise(kv, int64(p.ID)); err != nil {
+			return errors.Wrapf(err, "proposal #%d: sequence", i)
+		}
+	}
+
+	votes := NewVoteBucket()
+	for i, v := range governance.Votes {
+		proposalID := encodeSequence(v.ProposalID)
+		if _, err := proposals.GetProposal(kv, proposalID); err != nil {
+			return errors.Wrapf(err, "vote #%d", i)
+		}
+		vote := Vote{
+			Metadata: &weave.Metadata{Schema: 1},
+			Elector:  v.Elector,
+			Voted:    v.Voted,
+		}
+		if err := vote.Validate(); err != nil {
+			return errors.Wrapf(err, "vote #%d is invalid", i)
+		}
+		switch voted, err := votes.HasVoted(kv, proposalID, v.Elector.Address); {
+		case err != nil:
+			return errors.Wrapf(err, "vote #%d", i)
+		case voted:
+			return errors.Wrapf(errors.ErrDuplicate, "vote #%d", i)
+		}
+		if err := votes.Save(kv, votes.Build(kv, proposalID, vote)); err != nil {
+			return errors.Wrapf(err, "vote #%d", i)
+		}
+	}
+
+	resolutions := NewResolutionBucket()
+	for i, r := range governance.Resolutions {
+		if r.ID == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "resolution #%d: ID", i)
+		}
+		key := encodeSequence(r.ID)
+		switch obj, err := resolutions.Get(kv, key); {
+		case err != nil:
+			return errors.Wrapf(err, "resolution #%d", i)
+		case obj != nil:
+			return errors.Wrapf(errors.ErrDuplicate, "resolution #%d", i)
+		}
+		resolution := Resolution{
+			Metadata:      &weave.Metadata{Schema: 1},
+			ProposalID:    encodeSequence(r.ProposalID),
+			ElectorateRef: orm.VersionedIDRef{ID: encodeSequence(r.ElectorateID), Version: r.ElectorateVersion},
+			Resolution:    r.Resolution,
+		}
+		if err := resolutions.Save(kv, orm.NewSimpleObj(key, &resolution)); err != nil {
+			return errors.Wrapf(err, "resolution #%d", i)
+		}
+		seq := resolutions.Sequence("id")
+		if err := seq.Raise(kv, int64(r.ID)); err != nil {
+			return errors.Wrapf(err, "resolution #%d: sequence", i)
+		}
 	}
 
 	return nil
 }
 
+// versionedModel is implemented by electorates and election rules.
+type versionedModel interface {
+	orm.CloneableData
+	GetVersion() uint32
+	SetVersion(uint32)
+}
+
+// storeVersion stores given entity as a new entity if version is zero or one,
+// or as the next version of the last stored entity otherwise.
+func storeVersion(kv weave.KVStore, b orm.VersioningBucket, last *orm.VersionedIDRef, version uint32, m versionedModel) (*orm.VersionedIDRef, error) {
+	if version <= 1 {
+		return b.Create(kv, m)
+	}
+	if last == nil || last.Version+1 != version {
+		return nil, errors.Wrapf(errors.ErrInput, "version %d does not follow the previous entity", version)
+	}
+	m.SetVersion(last.Version)
+	return b.Update(kv, last.ID, m)
+}
+
+// ToGenesis will export all versions of all electorates and election rules,
+// together with all proposals, votes and resolutions from the database.
+// Electorates and election rules are exported in the order of their IDs and
+// versions, so that they are assigned the same IDs and versions when loaded
+// again.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	governance := genesisGovernance{
+		Electorate:  []genesisElectorate{},
+		Rules:       []genesisRule{},
+		Proposals:   []genesisProposal{},
+		Votes:       []genesisVote{},
+		Resolutions: []genesisResolution{},
+	}
+
+	var last orm.VersionedIDRef
+	it := orm.IterAll("electorate")
+	for {
+		var e Electorate
+		key, err := it.Next(kv, &e)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "electorate")
+		}
+		if last, err = nextVersionedKey(key, last); err != nil {
+			return nil, errors.Wrap(err, "electorate")
+		}
+		electors := make([]genesisElector, len(e.Electors))
+		for i, el := range e.Electors {
+			electors[i] = genesisElector{Address: el.Address, Weight: el.Weight}
+		}
+		governance.Electorate = append(governance.Electorate, genesisElectorate{
+			Version:  last.Version,
+			Admin:    e.Admin,
+			Title:    e.Title,
+			Electors: electors,
+		})
+	}
+
+	last = orm.VersionedIDRef{}
+	it = orm.IterAll("electnrule")
+	for {
+		var r ElectionRule
+		key, err := it.Next(kv, &r)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "election rule")
+		}
+		if last, err = nextVersionedKey(key, last); err != nil {
+			return nil, errors.Wrap(err, "election rule")
+		}
+		rule := genesisRule{
+			Version:      last.Version,
+			Admin:        r.Admin,
+			ElectorateID: binary.BigEndian.Uint64(r.ElectorateID),
+			Title:        r.Title,
+			VotingPeriod: r.VotingPeriod,
+			Threshold:    genesisFraction{Numerator: r.Threshold.Numerator, Denominator: r.Threshold.Denominator},
+		}
+		if r.Quorum != nil {
+			rule.Quorum = genesisFraction{Numerator: r.Quorum.Numerator, Denominator: r.Quorum.Denominator}
+		}
+		governance.Rules = append(governance.Rules, rule)
+	}
+
+	it = orm.IterAll("proposal")
+	for {
+		var p Proposal
+		key, err := it.Next(kv, &p)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "proposal")
+		}
+		for _, id := range [][]byte{key, p.ElectionRuleRef.ID, p.ElectorateRef.ID} {
+			if err := orm.ValidateSequence(id); err != nil {
+				return nil, errors.Wrapf(err, "proposal %x", key)
+			}
+		}
+		governance.Proposals = append(governance.Proposals, genesisProposal{
+			ID:                  binary.BigEndian.Uint64(key),
+			Title:               p.Title,
+			RawOption:           p.RawOption,
+			Description:         p.Description,
+			ElectionRuleID:      binary.BigEndian.Uint64(p.ElectionRuleRef.ID),
+			ElectionRuleVersion: p.ElectionRuleRef.Version,
+			ElectorateID:        binary.BigEndian.Uint64(p.ElectorateRef.ID),
+			ElectorateVersion:   p.ElectorateRef.Version,
+			VotingStartTime:     p.VotingStartTime,
+			VotingEndTime:       p.VotingEndTime,
+			SubmissionTime:      p.SubmissionTime,
+			Author:              p.Author,
+			VoteState:           p.VoteState,
+			Status:              p.Status,
+			Result:              p.Result,
+			ExecutorResult:      p.ExecutorResult,
+			TallyTaskID:         p.TallyTaskID,
+		})
+	}
+
+	it = orm.IterAll("vote")
+	for {
+		var v Vote
+		key, err := it.Next(kv, &v)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "vote")
+		}
+		if !bytes.HasPrefix(key, v.Elector.Address) {
+			return nil, errors.Wrapf(errors.ErrState, "vote %x is not stored under its elector", key)
+		}
+		proposalID := key[len(v.Elector.Address):]
+		if err := orm.ValidateSequence(proposalID); err != nil {
+			return nil, errors.Wrapf(err, "vote %x", key)
+		}
+		governance.Votes = append(governance.Votes, genesisVote{
+			ProposalID: binary.BigEndian.Uint64(proposalID),
+			Elector:    v.Elector,
+			Voted:      v.Voted,
+		})
+	}
+
+	it = orm.IterAll("resolution")
+	for {
+		var r Resolution
+		key, err := it.Next(kv, &r)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "resolution")
+		}
+		for _, id := range [][]byte{key, r.ProposalID, r.ElectorateRef.ID} {
+			if err := orm.ValidateSequence(id); err != nil {
+				return nil, errors.Wrapf(err, "resolution %x", key)
+			}
+		}
+		governance.Resolutions = append(governance.Resolutions, genesisResolution{
+			ID:                binary.BigEndian.Uint64(key),
+			ProposalID:        binary.BigEndian.Uint64(r.ProposalID),
+			ElectorateID:      binary.BigEndian.Uint64(r.ElectorateRef.ID),
+			ElectorateVersion: r.ElectorateRef.Version,
+			Resolution:        r.Resolution,
+		})
+	}
+
+	opts := make(weave.Options)
+	if err := opts.WriteOptions("governance", governance); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// nextVersionedKey returns the reference stored in given versioned key, if it
+// follows the previously exported one. Keys are iterated in order, so all
+// versions of an entity are next to each other. Entity IDs and versions must
+// be consecutive to be assigned the same values when loaded again.
+func nextVersionedKey(key []byte, last orm.VersionedIDRef) (orm.VersionedIDRef, error) {
+	ref, err := orm.UnmarshalVersionedID(key)
+	if err != nil {
+		return last, err
+	}
+	if err := orm.ValidateSequence(ref.ID); err != nil {
+		return last, err
+	}
+	var lastID uint64
+	if last.ID != nil {
+		lastID = binary.BigEndian.Uint64(last.ID)
+	}
+	switch id := binary.BigEndian.Uint64(ref.ID); {
+	case id == lastID && ref.Version == last.Version+1:
+		return ref, nil
+	case id == lastID+1 && ref.Version == 1:
+		return ref, nil
+	default:
+		return last, errors.Wrapf(errors.ErrState, "ID %d version %d cannot be assigned again", id, ref.Version)
+	}
+}
+
 func encodeSequence(val uint64) []byte {
 	bz := make([]byte, 8)
 	binary.BigEndian.PutUint64(bz, val)
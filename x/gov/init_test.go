@@ -2,13 +2,16 @@ package gov
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
@@ -218,3 +221,92 @@ func addr(s string) weave.Address {
 	}
 	return a
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+
+	ctx := weave.WithBlockTime(context.Background(), time.Now())
+	proposals := withTextProposal(t, db, ctx, func(_ weave.Context, p *Proposal) {
+		p.TallyTaskID = weavetest.SequenceID(9)
+	})
+
+	// Proposal refers to the first version, so newer versions must not
+	// replace it.
+	electorates := NewElectorateBucket()
+	_, obj, err := electorates.GetLatestVersion(db, weavetest.SequenceID(1))
+	assert.Nil(t, err)
+	electorate, err := asElectorate(obj)
+	assert.Nil(t, err)
+	electorate.Title = "updated"
+	_, err = electorates.Update(db, weavetest.SequenceID(1), electorate)
+	assert.Nil(t, err)
+	rules := NewElectionRulesBucket()
+	_, obj, err = rules.GetLatestVersion(db, weavetest.SequenceID(1))
+	assert.Nil(t, err)
+	rule, err := asElectionRule(obj)
+	assert.Nil(t, err)
+	rule.Title = "updated"
+	_, err = rules.Update(db, weavetest.SequenceID(1), rule)
+	assert.Nil(t, err)
+
+	votes := NewVoteBucket()
+	vote := Vote{
+		Metadata: &weave.Metadata{Schema: 1},
+		Elector:  Elector{Address: hAlice, Weight: 1},
+		Voted:    VoteOption_Yes,
+	}
+	assert.Nil(t, votes.Save(db, votes.Build(db, weavetest.SequenceID(1), vote)))
+	_, err = NewResolutionBucket().Create(db, &Resolution{
+		Metadata:      &weave.Metadata{Schema: 1},
+		ProposalID:    weavetest.SequenceID(1),
+		ElectorateRef: orm.VersionedIDRef{ID: weavetest.SequenceID(1), Version: 1},
+		Resolution:    fixtureResolution,
+	})
+	assert.Nil(t, err)
+
+	var ini Initializer
+	opts, err := ini.ToGenesis(db)
+	assert.Nil(t, err)
+	raw, err := json.Marshal(opts)
+	assert.Nil(t, err)
+	var reopts weave.Options
+	assert.Nil(t, json.Unmarshal(raw, &reopts))
+
+	redb := store.MemStore()
+	migration.MustInitPkg(redb, packageName)
+	assert.Nil(t, ini.FromGenesis(reopts, weave.GenesisParams{}, redb))
+
+	for _, b := range []string{"electorate", "electnrule", "proposal", "vote", "resolution"} {
+		assert.Equal(t, dumpBucket(t, db, b), dumpBucket(t, redb, b))
+	}
+	p, err := proposals.GetProposal(redb, weavetest.SequenceID(1))
+	assert.Nil(t, err)
+	assert.Equal(t, weavetest.SequenceID(9), p.TallyTaskID)
+
+	// New entities are not assigned IDs of loaded ones.
+	seq := NewProposalBucket().Sequence("id")
+	id, err := seq.NextVal(redb)
+	assert.Nil(t, err)
+	assert.Equal(t, weavetest.SequenceID(2), id)
+}
+
+// dumpBucket returns all entities stored in the bucket with given name.
+func dumpBucket(t testing.TB, db weave.ReadOnlyKVStore, name string) map[string]string {
+	t.Helper()
+	it, err := db.Iterator([]byte(name+":"), []byte(name+";"))
+	assert.Nil(t, err)
+	defer it.Release()
+
+	res := make(map[string]string)
+	for {
+		switch key, value, err := it.Next(); {
+		case err == nil:
+			res[string(key)] = string(value)
+		case errors.ErrIteratorDone.Is(err):
+			return res
+		default:
+			t.Fatalf("cannot iterate: %s", err)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -14,15 +15,17 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisMsgFee struct {
+	MsgPath string    `json:"msg_path"`
+	Fee     coin.Coin `json:"fee"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type msgfee struct {
-		MsgPath string    `json:"msg_path"`
-		Fee     coin.Coin `json:"fee"`
-	}
-	var fees []*msgfee
+	var fees []*genesisMsgFee
 	if err := opts.ReadOptions("msgfee", &fees); err != nil {
 		return errors.Wrap(err, "cannot load fees")
 	}
@@ -49,3 +52,35 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 
 	return nil
 }
+
+// ToGenesis will export all message fees and the configuration, if present,
+// from the database.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	opts, err := gconf.ExportConfig(kv, "msgfee", &Configuration{})
+	switch {
+	case errors.ErrNotFound.Is(err):
+		opts = make(weave.Options)
+	case err != nil:
+		return nil, errors.Wrap(err, "export config")
+	}
+
+	fees := []genesisMsgFee{}
+	it := orm.IterAll("msgfee")
+	for {
+		var f MsgFee
+		switch _, err := it.Next(kv, &f); {
+		case err == nil:
+			fees = append(fees, genesisMsgFee{
+				MsgPath: f.MsgPath,
+				Fee:     f.Fee,
+			})
+		case errors.ErrIteratorDone.Is(err):
+			if err := opts.WriteOptions("msgfee", fees); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "fee")
+		}
+	}
+}
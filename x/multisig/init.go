@@ -1,8 +1,11 @@
 package multisig
 
 import (
+	"encoding/binary"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -10,18 +13,23 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisParticipant struct {
+	Signature weave.Address `json:"signature"`
+	Weight    Weight        `json:"weight"`
+}
+
+type genesisContract struct {
+	Participants        []genesisParticipant `json:"participants"`
+	ActivationThreshold Weight               `json:"activation_threshold"`
+	AdminThreshold      Weight               `json:"admin_threshold"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it in the
 // database.
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	var contracts []struct {
-		Participants []struct {
-			Signature weave.Address `json:"signature"`
-			Weight    Weight        `json:"weight"`
-		} `json:"participants"`
-		ActivationThreshold Weight `json:"activation_threshold"`
-		AdminThreshold      Weight `json:"admin_threshold"`
-	}
+	var contracts []genesisContract
 	if err := opts.ReadOptions("multisig", &contracts); err != nil {
 		return err
 	}
@@ -52,3 +60,40 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 	}
 	return nil
 }
+
+// ToGenesis will export all contracts from the database. Contracts are
+// exported in the order of their IDs, so that they are assigned the same
+// IDs when loaded again.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	var contracts []genesisContract
+	it := orm.IterAll("contracts")
+	for {
+		var c Contract
+		switch key, err := it.Next(kv, &c); {
+		case err == nil:
+			if len(key) != 8 || binary.BigEndian.Uint64(key) != uint64(len(contracts)+1) {
+				return nil, errors.Wrapf(errors.ErrState, "contract %x cannot be assigned the same ID", key)
+			}
+			ps := make([]genesisParticipant, 0, len(c.Participants))
+			for _, p := range c.Participants {
+				ps = append(ps, genesisParticipant{
+					Signature: p.Signature,
+					Weight:    p.Weight,
+				})
+			}
+			contracts = append(contracts, genesisContract{
+				Participants:        ps,
+				ActivationThreshold: c.ActivationThreshold,
+				AdminThreshold:      c.AdminThreshold,
+			})
+		case errors.ErrIteratorDone.Is(err):
+			opts := make(weave.Options)
+			if err := opts.WriteOptions("multisig", contracts); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "contract")
+		}
+	}
+}
@@ -128,39 +128,51 @@ func (h *transferPaymentChannelHandler) validate(ctx weave.Context, db weave.KVS
 	if err := weave.LoadMsg(tx, &msg); err != nil {
 		return nil, errors.Wrap(err, "load msg")
 	}
-	if weave.GetChainID(ctx) != msg.Payment.ChainID {
-		return nil, errors.Wrap(errors.ErrMsg, "invalid chain ID")
-	}
-
 	var pc PaymentChannel
 	if err := h.bucket.One(db, msg.Payment.ChannelID, &pc); err != nil {
 		return nil, err
 	}
+	if err := VerifyTransfer(weave.GetChainID(ctx), &pc, &msg); err != nil {
+		return &msg, err
+	}
+	return &msg, nil
+}
+
+// VerifyTransfer returns an error if the payment of given transfer message
+// cannot be redeemed from the payment channel on the chain with given ID.
+//
+// Transfer messages are signed by the source off-chain. The destination can
+// use this function to verify a received payment before accepting it. The
+// payment channel must be the one stored under the channel ID of the signed
+// payment.
+func VerifyTransfer(chainID string, pc *PaymentChannel, msg *TransferMsg) error {
+	if chainID != msg.Payment.ChainID {
+		return errors.Wrap(errors.ErrMsg, "invalid chain ID")
+	}
 
 	// Check signature to ensure the message was not altered.
 	raw, err := msg.Payment.Marshal()
 	if err != nil {
-		return nil, errors.Wrap(err, "cannot serialize payment")
+		return errors.Wrap(err, "cannot serialize payment")
 	}
 	if !pc.SourcePubkey.Verify(raw, msg.Signature) {
-		return &msg, errors.Wrap(errors.ErrMsg, "invalid signature")
+		return errors.Wrap(errors.ErrMsg, "invalid signature")
 	}
 
 	if !msg.Payment.Amount.SameType(*pc.Total) {
-		return &msg, errors.Wrap(errors.ErrMsg, "amount and total amount use different ticker")
+		return errors.Wrap(errors.ErrMsg, "amount and total amount use different ticker")
 	}
 
 	if msg.Payment.Amount.Compare(*pc.Total) > 0 {
-		return &msg, errors.Wrap(errors.ErrMsg, "amount greater than total amount")
+		return errors.Wrap(errors.ErrMsg, "amount greater than total amount")
 	}
 	// Payment is representing a cumulative amount that is to be
 	// transferred to destinations account. Because it is cumulative, every
 	// transfer request must be greater than the previous one.
 	if msg.Payment.Amount.Compare(*pc.Transferred) <= 0 {
-		return &msg, errors.Wrap(errors.ErrMsg, "amount must be greater than previously requested")
+		return errors.Wrap(errors.ErrMsg, "amount must be greater than previously requested")
 	}
-
-	return &msg, nil
+	return nil
 }
 
 func (h *transferPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -201,8 +213,10 @@ func (h *transferPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVSt
 	// To avoid "empty" payment channels in our database, delete it without
 	// waiting for the explicit close request.
 	if pc.Transferred.Equals(*pc.Total) {
-		err := h.bucket.Delete(db, msg.Payment.ChannelID)
-		return nil, err
+		if err := h.bucket.Delete(db, msg.Payment.ChannelID); err != nil {
+			return nil, err
+		}
+		return &weave.DeliverResult{}, nil
 	}
 
 	if _, err := h.bucket.Put(db, msg.Payment.ChannelID, &pc); err != nil {
@@ -542,6 +542,66 @@ func TestPaymentChannelHandlers(t *testing.T) {
 	}
 }
 
+func TestVerifyTransfer(t *testing.T) {
+	srcKey := crypto.GenPrivKeyEd25519()
+	channelID := weavetest.SequenceID(1)
+	pc := &PaymentChannel{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Source:       srcKey.PublicKey().Address(),
+		SourcePubkey: srcKey.PublicKey(),
+		Destination:  weavetest.NewCondition().Address(),
+		Total:        dogeCoin(10, 0),
+		Timeout:      weave.AsUnixTime(inOneHour),
+		Transferred:  dogeCoin(2, 0),
+		Address:      paymentChannelAccount(channelID),
+	}
+	transfer := func(chainID string, channelID []byte, amount *coin.Coin) *TransferMsg {
+		return &TransferMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			Payment: &Payment{
+				ChainID:   chainID,
+				ChannelID: channelID,
+				Amount:    amount,
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		msg     *TransferMsg
+		wantErr *errors.Error
+	}{
+		"valid payment": {
+			msg: setSignature(srcKey, transfer("testchain-123", channelID, dogeCoin(3, 0))),
+		},
+		"whole total": {
+			msg: setSignature(srcKey, transfer("testchain-123", channelID, dogeCoin(10, 0))),
+		},
+		"different chain": {
+			msg:     setSignature(srcKey, transfer("otherchain", channelID, dogeCoin(3, 0))),
+			wantErr: errors.ErrMsg,
+		},
+		"signed by another key": {
+			msg:     setSignature(crypto.GenPrivKeyEd25519(), transfer("testchain-123", channelID, dogeCoin(3, 0))),
+			wantErr: errors.ErrMsg,
+		},
+		"amount not greater than transferred": {
+			msg:     setSignature(srcKey, transfer("testchain-123", channelID, dogeCoin(2, 0))),
+			wantErr: errors.ErrMsg,
+		},
+		"amount greater than total": {
+			msg:     setSignature(srcKey, transfer("testchain-123", channelID, dogeCoin(11, 0))),
+			wantErr: errors.ErrMsg,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := VerifyTransfer("testchain-123", pc, tc.msg); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
 func dogeCoin(w, f int64) *coin.Coin {
 	c := coin.NewCoin(w, f, "DOGE")
 	return &c
@@ -0,0 +1,120 @@
+package paychan
+
+import (
+	"encoding/binary"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file.
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisChannel struct {
+	ID     uint64        `json:"id"`
+	Source weave.Address `json:"source"`
+	// SourcePubkey is the protobuf serialized crypto.PublicKey.
+	SourcePubkey []byte         `json:"source_pubkey"`
+	Destination  weave.Address  `json:"destination"`
+	Total        coin.Coin      `json:"total"`
+	Timeout      weave.UnixTime `json:"timeout"`
+	Memo         string         `json:"memo,omitempty"`
+	Transferred  coin.Coin      `json:"transferred"`
+}
+
+// FromGenesis loads all payment channels, each stored under its ID. Funds
+// held by payment channels are part of the cash extension state and are not
+// issued.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	var channels []genesisChannel
+	if err := opts.ReadOptions("paychan", &channels); err != nil {
+		return err
+	}
+	bucket := NewPaymentChannelBucket()
+	for i, g := range channels {
+		if g.ID == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "channel %d: ID", i)
+		}
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, g.ID)
+		switch err := bucket.Has(kv, key); {
+		case err == nil:
+			return errors.Wrapf(errors.ErrDuplicate, "channel %d", i)
+		case !errors.ErrNotFound.Is(err):
+			return errors.Wrapf(err, "channel %d", i)
+		}
+		var pubkey crypto.PublicKey
+		if err := pubkey.Unmarshal(g.SourcePubkey); err != nil {
+			return errors.Wrapf(errors.ErrInput, "channel %d: source public key: %s", i, err)
+		}
+		total, transferred := g.Total, g.Transferred
+		pc := PaymentChannel{
+			Metadata:     &weave.Metadata{Schema: 1},
+			Source:       g.Source,
+			SourcePubkey: &pubkey,
+			Destination:  g.Destination,
+			Total:        &total,
+			Timeout:      g.Timeout,
+			Memo:         g.Memo,
+			Transferred:  &transferred,
+			Address:      paymentChannelAccount(key),
+		}
+		if _, err := bucket.Put(kv, key, &pc); err != nil {
+			return errors.Wrapf(err, "channel %d", i)
+		}
+		if err := paymentChannelSeq.Raise(kv, int64(g.ID)); err != nil {
+			return errors.Wrapf(err, "channel %d: sequence", i)
+		}
+	}
+	return nil
+}
+
+// ToGenesis exports all payment channels together with their IDs.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	channels := []genesisChannel{}
+	it := orm.IterAll("paychan")
+	for {
+		var pc PaymentChannel
+		switch key, err := it.Next(kv, &pc); {
+		case err == nil:
+			if err := orm.ValidateSequence(key); err != nil {
+				return nil, errors.Wrapf(err, "channel %x", key)
+			}
+			if pc.SourcePubkey == nil || pc.Total == nil || pc.Transferred == nil {
+				return nil, errors.Wrapf(errors.ErrState, "channel %x is incomplete", key)
+			}
+			if !pc.Address.Equals(paymentChannelAccount(key)) {
+				return nil, errors.Wrapf(errors.ErrState, "channel %x address is not derived from its ID", key)
+			}
+			pubkey, err := pc.SourcePubkey.Marshal()
+			if err != nil {
+				return nil, errors.Wrapf(err, "channel %x: source public key", key)
+			}
+			channels = append(channels, genesisChannel{
+				ID:           binary.BigEndian.Uint64(key),
+				Source:       pc.Source,
+				SourcePubkey: pubkey,
+				Destination:  pc.Destination,
+				Total:        *pc.Total,
+				Timeout:      pc.Timeout,
+				Memo:         pc.Memo,
+				Transferred:  *pc.Transferred,
+			})
+		case errors.ErrIteratorDone.Is(err):
+			opts := make(weave.Options)
+			if err := opts.WriteOptions("paychan", channels); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "channel")
+		}
+	}
+}
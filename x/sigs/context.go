@@ -14,6 +14,7 @@ type contextKey int // local to the auth module
 
 const (
 	contextKeySigners contextKey = iota
+	contextKeyPendingTxs
 )
 
 // withSigners is a private method, as only this module
@@ -22,6 +23,18 @@ func withSigners(ctx weave.Context, signers []weave.Condition) weave.Context {
 	return context.WithValue(ctx, contextKeySigners, signers)
 }
 
+// WithPendingTxs returns a context that provides given index of pending
+// transactions. It must be used only for checking transactions, for example
+// via app.BaseApp.WithCheckContext, as the index is local to the node.
+func WithPendingTxs(ctx weave.Context, p *PendingTxs) weave.Context {
+	return context.WithValue(ctx, contextKeyPendingTxs, p)
+}
+
+func pendingTxsFromContext(ctx weave.Context) (*PendingTxs, bool) {
+	p, ok := ctx.Value(contextKeyPendingTxs).(*PendingTxs)
+	return p, ok && p != nil
+}
+
 // Authenticate implements x.Authenticator and provides
 // authentication based on public-key signatures.
 type Authenticate struct{}
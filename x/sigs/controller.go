@@ -7,6 +7,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 )
 
 // SignCodeV1 is the current way to prefix the bytes we use to build
@@ -27,6 +28,9 @@ var SignCodeV1 = []byte{0, 0xCA, 0xFE, 0}
 // VerifyTxSignatures checks all the signatures on the tx,
 // which must have at least one.
 //
+// All signatures are verified at once as a batch. Only if the batch is
+// invalid, signatures are verified one by one to find the invalid one.
+//
 // returns list of signer addresses (possibly empty),
 // or error if any signature is invalid
 func VerifyTxSignatures(store weave.KVStore, tx SignedTx,
@@ -38,14 +42,51 @@ func VerifyTxSignatures(store weave.KVStore, tx SignedTx,
 	}
 	sigs := tx.GetSignatures()
 
-	signers := make([]weave.Condition, 0, len(sigs))
-	for _, sig := range sigs {
-		// TODO: separate into own function (verify one sig)
-		signer, err := VerifySignature(store, sig, bz, chainID)
+	// Each signer is loaded once. A signer that signed more than once is
+	// represented by the same object, so that its sequence is incremented
+	// for every signature.
+	bucket := NewBucket()
+	loaded := make(map[string]orm.Object)
+	users := make([]orm.Object, len(sigs))
+	toSign := make([][]byte, len(sigs))
+	batch := crypto.NewBatchVerifier()
+	for i, sig := range sigs {
+		// we guarantee sequence makes sense and pubkey or address is there
+		if err := sig.Validate(); err != nil {
+			return nil, err
+		}
+		addr := sig.Pubkey.Address()
+		obj, ok := loaded[string(addr)]
+		if !ok {
+			obj, err = bucket.GetOrCreate(store, sig.Pubkey)
+			if err != nil {
+				return nil, err
+			}
+			loaded[string(addr)] = obj
+		}
+		users[i] = obj
+		toSign[i], err = BuildSignBytes(bz, chainID, sig.Sequence)
 		if err != nil {
 			return nil, err
 		}
-		signers = append(signers, signer)
+		batch.Add(AsUser(obj).Pubkey, toSign[i], sig.Signature)
+	}
+
+	verified := len(sigs) > 1 && batch.Verify()
+
+	signers := make([]weave.Condition, 0, len(sigs))
+	for i, sig := range sigs {
+		user := AsUser(users[i])
+		if !verified && !user.Pubkey.Verify(toSign[i], sig.Signature) {
+			return nil, errors.Wrap(errors.ErrUnauthorized, "invalid signature")
+		}
+		if err := user.CheckAndIncrementSequence(sig.Sequence); err != nil {
+			return nil, err
+		}
+		if err := bucket.Save(store, users[i]); err != nil {
+			return nil, err
+		}
+		signers = append(signers, user.Pubkey.Condition())
 	}
 
 	return signers, nil
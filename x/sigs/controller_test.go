@@ -189,6 +189,87 @@ func TestVerifyTxSignatures(t *testing.T) {
 	assert.Equal(t, []weave.Condition{addr, addr2}, signers)
 }
 
+func TestVerifyTxSignaturesBatch(t *testing.T) {
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "sigs")
+
+	priv := weavetest.NewKey()
+	priv2 := weavetest.NewKey()
+	priv3 := weavetest.NewKey()
+
+	chainID := "batch_chain"
+	tx := NewStdTx([]byte("many signers"))
+
+	sign := func(key crypto.Signer, seq int64) *StdSignature {
+		t.Helper()
+		sig, err := SignTx(key, tx, chainID, seq)
+		assert.Nil(t, err)
+		return sig
+	}
+
+	// All signatures are valid, including two of the same signer.
+	tx.Signatures = []*StdSignature{sign(priv, 0), sign(priv2, 0), sign(priv, 1)}
+	signers, err := VerifyTxSignatures(kv, tx, chainID)
+	assert.Nil(t, err)
+	want := []weave.Condition{
+		priv.PublicKey().Condition(),
+		priv2.PublicKey().Condition(),
+		priv.PublicKey().Condition(),
+	}
+	assert.Equal(t, want, signers)
+
+	obj, err := NewBucket().GetOrCreate(kv, priv.PublicKey())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), AsUser(obj).Sequence)
+
+	// Sequence is checked for signatures verified in a batch.
+	tx.Signatures = []*StdSignature{sign(priv2, 0), sign(priv, 2)}
+	if _, err := VerifyTxSignatures(kv, tx, chainID); !ErrInvalidSequence.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A single invalid signature fails the whole transaction.
+	bad := sign(priv3, 0)
+	bad.Signature.GetEd25519()[3] ^= 0xff
+	tx.Signatures = []*StdSignature{sign(priv, 2), sign(priv2, 1), bad}
+	if _, err := VerifyTxSignatures(kv, tx, chainID); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestVerifyTxSignaturesSecp256k1(t *testing.T) {
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "sigs")
+
+	priv := crypto.GenPrivKeySecp256k1()
+	addr := priv.PublicKey().Condition()
+	priv2 := weavetest.NewKey()
+	addr2 := priv2.PublicKey().Condition()
+
+	chainID := "mixed_keys"
+	tx := NewStdTx([]byte("secp256k1 and ed25519"))
+
+	sig, err := SignTx(priv, tx, chainID, 0)
+	assert.Nil(t, err)
+	sig2, err := SignTx(priv2, tx, chainID, 0)
+	assert.Nil(t, err)
+
+	// Both key types can sign the same transaction.
+	tx.Signatures = []*StdSignature{sig, sig2}
+	signers, err := VerifyTxSignatures(kv, tx, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, []weave.Condition{addr, addr2}, signers)
+
+	// Modified signature is rejected.
+	sig, err = SignTx(priv, tx, chainID, 1)
+	assert.Nil(t, err)
+	sig.Signature.GetSecp256K1()[7] ^= 0xff
+	tx.Signatures = []*StdSignature{sig}
+	if _, err := VerifyTxSignatures(kv, tx, chainID); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 type StdTx struct {
 	weave.Tx
 	Signatures []*StdSignature
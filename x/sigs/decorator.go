@@ -8,6 +8,7 @@ package sigs
 import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
 )
 
 const (
@@ -27,6 +28,7 @@ func RegisterQuery(qr weave.QueryRouter) {
 // Decorator verifies the signatures and adds them to the context
 type Decorator struct {
 	allowMissingSigs bool
+	allowReplacement bool
 }
 
 var _ weave.Decorator = Decorator{}
@@ -46,6 +48,21 @@ func (d Decorator) AllowMissingSigs() Decorator {
 	return d
 }
 
+// AllowReplacement allows a pending transaction to be replaced by another
+// transaction signed with the same sequence, if the new one has a higher
+// priority (weave.CheckResult.Priority), for example because it pays a higher
+// fee. The replaced transaction is reverted from the check state and fails
+// the next check, which removes it from the mempool when Tendermint checks
+// the mempool again after a block.
+//
+// Pending transactions are tracked by PendingTxs, which must be provided to
+// the check context using WithPendingTxs. Without it, no transaction can be
+// replaced.
+func (d Decorator) AllowReplacement() Decorator {
+	d.allowReplacement = true
+	return d
+}
+
 // Check verifies signatures before calling down the stack.
 func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
 	stx, ok := tx.(SignedTx)
@@ -53,6 +70,18 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 		return next.Check(ctx, store, tx)
 	}
 
+	if err := chargeSignatures(ctx, stx); err != nil {
+		return nil, err
+	}
+	if d.allowReplacement {
+		if pending, ok := pendingTxsFromContext(ctx); ok {
+			return d.checkPending(ctx, store, tx, stx, pending, next)
+		}
+	}
+	return d.check(ctx, store, tx, stx, next)
+}
+
+func (d Decorator) check(ctx weave.Context, store weave.KVStore, tx weave.Tx, stx SignedTx, next weave.Checker) (*weave.CheckResult, error) {
 	chainID := weave.GetChainID(ctx)
 	signers, err := VerifyTxSignatures(store, stx, chainID)
 	if err != nil {
@@ -75,6 +104,73 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 	return res, nil
 }
 
+// checkPending checks a transaction and records it as pending. If the
+// transaction is signed with a sequence already used by a pending
+// transaction, the pending one is reverted first, so that the transaction is
+// checked against the state without any of its changes. It is accepted only
+// if it has a higher priority than the transaction it replaces. The changes
+// of the accepted transaction are kept in the check state.
+func (d Decorator) checkPending(
+	ctx weave.Context,
+	db weave.KVStore,
+	tx weave.Tx,
+	stx SignedTx,
+	pending *PendingTxs,
+	next weave.Checker,
+) (*weave.CheckResult, error) {
+	cstore, ok := db.(weave.CacheableKVStore)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "need cachable kvstore")
+	}
+	id, err := pendingTxID(stx)
+	if err != nil {
+		return nil, err
+	}
+	keys := pendingKeys(stx)
+	height, _ := weave.GetHeight(ctx)
+
+	pending.mu.Lock()
+	defer pending.mu.Unlock()
+	pending.prune(height)
+
+	// State without the changes of the replaced transactions.
+	reverted := cstore.CacheWrap()
+	defer reverted.Discard()
+	replaced := pending.conflicts(id, keys)
+	if err := pending.revert(reverted, height, replaced); err != nil {
+		return nil, err
+	}
+
+	cache := reverted.CacheWrap()
+	defer cache.Discard()
+	recording := store.NewRecordingStore(cache)
+	res, err := d.check(ctx, recording, tx, stx, next)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := recording.(store.Recorder).KVPairs()
+	previous := make(map[string][]byte, len(changes))
+	for key := range changes {
+		value, err := reverted.Get([]byte(key))
+		if err != nil {
+			return nil, errors.Wrap(err, "pending transaction state")
+		}
+		previous[key] = value
+	}
+	if err := pending.replace(id, keys, res.Priority, height, previous, replaced); err != nil {
+		return nil, err
+	}
+
+	if err := cache.Write(); err != nil {
+		return nil, err
+	}
+	if err := reverted.Write(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // Deliver verifies signatures before calling down the stack.
 func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
 	stx, ok := tx.(SignedTx)
@@ -82,6 +178,9 @@ func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 		return next.Deliver(ctx, store, tx)
 	}
 
+	if err := chargeSignatures(ctx, stx); err != nil {
+		return nil, err
+	}
 	chainID := weave.GetChainID(ctx)
 	signers, err := VerifyTxSignatures(store, stx, chainID)
 	if err != nil {
@@ -94,3 +193,11 @@ func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 	ctx = withSigners(ctx, signers)
 	return next.Deliver(ctx, store, tx)
 }
+
+// chargeSignatures consumes gas for the verification of every signature of
+// the transaction. Gas is charged upfront, so that the verification of
+// invalid signatures is paid as well.
+func chargeSignatures(ctx weave.Context, tx SignedTx) error {
+	cost := int64(len(tx.GetSignatures()) * signatureVerifyCost)
+	return weave.ConsumeGas(ctx, cost, "signature verification")
+}
@@ -153,3 +153,169 @@ func TestGasPaymentPerSigner(t *testing.T) {
 		t.Fatalf("want %d gas payment, got %d", want, got)
 	}
 }
+
+func TestGasMeterChargedPerSignature(t *testing.T) {
+	var (
+		h weavetest.Handler
+		d Decorator
+	)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "sigs")
+
+	priv := weavetest.NewKey()
+	tx := NewStdTx([]byte("foo"))
+	if sig, err := SignTx(priv, tx, "mychain", 0); err != nil {
+		t.Fatalf("cannot sign the transaction: %s", err)
+	} else {
+		tx.Signatures = []*StdSignature{sig}
+	}
+
+	meter := weave.NewGasMeter(0)
+	ctx := weave.WithChainID(context.Background(), "mychain")
+	ctx = weave.WithGasMeter(ctx, meter)
+	if _, err := d.Deliver(ctx, db, tx, &h); err != nil {
+		t.Fatalf("cannot deliver: %s", err)
+	}
+	if got, want := meter.GasConsumed(), int64(signatureVerifyCost); want != got {
+		t.Fatalf("want %d gas consumed, got %d", want, got)
+	}
+
+	// Not enough gas to verify the signature.
+	ctx = weave.WithChainID(context.Background(), "mychain")
+	ctx = weave.WithGasMeter(ctx, weave.NewGasMeter(signatureVerifyCost-1))
+	if _, err := d.Check(ctx, db, tx, &h); !errors.ErrOutOfGas.Is(err) {
+		t.Fatalf("want out of gas error, got %v", err)
+	}
+}
+
+func TestDecoratorReplacement(t *testing.T) {
+	const chainID = "replace-me"
+	pending := NewPendingTxs()
+	base := weave.WithChainID(context.Background(), chainID)
+	ctx := WithPendingTxs(weave.WithHeight(base, 10), pending)
+	priv := weavetest.NewKey()
+
+	sign := func(payload string, seq int64) *StdTx {
+		tx := NewStdTx([]byte(payload))
+		sig, err := SignTx(priv, tx, chainID, seq)
+		assert.Nil(t, err)
+		tx.Signatures = []*StdSignature{sig}
+		return tx
+	}
+
+	committed := store.MemStore()
+	migration.MustInitPkg(committed, "sigs")
+
+	d := NewDecorator().AllowReplacement()
+	txA := sign("first", 0)
+	txB := sign("second", 0)
+
+	checkState := committed.CacheWrap()
+	_, err := d.Check(ctx, checkState, txA, &writeHandler{priority: 10})
+	assert.Nil(t, err)
+	assertWritten(t, checkState, "first", true)
+
+	// Replacing transaction must have a higher priority.
+	if _, err := d.Check(ctx, checkState, txB, &writeHandler{priority: 10}); !ErrInvalidSequence.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	assertWritten(t, checkState, "first", true)
+	assertWritten(t, checkState, "second", false)
+
+	// The replacement is checked against the state without the changes
+	// of the replaced transaction, and its own changes are kept.
+	_, err = d.Check(ctx, checkState, txB, &writeHandler{priority: 11, requireMissing: "first"})
+	assert.Nil(t, err)
+	assertWritten(t, checkState, "first", false)
+	assertWritten(t, checkState, "second", true)
+
+	// Sequence was incremented only once.
+	obj, err := NewBucket().GetOrCreate(checkState, priv.PublicKey())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), AsUser(obj).Sequence)
+
+	// A transaction with the next sequence is not affected.
+	_, err = d.Check(ctx, checkState, sign("third", 1), &writeHandler{priority: 1})
+	assert.Nil(t, err)
+
+	// The replacing transaction cannot be replaced anymore, because the
+	// next transaction depends on its sequence increment.
+	if _, err := d.Check(ctx, checkState, sign("fourth", 0), &writeHandler{priority: 20}); !ErrInvalidSequence.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	assertWritten(t, checkState, "second", true)
+	assertWritten(t, checkState, "fourth", false)
+
+	// After a block is committed, pending transactions are checked again.
+	// The replaced one is rejected.
+	ctx = WithPendingTxs(weave.WithHeight(base, 11), pending)
+	checkState = committed.CacheWrap()
+	if _, err := d.Check(ctx, checkState, txA, &writeHandler{priority: 10}); !ErrInvalidSequence.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	_, err = d.Check(ctx, checkState, txB, &writeHandler{priority: 11})
+	assert.Nil(t, err)
+
+	// Delivery does not depend on pending transactions.
+	_, err = d.Deliver(ctx, committed.CacheWrap(), txA, &writeHandler{priority: 10})
+	assert.Nil(t, err)
+
+	// Without pending transactions in the context, the sequence can be
+	// used only once.
+	ctx = base
+	checkState = committed.CacheWrap()
+	_, err = d.Check(ctx, checkState, txA, &writeHandler{priority: 10})
+	assert.Nil(t, err)
+	if _, err := d.Check(ctx, checkState, txB, &writeHandler{priority: 11}); !ErrInvalidSequence.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+// writeHandler stores the payload of the processed transaction and returns
+// the configured priority. If requireMissing is set, it fails if the
+// payload with that value is stored.
+type writeHandler struct {
+	priority       int64
+	requireMissing string
+}
+
+func (h *writeHandler) write(db weave.KVStore, tx weave.Tx) error {
+	if h.requireMissing != "" {
+		if ok, err := db.Has([]byte("payload:" + h.requireMissing)); err != nil || ok {
+			return errors.Wrap(errors.ErrState, "replaced transaction not reverted")
+		}
+	}
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return err
+	}
+	payload, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+	return db.Set(append([]byte("payload:"), payload...), []byte{1})
+}
+
+func (h *writeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if err := h.write(db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{Priority: h.priority}, nil
+}
+
+func (h *writeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	if err := h.write(db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func assertWritten(t testing.TB, db weave.ReadOnlyKVStore, payload string, want bool) {
+	t.Helper()
+	ok, err := db.Has([]byte("payload:" + payload))
+	assert.Nil(t, err)
+	if ok != want {
+		t.Fatalf("payload %q stored: %v", payload, ok)
+	}
+}
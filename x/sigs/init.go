@@ -0,0 +1,83 @@
+package sigs
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file.
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisUser struct {
+	// Pubkey is the protobuf serialized crypto.PublicKey.
+	Pubkey   []byte `json:"pubkey"`
+	Sequence int64  `json:"sequence"`
+}
+
+// FromGenesis loads the signer sequences, so that transactions signed for
+// the exported state cannot be replayed. Each user is stored under the
+// address of its public key.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	var users []genesisUser
+	if err := opts.ReadOptions("sigs", &users); err != nil {
+		return err
+	}
+	bucket := NewBucket()
+	for i, g := range users {
+		var pubkey crypto.PublicKey
+		if err := pubkey.Unmarshal(g.Pubkey); err != nil {
+			return errors.Wrapf(errors.ErrInput, "user %d: public key: %s", i, err)
+		}
+		switch obj, err := bucket.Get(kv, pubkey.Address()); {
+		case err != nil:
+			return errors.Wrapf(err, "user %d", i)
+		case obj != nil:
+			return errors.Wrapf(errors.ErrDuplicate, "user %d", i)
+		}
+		obj := NewUser(&pubkey)
+		AsUser(obj).Sequence = g.Sequence
+		if err := bucket.Save(kv, obj); err != nil {
+			return errors.Wrapf(err, "user %d", i)
+		}
+	}
+	return nil
+}
+
+// ToGenesis exports the public keys and sequences of all signers.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	users := []genesisUser{}
+	it := orm.IterAll(BucketName)
+	for {
+		var u UserData
+		switch key, err := it.Next(kv, &u); {
+		case err == nil:
+			if u.Pubkey == nil || !bytes.Equal(key, u.Pubkey.Address()) {
+				return nil, errors.Wrapf(errors.ErrState, "user %X is not stored under its public key address", key)
+			}
+			pubkey, err := u.Pubkey.Marshal()
+			if err != nil {
+				return nil, errors.Wrapf(err, "user %X: public key", key)
+			}
+			users = append(users, genesisUser{
+				Pubkey:   pubkey,
+				Sequence: u.Sequence,
+			})
+		case errors.ErrIteratorDone.Is(err):
+			opts := make(weave.Options)
+			if err := opts.WriteOptions("sigs", users); err != nil {
+				return nil, err
+			}
+			return opts, nil
+		default:
+			return nil, errors.Wrap(err, "user")
+		}
+	}
+}
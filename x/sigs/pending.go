@@ -0,0 +1,214 @@
+package sigs
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sync"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// pendingTTL is the number of blocks after which a pending transaction that
+// was not checked again is forgotten. Tendermint checks all transactions
+// left in the mempool after every block, which keeps their entries fresh.
+const pendingTTL = 5
+
+// PendingTxs is an in memory index of transactions that passed the check and
+// wait to be included in a block. For every signer and sequence pair, it
+// remembers the transaction with the highest priority together with the
+// changes that transaction made to the check state, so that it can be
+// reverted when replaced.
+//
+// This information is local to the node. It is provided to the check context
+// using WithPendingTxs and must never be used when delivering transactions.
+// A single instance must be used for the whole lifetime of the application.
+type PendingTxs struct {
+	mu      sync.Mutex
+	height  int64
+	entries map[pendingKey]*pendingTx
+	// writers maps every key changed by a pending transaction checked at
+	// the current height to the ID of the last transaction that changed
+	// it.
+	writers map[string][]byte
+}
+
+type pendingKey struct {
+	signer   string
+	sequence int64
+}
+
+type pendingTx struct {
+	id       []byte
+	priority int64
+	height   int64
+	// undo holds the state of all keys changed by the transaction, from
+	// before it was checked.
+	undo map[string]undoEntry
+}
+
+type undoEntry struct {
+	// value is nil if the key did not exist.
+	value []byte
+	// writer is the ID of the pending transaction that changed the key
+	// before, if any.
+	writer []byte
+}
+
+// NewPendingTxs returns an empty index of pending transactions.
+func NewPendingTxs() *PendingTxs {
+	return &PendingTxs{
+		entries: make(map[pendingKey]*pendingTx),
+		writers: make(map[string][]byte),
+	}
+}
+
+// conflicts returns all pending transactions, other than the one with given
+// ID, that use any signer and sequence pair of the transaction.
+func (p *PendingTxs) conflicts(id []byte, keys []pendingKey) []*pendingTx {
+	var res []*pendingTx
+	for _, key := range keys {
+		current, ok := p.entries[key]
+		if !ok || bytes.Equal(current.id, id) {
+			continue
+		}
+		var seen bool
+		for _, tx := range res {
+			if tx == current {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			res = append(res, current)
+		}
+	}
+	return res
+}
+
+// revert restores the state from before given pending transactions were
+// checked. A transaction checked before the current height is not part of
+// the check state and is ignored. Reverting fails if a key changed by a
+// transaction was changed again by another one, because the other
+// transaction depends on it.
+func (p *PendingTxs) revert(db weave.KVStore, height int64, txs []*pendingTx) error {
+	for _, tx := range txs {
+		if tx.height != height {
+			continue
+		}
+		for key := range tx.undo {
+			if !bytes.Equal(p.writers[key], tx.id) {
+				return errors.Wrap(ErrInvalidSequence, "pending transaction changes are used by another transaction")
+			}
+		}
+	}
+	for _, tx := range txs {
+		if tx.height != height {
+			continue
+		}
+		for key, u := range tx.undo {
+			var err error
+			if u.value == nil {
+				err = db.Delete([]byte(key))
+			} else {
+				err = db.Set([]byte(key), u.value)
+			}
+			if err != nil {
+				return errors.Wrap(err, "revert pending transaction")
+			}
+		}
+	}
+	return nil
+}
+
+// replace records the transaction as the pending one for all of its signer
+// and sequence pairs, replacing given conflicting transactions, which must be
+// already reverted. Previous holds the state of all keys changed by the
+// transaction, from before it was checked. It fails if any of the
+// conflicting transactions has the same or higher priority.
+func (p *PendingTxs) replace(
+	id []byte,
+	keys []pendingKey,
+	priority int64,
+	height int64,
+	previous map[string][]byte,
+	replaced []*pendingTx,
+) error {
+	for _, tx := range replaced {
+		if tx.priority >= priority {
+			return errors.Wrapf(ErrInvalidSequence,
+				"sequence is used by a pending transaction with priority %d", tx.priority)
+		}
+	}
+	for _, tx := range replaced {
+		for key, entry := range p.entries {
+			if entry == tx {
+				delete(p.entries, key)
+			}
+		}
+		if tx.height != height {
+			continue
+		}
+		for key, u := range tx.undo {
+			if u.writer == nil {
+				delete(p.writers, key)
+			} else {
+				p.writers[key] = u.writer
+			}
+		}
+	}
+
+	undo := make(map[string]undoEntry, len(previous))
+	for key, value := range previous {
+		undo[key] = undoEntry{value: value, writer: p.writers[key]}
+		p.writers[key] = id
+	}
+	tx := &pendingTx{id: id, priority: priority, height: height, undo: undo}
+	for _, key := range keys {
+		p.entries[key] = tx
+	}
+	return nil
+}
+
+// prune removes all entries that were not refreshed for pendingTTL blocks.
+// The check state is reset after every block, so changes recorded at a lower
+// height are no longer part of it.
+func (p *PendingTxs) prune(height int64) {
+	if height == p.height {
+		return
+	}
+	p.height = height
+	p.writers = make(map[string][]byte)
+	for key, tx := range p.entries {
+		if tx.height+pendingTTL < height {
+			delete(p.entries, key)
+		}
+	}
+}
+
+func pendingKeys(tx SignedTx) []pendingKey {
+	sigs := tx.GetSignatures()
+	keys := make([]pendingKey, 0, len(sigs))
+	for _, sig := range sigs {
+		if sig.GetPubkey() == nil {
+			continue
+		}
+		keys = append(keys, pendingKey{
+			signer:   sig.Pubkey.Address().String(),
+			sequence: sig.Sequence,
+		})
+	}
+	return keys
+}
+
+// pendingTxID returns a value that identifies the transaction. Signatures
+// are not part of it, because the same content signed with the same sequence
+// is the same transaction.
+func pendingTxID(tx SignedTx) ([]byte, error) {
+	bz, err := tx.GetSignBytes()
+	if err != nil {
+		return nil, err
+	}
+	id := sha256.Sum256(bz)
+	return id[:], nil
+}
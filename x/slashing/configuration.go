@@ -0,0 +1,23 @@
+package slashing
+
+import (
+	"github.com/iov-one/weave/errors"
+)
+
+func (c *Configuration) Validate() error {
+	var errs error
+	// Owner field is optional.
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	if c.MissedBlocksLimit < 0 {
+		errs = errors.Append(errs, errors.Field("MissedBlocksLimit", errors.ErrInput, "cannot be negative"))
+	}
+	if c.JailPeriod < 0 {
+		errs = errors.Append(errs, errors.Field("JailPeriod", errors.ErrInput, "cannot be negative"))
+	}
+	if c.DoubleSignSlashPercent > 100 {
+		errs = errors.Append(errs, errors.Field("DoubleSignSlashPercent", errors.ErrInput, "cannot be greater than 100"))
+	}
+	return errs
+}
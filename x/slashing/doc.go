@@ -0,0 +1,21 @@
+/*
+Package slashing punishes validators that misbehave.
+
+Tendermint reports evidence of double signing and information about which
+validators signed the last block. This extension records reported evidence and
+counts the number of consecutive blocks each validator did not sign.
+
+A validator with a double sign evidence loses a configured percentage of its
+power and is jailed. A validator that did not sign more consecutive blocks than
+allowed is jailed. A jailed validator is removed from the validator set by
+setting its power to zero.
+
+Once the jail period is over, a jailed validator can send an `UnjailMsg`
+signed with the validator key to be added back to the validator set with the
+power it had when jailed, reduced by any punishment.
+
+Thresholds are kept in the extension configuration (see gconf package).
+Slashing is disabled unless the configuration is present. The EndBlocker must
+be registered in the application for this extension to work.
+*/
+package slashing
@@ -0,0 +1,278 @@
+package slashing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+// NewEndBlocker returns an end blocker that punishes misbehaving validators.
+// It must be registered in the application to enable slashing.
+func NewEndBlocker() *EndBlocker {
+	return &EndBlocker{
+		infos:     NewSigningInfoBucket(),
+		evidences: NewEvidenceBucket(),
+	}
+}
+
+// EndBlocker records validators misbehaviour reported by tendermint and
+// jails or reduces the power of misbehaving validators. It does this by
+// implementing weave.EndBlocker interface.
+//
+// Double sign evidence reduces the validator power as configured and jails
+// the validator. A validator that did not sign too many consecutive blocks is
+// jailed. A jailed validator is removed from the validator set until it
+// requests to be unjailed.
+type EndBlocker struct {
+	infos     orm.ModelBucket
+	evidences orm.ModelBucket
+	slasher   PowerSlasher
+}
+
+var _ weave.EndBlocker = (*EndBlocker)(nil)
+
+// PowerSlasher is implemented by an extension that derives the validator
+// power from its own state, for example from the bonded stake. It is notified
+// about the power taken away as a double sign punishment, so that the
+// punishment persists when that extension recalculates the validator power.
+type PowerSlasher interface {
+	SlashPower(db weave.KVStore, validator weave.PubKey, power int64) error
+}
+
+// WithPowerSlasher configures the end blocker to notify given slasher about
+// every double sign punishment.
+func (e *EndBlocker) WithPowerSlasher(s PowerSlasher) *EndBlocker {
+	e.slasher = s
+	return e
+}
+
+// EndBlock implements weave.EndBlocker interface.
+//
+// All changes are done atomically and apply only on success.
+func (e *EndBlocker) EndBlock(ctx weave.Context, db weave.CacheableKVStore) weave.TickResult {
+	cache := db.CacheWrap()
+	tags, diff, err := e.endBlock(ctx, cache)
+	if err == nil {
+		err = cache.Write()
+	}
+	if err != nil {
+		cache.Discard()
+		// This is a hopeless state. This error is most likely due to a
+		// database issues or some other instance specific problems.
+		// This instance is out of sync with the rest of the network
+		// and cannot continue operating.
+		panic(fmt.Sprintf("slashing: %+v", err))
+	}
+	return weave.TickResult{Tags: tags, Diff: diff}
+}
+
+func (e *EndBlocker) endBlock(ctx weave.Context, db weave.KVStore) ([]common.KVPair, []weave.ValidatorUpdate, error) {
+	var conf Configuration
+	switch err := gconf.Load(db, "slashing", &conf); {
+	case err == nil:
+		// All good.
+	case errors.ErrNotFound.Is(err):
+		// Slashing is disabled unless configured.
+		return nil, nil, nil
+	default:
+		return nil, nil, errors.Wrap(err, "cannot load configuration")
+	}
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot get current time")
+	}
+
+	s, err := newSlasher(db, e.infos, e.slasher, now, conf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	evidence, _ := weave.GetEvidence(ctx)
+	for _, ev := range evidence {
+		if err := s.handleEvidence(ev, e.evidences); err != nil {
+			return nil, nil, errors.Wrap(err, "cannot handle evidence")
+		}
+	}
+
+	if conf.MissedBlocksLimit > 0 {
+		info, _ := weave.GetCommitInfo(ctx)
+		for _, vote := range info.Votes {
+			if err := s.handleVote(vote.Validator.Address, vote.SignedLastBlock); err != nil {
+				return nil, nil, errors.Wrap(err, "cannot handle vote")
+			}
+		}
+	}
+
+	if len(s.diff) != 0 {
+		if err := updateValidators(db, s.diff); err != nil {
+			return nil, nil, errors.Wrap(err, "cannot update validators")
+		}
+	}
+	return s.tags, s.diff, nil
+}
+
+// slasher gathers the state of a single end block execution.
+type slasher struct {
+	db      weave.KVStore
+	infos   orm.ModelBucket
+	slasher PowerSlasher
+	now     time.Time
+	conf    Configuration
+
+	// validators maps tendermint address to an active validator.
+	validators map[string]weave.ValidatorUpdate
+
+	tags []common.KVPair
+	diff []weave.ValidatorUpdate
+}
+
+func newSlasher(db weave.KVStore, infos orm.ModelBucket, ps PowerSlasher, now time.Time, conf Configuration) (*slasher, error) {
+	updates, err := weave.GetValidatorUpdates(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load validators")
+	}
+	validators := make(map[string]weave.ValidatorUpdate, len(updates.ValidatorUpdates))
+	for _, v := range updates.ValidatorUpdates {
+		validators[string(ValidatorAddress(v.PubKey))] = v
+	}
+	return &slasher{
+		db:         db,
+		infos:      infos,
+		slasher:    ps,
+		now:        now,
+		conf:       conf,
+		validators: validators,
+	}, nil
+}
+
+// signingInfo returns the signing information of a validator with given
+// address. It returns ErrNotFound if the validator is not known.
+func (s *slasher) signingInfo(address []byte) (*SigningInfo, error) {
+	var info SigningInfo
+	switch err := s.infos.One(s.db, address, &info); {
+	case err == nil:
+		return &info, nil
+	case !errors.ErrNotFound.Is(err):
+		return nil, errors.Wrap(err, "cannot load signing info")
+	}
+	v, ok := s.validators[string(address)]
+	if !ok {
+		return nil, errors.Wrap(errors.ErrNotFound, "unknown validator")
+	}
+	return &SigningInfo{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  address,
+		PubKey:   v.PubKey,
+	}, nil
+}
+
+func (s *slasher) handleEvidence(ev weave.Evidence, evidences orm.ModelBucket) error {
+	address := ev.Validator.Address
+	key := evidenceKey(address, ev.Height)
+	switch err := evidences.Has(s.db, key); {
+	case err == nil:
+		// Misbehaviour at this height was already punished.
+		return nil
+	case !errors.ErrNotFound.Is(err):
+		return errors.Wrap(err, "cannot check evidence")
+	}
+
+	record := Evidence{
+		Metadata:         &weave.Metadata{Schema: 1},
+		ValidatorAddress: address,
+		Type:             ev.Type,
+		Height:           ev.Height,
+		Time:             weave.AsUnixTime(ev.Time),
+	}
+
+	switch info, err := s.signingInfo(address); {
+	case err == nil:
+		power := info.Power
+		if !info.Jailed {
+			power = s.validators[string(address)].Power
+		}
+		record.SlashedPower = power * int64(s.conf.DoubleSignSlashPercent) / 100
+		info.Power = power - record.SlashedPower
+		if s.slasher != nil && record.SlashedPower != 0 {
+			if err := s.slasher.SlashPower(s.db, info.PubKey, record.SlashedPower); err != nil {
+				return errors.Wrap(err, "cannot slash power")
+			}
+		}
+		if err := s.jail(info); err != nil {
+			return err
+		}
+	case errors.ErrNotFound.Is(err):
+		// Evidence of a validator that is no longer active is
+		// recorded but there is nothing to punish.
+	default:
+		return err
+	}
+
+	if _, err := evidences.Put(s.db, key, &record); err != nil {
+		return errors.Wrap(err, "cannot store evidence")
+	}
+	s.tags = append(s.tags, common.KVPair{
+		Key:   []byte("slashing_evidence"),
+		Value: []byte(fmt.Sprintf("%X", address)),
+	})
+	return nil
+}
+
+func (s *slasher) handleVote(address []byte, signed bool) error {
+	info, err := s.signingInfo(address)
+	switch {
+	case errors.ErrNotFound.Is(err):
+		// Only validators known to the application are tracked.
+		return nil
+	case err != nil:
+		return err
+	}
+	if info.Jailed {
+		// A validator is jailed but the change did not yet propagate.
+		return nil
+	}
+
+	switch {
+	case signed && info.MissedBlocks == 0:
+		// Nothing changed.
+		return nil
+	case signed:
+		info.MissedBlocks = 0
+	default:
+		info.MissedBlocks++
+	}
+
+	if info.MissedBlocks >= s.conf.MissedBlocksLimit {
+		info.Power = s.validators[string(address)].Power
+		return s.jail(info)
+	}
+	if _, err := s.infos.Put(s.db, info.Address, info); err != nil {
+		return errors.Wrap(err, "cannot store signing info")
+	}
+	return nil
+}
+
+// jail removes given validator from the validator set until the jail period
+// is over and the validator requests to be unjailed.
+func (s *slasher) jail(info *SigningInfo) error {
+	if !info.Jailed {
+		info.Jailed = true
+		info.JailedUntil = weave.AsUnixTime(s.now.Add(s.conf.JailPeriod.Duration()))
+		s.diff = append(s.diff, weave.ValidatorUpdate{PubKey: info.PubKey, Power: 0})
+		delete(s.validators, string(info.Address))
+		s.tags = append(s.tags, common.KVPair{
+			Key:   []byte("slashing_jail"),
+			Value: []byte(fmt.Sprintf("%X", info.Address)),
+		})
+	}
+	if _, err := s.infos.Put(s.db, info.Address, info); err != nil {
+		return errors.Wrap(err, "cannot store signing info")
+	}
+	return nil
+}
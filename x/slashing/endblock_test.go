@@ -0,0 +1,166 @@
+package slashing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestEndBlockerMissedBlocks(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "slashing")
+
+	alice, bob := newValidatorKey(), newValidatorKey()
+	setValidators(t, db, weave.ValidatorUpdate{PubKey: alice, Power: 10}, weave.ValidatorUpdate{PubKey: bob, Power: 20})
+
+	eb := NewEndBlocker()
+	now := time.Now().UTC().Truncate(time.Second)
+	votes := func(aliceSigned bool) weave.CommitInfo {
+		return weave.CommitInfo{
+			Votes: []abci.VoteInfo{
+				{Validator: abci.Validator{Address: ValidatorAddress(alice), Power: 10}, SignedLastBlock: aliceSigned},
+				{Validator: abci.Validator{Address: ValidatorAddress(bob), Power: 20}, SignedLastBlock: true},
+			},
+		}
+	}
+
+	// Slashing is disabled unless configured.
+	res := eb.EndBlock(blockContext(now, votes(false), nil), db)
+	assert.Equal(t, 0, len(res.Diff))
+
+	conf := Configuration{
+		MissedBlocksLimit: 2,
+		JailPeriod:        weave.AsUnixDuration(time.Hour),
+	}
+	if err := gconf.Save(db, "slashing", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+
+	res = eb.EndBlock(blockContext(now, votes(false), nil), db)
+	assert.Equal(t, 0, len(res.Diff))
+	assertMissedBlocks(t, db, alice, 1)
+
+	// Signing a block resets the counter.
+	res = eb.EndBlock(blockContext(now, votes(true), nil), db)
+	assert.Equal(t, 0, len(res.Diff))
+	assertMissedBlocks(t, db, alice, 0)
+
+	res = eb.EndBlock(blockContext(now, votes(false), nil), db)
+	assert.Equal(t, 0, len(res.Diff))
+	res = eb.EndBlock(blockContext(now, votes(false), nil), db)
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: alice, Power: 0}}, res.Diff)
+	assert.Equal(t, 1, len(res.Tags))
+
+	var info SigningInfo
+	assert.Nil(t, NewSigningInfoBucket().One(db, ValidatorAddress(alice), &info))
+	assert.Equal(t, true, info.Jailed)
+	assert.Equal(t, int64(10), info.Power)
+	assert.Equal(t, weave.AsUnixTime(now.Add(time.Hour)), info.JailedUntil)
+	assertValidators(t, db, weave.ValidatorUpdate{PubKey: bob, Power: 20})
+
+	// A jailed validator is not punished again.
+	res = eb.EndBlock(blockContext(now, votes(false), nil), db)
+	assert.Equal(t, 0, len(res.Diff))
+}
+
+func TestEndBlockerEvidence(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "slashing")
+
+	alice, bob := newValidatorKey(), newValidatorKey()
+	setValidators(t, db, weave.ValidatorUpdate{PubKey: alice, Power: 10}, weave.ValidatorUpdate{PubKey: bob, Power: 20})
+
+	conf := Configuration{
+		JailPeriod:             weave.AsUnixDuration(time.Hour),
+		DoubleSignSlashPercent: 25,
+	}
+	if err := gconf.Save(db, "slashing", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+
+	eb := NewEndBlocker()
+	now := time.Now().UTC().Truncate(time.Second)
+	evidence := []weave.Evidence{
+		{
+			Type:      "duplicate/vote",
+			Validator: abci.Validator{Address: ValidatorAddress(bob), Power: 20},
+			Height:    4,
+			Time:      now.Add(-time.Minute),
+		},
+	}
+
+	res := eb.EndBlock(blockContext(now, weave.CommitInfo{}, evidence), db)
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: bob, Power: 0}}, res.Diff)
+	assert.Equal(t, 2, len(res.Tags))
+	assertValidators(t, db, weave.ValidatorUpdate{PubKey: alice, Power: 10})
+
+	var info SigningInfo
+	assert.Nil(t, NewSigningInfoBucket().One(db, ValidatorAddress(bob), &info))
+	assert.Equal(t, true, info.Jailed)
+	assert.Equal(t, int64(15), info.Power)
+
+	var ev Evidence
+	assert.Nil(t, NewEvidenceBucket().One(db, evidenceKey(ValidatorAddress(bob), 4), &ev))
+	assert.Equal(t, int64(5), ev.SlashedPower)
+	assert.Equal(t, "duplicate/vote", ev.Type)
+
+	// The same evidence is processed only once.
+	res = eb.EndBlock(blockContext(now, weave.CommitInfo{}, evidence), db)
+	assert.Equal(t, 0, len(res.Diff))
+	assert.Equal(t, 0, len(res.Tags))
+
+	// Another evidence for a jailed validator reduces the power that is
+	// restored on unjail.
+	evidence[0].Height = 5
+	res = eb.EndBlock(blockContext(now, weave.CommitInfo{}, evidence), db)
+	assert.Equal(t, 0, len(res.Diff))
+	assert.Nil(t, NewSigningInfoBucket().One(db, ValidatorAddress(bob), &info))
+	assert.Equal(t, int64(12), info.Power)
+}
+
+func blockContext(now time.Time, info weave.CommitInfo, evidence []weave.Evidence) weave.Context {
+	ctx := weave.WithBlockTime(context.Background(), now)
+	ctx = weave.WithCommitInfo(ctx, info)
+	return weave.WithEvidence(ctx, evidence)
+}
+
+func newValidatorKey() weave.PubKey {
+	return weave.PubKey{
+		Type: "ed25519",
+		Data: crypto.GenPrivKeyEd25519().PublicKey().GetEd25519(),
+	}
+}
+
+func setValidators(t testing.TB, db weave.KVStore, validators ...weave.ValidatorUpdate) {
+	t.Helper()
+	updates := weave.ValidatorUpdates{ValidatorUpdates: validators}
+	if err := weave.StoreValidatorUpdates(db, updates); err != nil {
+		t.Fatalf("cannot store validators: %s", err)
+	}
+}
+
+func assertValidators(t testing.TB, db weave.KVStore, want ...weave.ValidatorUpdate) {
+	t.Helper()
+	updates, err := weave.GetValidatorUpdates(db)
+	if err != nil {
+		t.Fatalf("cannot load validators: %s", err)
+	}
+	assert.Equal(t, want, updates.ValidatorUpdates)
+}
+
+func assertMissedBlocks(t testing.TB, db weave.KVStore, key weave.PubKey, want int64) {
+	t.Helper()
+	var info SigningInfo
+	if err := NewSigningInfoBucket().One(db, ValidatorAddress(key), &info); err != nil {
+		t.Fatalf("cannot load signing info: %s", err)
+	}
+	assert.Equal(t, want, info.MissedBlocks)
+}
@@ -0,0 +1,120 @@
+package slashing
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
+)
+
+const (
+	unjailCost = 0
+)
+
+// RegisterRoutes registers handlers for slashing message processing.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+	r = migration.SchemaMigratingRegistry("slashing", r)
+	r.Handle(&UnjailMsg{}, &unjailHandler{
+		auth:  auth,
+		infos: NewSigningInfoBucket(),
+	})
+	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
+}
+
+// RegisterQuery registers signing information and evidence buckets for
+// querying.
+func RegisterQuery(qr weave.QueryRouter) {
+	NewSigningInfoBucket().Register("signinfos", qr)
+	NewEvidenceBucket().Register("evidences", qr)
+}
+
+// NewConfigHandler returns a handler that allows to update the slashing
+// configuration.
+func NewConfigHandler(auth x.Authenticator) weave.Handler {
+	var conf Configuration
+	return gconf.NewUpdateConfigurationHandler("slashing", &conf, auth, migration.CurrentAdmin)
+}
+
+type unjailHandler struct {
+	auth  x.Authenticator
+	infos orm.ModelBucket
+}
+
+var _ weave.Handler = (*unjailHandler)(nil)
+
+func (h *unjailHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: unjailCost}, nil
+}
+
+func (h *unjailHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, info, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := []weave.ValidatorUpdate{
+		{PubKey: msg.PubKey, Power: info.Power},
+	}
+	if err := updateValidators(db, diff); err != nil {
+		return nil, errors.Wrap(err, "cannot update validators")
+	}
+
+	info.Jailed = false
+	info.JailedUntil = 0
+	info.MissedBlocks = 0
+	info.Power = 0
+	if _, err := h.infos.Put(db, info.Address, info); err != nil {
+		return nil, errors.Wrap(err, "cannot store signing info")
+	}
+	return &weave.DeliverResult{Diff: diff}, nil
+}
+
+func (h *unjailHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UnjailMsg, *SigningInfo, error) {
+	var msg UnjailMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var info SigningInfo
+	if err := h.infos.One(db, ValidatorAddress(msg.PubKey), &info); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load signing info")
+	}
+	if info.PubKey.Type != msg.PubKey.Type || !bytes.Equal(info.PubKey.Data, msg.PubKey.Data) {
+		return nil, nil, errors.Wrap(errors.ErrNotFound, "public key mismatch")
+	}
+
+	signer := crypto.PublicKey{Pub: &crypto.PublicKey_Ed25519{Ed25519: msg.PubKey.Data}}
+	if !h.auth.HasAddress(ctx, signer.Address()) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "validator signature required")
+	}
+
+	if !info.Jailed {
+		return nil, nil, errors.Wrap(errors.ErrState, "validator is not jailed")
+	}
+	if !weave.IsExpired(ctx, info.JailedUntil) {
+		return nil, nil, errors.Wrapf(errors.ErrState, "validator is jailed until %s", info.JailedUntil)
+	}
+	if info.Power == 0 {
+		return nil, nil, errors.Wrap(errors.ErrState, "validator has no power left")
+	}
+	return &msg, &info, nil
+}
+
+// updateValidators applies given diff to the validator set that is kept in
+// the database for bookkeeping.
+func updateValidators(db weave.KVStore, diff []weave.ValidatorUpdate) error {
+	updates, err := weave.GetValidatorUpdates(db)
+	if err != nil {
+		return errors.Wrap(err, "cannot load validators")
+	}
+	updates.ValidatorUpdates = append(updates.ValidatorUpdates, diff...)
+	return weave.StoreValidatorUpdates(db, updates.Deduplicate(true))
+}
@@ -0,0 +1,123 @@
+package slashing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestUnjail(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	validator := crypto.GenPrivKeyEd25519().PublicKey()
+	pubKey := weave.PubKey{Type: "ed25519", Data: validator.GetEd25519()}
+	other := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		Info           SigningInfo
+		Signer         weave.Condition
+		WantCheckErr   *errors.Error
+		WantDeliverErr *errors.Error
+	}{
+		"jailed validator is unjailed": {
+			Info: SigningInfo{
+				Jailed:      true,
+				JailedUntil: weave.AsUnixTime(now.Add(-time.Minute)),
+				Power:       7,
+			},
+			Signer: validator.Condition(),
+		},
+		"validator must sign the request": {
+			Info: SigningInfo{
+				Jailed:      true,
+				JailedUntil: weave.AsUnixTime(now.Add(-time.Minute)),
+				Power:       7,
+			},
+			Signer:         other,
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+		"jail period must be over": {
+			Info: SigningInfo{
+				Jailed:      true,
+				JailedUntil: weave.AsUnixTime(now.Add(time.Minute)),
+				Power:       7,
+			},
+			Signer:         validator.Condition(),
+			WantCheckErr:   errors.ErrState,
+			WantDeliverErr: errors.ErrState,
+		},
+		"validator must be jailed": {
+			Info:           SigningInfo{},
+			Signer:         validator.Condition(),
+			WantCheckErr:   errors.ErrState,
+			WantDeliverErr: errors.ErrState,
+		},
+		"validator without power cannot be unjailed": {
+			Info: SigningInfo{
+				Jailed:      true,
+				JailedUntil: weave.AsUnixTime(now.Add(-time.Minute)),
+			},
+			Signer:         validator.Condition(),
+			WantCheckErr:   errors.ErrState,
+			WantDeliverErr: errors.ErrState,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "slashing")
+
+			info := tc.Info
+			info.Metadata = &weave.Metadata{Schema: 1}
+			info.Address = ValidatorAddress(pubKey)
+			info.PubKey = pubKey
+			if _, err := NewSigningInfoBucket().Put(db, info.Address, &info); err != nil {
+				t.Fatalf("cannot store signing info: %s", err)
+			}
+
+			auth := &weavetest.Auth{Signer: tc.Signer}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth)
+
+			ctx := weave.WithBlockTime(context.Background(), now)
+			tx := &weavetest.Tx{
+				Msg: &UnjailMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					PubKey:   pubKey,
+				},
+			}
+
+			cache := db.CacheWrap()
+			if _, err := rt.Check(ctx, cache, tx); !tc.WantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			cache.Discard()
+
+			res, err := rt.Deliver(ctx, db, tx)
+			if !tc.WantDeliverErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+			if tc.WantDeliverErr != nil {
+				return
+			}
+
+			want := weave.ValidatorUpdate{PubKey: pubKey, Power: info.Power}
+			assert.Equal(t, []weave.ValidatorUpdate{want}, res.Diff)
+			assertValidators(t, db, want)
+
+			var got SigningInfo
+			assert.Nil(t, NewSigningInfoBucket().One(db, info.Address, &got))
+			assert.Equal(t, false, got.Jailed)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package slashing
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisSlashing struct {
+	SigningInfos []genesisSigningInfo `json:"signing_infos"`
+	Evidence     []genesisEvidence    `json:"evidence"`
+}
+
+// genesisSigningInfo does not contain the validator address, because it is
+// derived from the public key.
+type genesisSigningInfo struct {
+	PubKey       weave.PubKey   `json:"pub_key"`
+	MissedBlocks int64          `json:"missed_blocks,omitempty"`
+	Jailed       bool           `json:"jailed,omitempty"`
+	JailedUntil  weave.UnixTime `json:"jailed_until,omitempty"`
+	Power        int64          `json:"power,omitempty"`
+}
+
+type genesisEvidence struct {
+	ValidatorAddress weave.Address  `json:"validator_address"`
+	Type             string         `json:"type"`
+	Height           int64          `json:"height"`
+	Time             weave.UnixTime `json:"time"`
+	SlashedPower     int64          `json:"slashed_power,omitempty"`
+}
+
+// FromGenesis will parse the slashing configuration from genesis and save it
+// to the database. Configuration is optional. Slashing is disabled when not
+// configured. Signing information and evidence are loaded as well.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	if err := gconf.InitConfig(kv, opts, "slashing", &Configuration{}); err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "init config")
+	}
+
+	var genesis genesisSlashing
+	if err := opts.ReadOptions("slashing", &genesis); err != nil {
+		return err
+	}
+	infos := NewSigningInfoBucket()
+	for i, g := range genesis.SigningInfos {
+		info := SigningInfo{
+			Metadata:     &weave.Metadata{Schema: 1},
+			Address:      ValidatorAddress(g.PubKey),
+			PubKey:       g.PubKey,
+			MissedBlocks: g.MissedBlocks,
+			Jailed:       g.Jailed,
+			JailedUntil:  g.JailedUntil,
+			Power:        g.Power,
+		}
+		switch err := infos.Has(kv, info.Address); {
+		case err == nil:
+			return errors.Wrapf(errors.ErrDuplicate, "signing info %d", i)
+		case !errors.ErrNotFound.Is(err):
+			return errors.Wrapf(err, "signing info %d", i)
+		}
+		if _, err := infos.Put(kv, info.Address, &info); err != nil {
+			return errors.Wrapf(err, "signing info %d", i)
+		}
+	}
+	evidence := NewEvidenceBucket()
+	for i, g := range genesis.Evidence {
+		ev := Evidence{
+			Metadata:         &weave.Metadata{Schema: 1},
+			ValidatorAddress: g.ValidatorAddress,
+			Type:             g.Type,
+			Height:           g.Height,
+			Time:             g.Time,
+			SlashedPower:     g.SlashedPower,
+		}
+		key := evidenceKey(ev.ValidatorAddress, ev.Height)
+		switch err := evidence.Has(kv, key); {
+		case err == nil:
+			return errors.Wrapf(errors.ErrDuplicate, "evidence %d", i)
+		case !errors.ErrNotFound.Is(err):
+			return errors.Wrapf(err, "evidence %d", i)
+		}
+		if _, err := evidence.Put(kv, key, &ev); err != nil {
+			return errors.Wrapf(err, "evidence %d", i)
+		}
+	}
+	return nil
+}
+
+// ToGenesis will export the configuration, if present, together with all
+// signing information and evidence from the database.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	opts, err := gconf.ExportConfig(kv, "slashing", &Configuration{})
+	switch {
+	case errors.ErrNotFound.Is(err):
+		opts = make(weave.Options)
+	case err != nil:
+		return nil, errors.Wrap(err, "export config")
+	}
+
+	genesis := genesisSlashing{
+		SigningInfos: []genesisSigningInfo{},
+		Evidence:     []genesisEvidence{},
+	}
+
+	it := orm.IterAll("signinfo")
+	for {
+		var info SigningInfo
+		key, err := it.Next(kv, &info)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "signing info")
+		}
+		if !bytes.Equal(key, ValidatorAddress(info.PubKey)) {
+			return nil, errors.Wrapf(errors.ErrState, "signing info %X is not stored under its validator address", key)
+		}
+		genesis.SigningInfos = append(genesis.SigningInfos, genesisSigningInfo{
+			PubKey:       info.PubKey,
+			MissedBlocks: info.MissedBlocks,
+			Jailed:       info.Jailed,
+			JailedUntil:  info.JailedUntil,
+			Power:        info.Power,
+		})
+	}
+
+	it = orm.IterAll("evidence")
+	for {
+		var ev Evidence
+		key, err := it.Next(kv, &ev)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "evidence")
+		}
+		if !bytes.Equal(key, evidenceKey(ev.ValidatorAddress, ev.Height)) {
+			return nil, errors.Wrapf(errors.ErrState, "evidence %X is not stored under its key", key)
+		}
+		genesis.Evidence = append(genesis.Evidence, genesisEvidence{
+			ValidatorAddress: ev.ValidatorAddress,
+			Type:             ev.Type,
+			Height:           ev.Height,
+			Time:             ev.Time,
+			SlashedPower:     ev.SlashedPower,
+		})
+	}
+
+	if err := opts.WriteOptions("slashing", genesis); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
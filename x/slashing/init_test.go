@@ -0,0 +1,82 @@
+package slashing
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "slashing")
+
+	conf := Configuration{
+		Metadata:          &weave.Metadata{Schema: 1},
+		MissedBlocksLimit: 2,
+		JailPeriod:        weave.AsUnixDuration(time.Hour),
+	}
+	assert.Nil(t, gconf.Save(db, "slashing", &conf))
+
+	alice := newValidatorKey()
+	now := weave.AsUnixTime(time.Now())
+	info := SigningInfo{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Address:      ValidatorAddress(alice),
+		PubKey:       alice,
+		MissedBlocks: 1,
+		Jailed:       true,
+		JailedUntil:  now.Add(time.Hour),
+		Power:        10,
+	}
+	_, err := NewSigningInfoBucket().Put(db, info.Address, &info)
+	assert.Nil(t, err)
+	ev := Evidence{
+		Metadata:         &weave.Metadata{Schema: 1},
+		ValidatorAddress: info.Address,
+		Type:             "duplicate/vote",
+		Height:           7,
+		Time:             now,
+		SlashedPower:     5,
+	}
+	_, err = NewEvidenceBucket().Put(db, evidenceKey(ev.ValidatorAddress, ev.Height), &ev)
+	assert.Nil(t, err)
+
+	var ini Initializer
+	opts, err := ini.ToGenesis(db)
+	assert.Nil(t, err)
+
+	// Exported state must survive serialization, as it does when written
+	// to a genesis file.
+	raw, err := json.Marshal(opts)
+	assert.Nil(t, err)
+	var reopts weave.Options
+	assert.Nil(t, json.Unmarshal(raw, &reopts))
+
+	redb := store.MemStore()
+	migration.MustInitPkg(redb, "slashing")
+	assert.Nil(t, ini.FromGenesis(reopts, weave.GenesisParams{}, redb))
+
+	var reinfo SigningInfo
+	assert.Nil(t, NewSigningInfoBucket().One(redb, info.Address, &reinfo))
+	assert.Equal(t, info, reinfo)
+
+	var reev Evidence
+	assert.Nil(t, NewEvidenceBucket().One(redb, evidenceKey(ev.ValidatorAddress, ev.Height), &reev))
+	assert.Equal(t, ev, reev)
+
+	var reconf Configuration
+	assert.Nil(t, gconf.Load(redb, "slashing", &reconf))
+	assert.Equal(t, conf, reconf)
+
+	// Loading the same state again is not allowed.
+	if err := ini.FromGenesis(reopts, weave.GenesisParams{}, redb); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %v", err)
+	}
+}
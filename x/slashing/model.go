@@ -0,0 +1,105 @@
+package slashing
+
+import (
+	"encoding/binary"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+func init() {
+	migration.MustRegister(1, &SigningInfo{}, migration.NoModification)
+	migration.MustRegister(1, &Evidence{}, migration.NoModification)
+}
+
+var _ orm.Model = (*SigningInfo)(nil)
+
+func (m *SigningInfo) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.Address) != tmhash.TruncatedSize {
+		errs = errors.AppendField(errs, "Address", errors.ErrInput)
+	}
+	errs = errors.AppendField(errs, "PubKey", validatePubKey(m.PubKey))
+	if m.MissedBlocks < 0 {
+		errs = errors.AppendField(errs, "MissedBlocks", errors.ErrInput)
+	}
+	if m.Power < 0 {
+		errs = errors.AppendField(errs, "Power", errors.ErrInput)
+	}
+	if m.Jailed {
+		errs = errors.AppendField(errs, "JailedUntil", m.JailedUntil.Validate())
+	}
+	return errs
+}
+
+// NewSigningInfoBucket returns a bucket for storing SigningInfo entities,
+// using the tendermint validator address as the key.
+func NewSigningInfoBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("signinfo", &SigningInfo{})
+	return migration.NewModelBucket("slashing", b)
+}
+
+var _ orm.Model = (*Evidence)(nil)
+
+func (m *Evidence) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.ValidatorAddress) == 0 {
+		errs = errors.AppendField(errs, "ValidatorAddress", errors.ErrEmpty)
+	}
+	if m.Type == "" {
+		errs = errors.AppendField(errs, "Type", errors.ErrEmpty)
+	}
+	if m.Height <= 0 {
+		errs = errors.AppendField(errs, "Height", errors.ErrInput)
+	}
+	errs = errors.AppendField(errs, "Time", m.Time.Validate())
+	if m.SlashedPower < 0 {
+		errs = errors.AppendField(errs, "SlashedPower", errors.ErrInput)
+	}
+	return errs
+}
+
+// NewEvidenceBucket returns a bucket for storing Evidence entities. Evidence
+// can be queried by the validator address.
+func NewEvidenceBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("evidence", &Evidence{},
+		orm.WithIndex("validator", evidenceValidatorIndex, false),
+	)
+	return migration.NewModelBucket("slashing", b)
+}
+
+func evidenceValidatorIndex(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	ev, ok := obj.Value().(*Evidence)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrModel, "cannot take index of %T", obj.Value())
+	}
+	return ev.ValidatorAddress, nil
+}
+
+// evidenceKey returns the key of an evidence for a misbehaviour of given
+// validator at given height. A validator can be punished only once for each
+// block.
+func evidenceKey(validatorAddress []byte, height int64) []byte {
+	key := make([]byte, len(validatorAddress)+8)
+	copy(key, validatorAddress)
+	binary.BigEndian.PutUint64(key[len(validatorAddress):], uint64(height))
+	return key
+}
+
+// ValidatorAddress returns the address of a validator with given public key,
+// as used by tendermint in the commit information and evidence.
+func ValidatorAddress(key weave.PubKey) []byte {
+	return tmhash.SumTruncated(key.Data)
+}
+
+func validatePubKey(key weave.PubKey) error {
+	return weave.ValidatorUpdate{PubKey: key}.Validate()
+}
@@ -0,0 +1,54 @@
+package slashing
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &UnjailMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+}
+
+var _ weave.Msg = (*UnjailMsg)(nil)
+
+func (m *UnjailMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PubKey", validatePubKey(m.PubKey))
+	return errs
+}
+
+func (*UnjailMsg) Path() string {
+	return "slashing/unjail"
+}
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+// Validate will skip any zero fields and validate the set ones.
+func (m *UpdateConfigurationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	c := m.Patch
+	if c == nil {
+		return errors.AppendField(errs, "Patch", errors.ErrEmpty)
+	}
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	if c.MissedBlocksLimit < 0 {
+		errs = errors.Append(errs, errors.Field("MissedBlocksLimit", errors.ErrInput, "cannot be negative"))
+	}
+	if c.JailPeriod < 0 {
+		errs = errors.Append(errs, errors.Field("JailPeriod", errors.ErrInput, "cannot be negative"))
+	}
+	if c.DoubleSignSlashPercent > 100 {
+		errs = errors.Append(errs, errors.Field("DoubleSignSlashPercent", errors.ErrInput, "cannot be greater than 100"))
+	}
+	return errs
+}
+
+func (*UpdateConfigurationMsg) Path() string {
+	return "slashing/update_configuration"
+}
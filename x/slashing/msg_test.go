@@ -0,0 +1,84 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestValidateMsg(t *testing.T) {
+	pubkey := weave.PubKey{
+		Data: weavetest.NewKey().PublicKey().GetEd25519(),
+		Type: "ed25519",
+	}
+
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid unjail": {
+			Msg: &UnjailMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				PubKey:   pubkey,
+			},
+			WantErr: nil,
+		},
+		"unjail missing metadata": {
+			Msg: &UnjailMsg{
+				PubKey: pubkey,
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"unjail invalid public key": {
+			Msg: &UnjailMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				PubKey:   weave.PubKey{Type: "ed25519", Data: []byte("short")},
+			},
+			WantErr: errors.ErrType,
+		},
+		"valid configuration update": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					MissedBlocksLimit:      100,
+					DoubleSignSlashPercent: 100,
+				},
+			},
+			WantErr: nil,
+		},
+		"configuration update missing patch": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"configuration update slash percent too big": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					DoubleSignSlashPercent: 101,
+				},
+			},
+			WantErr: errors.ErrInput,
+		},
+		"configuration update negative jail period": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					JailPeriod: -1,
+				},
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package staking
+
+import (
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+)
+
+func (c *Configuration) Validate() error {
+	var errs error
+	// Owner field is optional.
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	if !coin.IsCC(c.BondTicker) {
+		errs = errors.Append(errs, errors.Field("BondTicker", errors.ErrCurrency, "invalid ticker"))
+	}
+	if c.UnbondingPeriod < 0 {
+		errs = errors.Append(errs, errors.Field("UnbondingPeriod", errors.ErrInput, "cannot be negative"))
+	}
+	if c.TokensPerPower <= 0 {
+		errs = errors.Append(errs, errors.Field("TokensPerPower", errors.ErrInput, "must be greater than zero"))
+	}
+	return errs
+}
+
+// Power returns the validator power that corresponds to given stake.
+func (c *Configuration) Power(stake coin.Coin) int64 {
+	return stake.Whole / c.TokensPerPower
+}
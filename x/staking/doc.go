@@ -0,0 +1,32 @@
+/*
+Package staking implements proof of stake validator power.
+
+Token holders can bond coins to a validator public key by sending `BondMsg`.
+Bonded coins are moved from the delegator account to an account controlled by
+this extension. Validator power is derived from the total amount of coins
+bonded to that validator and each change of it is propagated to tendermint as
+a validator update.
+
+Bonded coins can be unbonded by sending `UnbondMsg`. Validator power is
+decreased immediately, but coins are returned to the delegator only after the
+unbonding period is over. Returning coins is done by a task queued in the
+cron, so the cron handlers must be registered using `RegisterCronRoutes`.
+
+Only one currency can be bonded. Currency, unbonding period and the number of
+tokens that represent a unit of validator power are kept in the extension
+configuration (see gconf package).
+
+A validator jailed by the slashing extension is not part of the validator set.
+Changing its stake does not produce a validator update. The new power is
+recorded in the slashing signing information and applied once the validator
+is unjailed.
+
+Power taken away as a double sign punishment is recorded in the stake (see
+`NewPowerSlasher`) and subtracted from the power derived from the bonded
+coins, so bonding more coins does not restore it.
+
+Validator updates always declare the absolute power value. When combined with
+other extensions that set validator power (ie validators package), the most
+recent update wins.
+*/
+package staking
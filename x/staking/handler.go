@@ -0,0 +1,394 @@
+package staking
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/slashing"
+)
+
+const (
+	bondCost   = 0
+	unbondCost = 0
+)
+
+// RegisterRoutes registers handlers for staking message processing. Unbonded
+// coins are returned to the delegator by a task queued using given
+// scheduler.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, bank cash.CoinMover, scheduler weave.Scheduler) {
+	r = migration.SchemaMigratingRegistry("staking", r)
+	r.Handle(&BondMsg{}, &bondHandler{
+		auth:        auth,
+		bank:        bank,
+		delegations: NewDelegationBucket(),
+		stakes:      NewStakeBucket(),
+		infos:       slashing.NewSigningInfoBucket(),
+	})
+	r.Handle(&UnbondMsg{}, &unbondHandler{
+		auth:        auth,
+		scheduler:   scheduler,
+		delegations: NewDelegationBucket(),
+		stakes:      NewStakeBucket(),
+		infos:       slashing.NewSigningInfoBucket(),
+		unbondings:  NewUnbondingBucket(),
+	})
+	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
+}
+
+// RegisterCronRoutes registers handlers for messages that are executed by the
+// cron only.
+func RegisterCronRoutes(r weave.Registry, bank cash.CoinMover) {
+	r = migration.SchemaMigratingRegistry("staking", r)
+	r.Handle(&ReleaseUnbondingMsg{}, &releaseUnbondingHandler{
+		bank:       bank,
+		unbondings: NewUnbondingBucket(),
+	})
+}
+
+// RegisterQuery registers delegation, stake and unbonding buckets for
+// querying.
+func RegisterQuery(qr weave.QueryRouter) {
+	NewDelegationBucket().Register("delegations", qr)
+	NewStakeBucket().Register("stakes", qr)
+	NewUnbondingBucket().Register("unbondings", qr)
+}
+
+// NewConfigHandler returns a handler that allows to update the staking
+// configuration.
+func NewConfigHandler(auth x.Authenticator) weave.Handler {
+	var conf Configuration
+	return gconf.NewUpdateConfigurationHandler("staking", &conf, auth, migration.CurrentAdmin)
+}
+
+type bondHandler struct {
+	auth        x.Authenticator
+	bank        cash.CoinMover
+	delegations orm.ModelBucket
+	stakes      orm.ModelBucket
+	infos       orm.ModelBucket
+}
+
+var _ weave.Handler = (*bondHandler)(nil)
+
+func (h *bondHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: bondCost}, nil
+}
+
+func (h *bondHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, conf, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.bank.MoveCoins(db, msg.Delegator, BondedCondition.Address(), msg.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot bond coins")
+	}
+
+	key := delegationKey(msg.Delegator, msg.Validator)
+	var delegation Delegation
+	switch err := h.delegations.One(db, key, &delegation); {
+	case err == nil:
+		if delegation.Amount, err = delegation.Amount.Add(msg.Amount); err != nil {
+			return nil, errors.Wrap(err, "delegation amount")
+		}
+	case errors.ErrNotFound.Is(err):
+		delegation = Delegation{
+			Metadata:  &weave.Metadata{Schema: 1},
+			Delegator: msg.Delegator,
+			Validator: msg.Validator,
+			Amount:    msg.Amount,
+		}
+	default:
+		return nil, errors.Wrap(err, "cannot load delegation")
+	}
+	if _, err := h.delegations.Put(db, key, &delegation); err != nil {
+		return nil, errors.Wrap(err, "cannot store delegation")
+	}
+
+	diff, err := updateStake(db, h.stakes, h.infos, conf, msg.Validator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{Diff: diff}, nil
+}
+
+func (h *bondHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*BondMsg, *Configuration, error) {
+	var msg BondMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, nil, err
+	}
+	if msg.Amount.Ticker != conf.BondTicker {
+		return nil, nil, errors.Wrapf(errors.ErrCurrency, "only %s can be bonded", conf.BondTicker)
+	}
+	if !h.auth.HasAddress(ctx, msg.Delegator) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "delegator signature required")
+	}
+	return &msg, conf, nil
+}
+
+type unbondHandler struct {
+	auth        x.Authenticator
+	scheduler   weave.Scheduler
+	delegations orm.ModelBucket
+	stakes      orm.ModelBucket
+	infos       orm.ModelBucket
+	unbondings  orm.ModelBucket
+}
+
+var _ weave.Handler = (*unbondHandler)(nil)
+
+func (h *unbondHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{GasAllocated: unbondCost}, nil
+}
+
+func (h *unbondHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, delegation, conf, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+
+	key := delegationKey(msg.Delegator, msg.Validator)
+	if delegation.Amount, err = delegation.Amount.Subtract(msg.Amount); err != nil {
+		return nil, errors.Wrap(err, "delegation amount")
+	}
+	if delegation.Amount.IsZero() {
+		err = h.delegations.Delete(db, key)
+	} else {
+		_, err = h.delegations.Put(db, key, delegation)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store delegation")
+	}
+
+	diff, err := updateStake(db, h.stakes, h.infos, conf, msg.Validator, msg.Amount.Negative())
+	if err != nil {
+		return nil, err
+	}
+
+	unbonding := &Unbonding{
+		Metadata:      &weave.Metadata{Schema: 1},
+		Delegator:     msg.Delegator,
+		Validator:     msg.Validator,
+		Amount:        msg.Amount,
+		ReleaseAt:     weave.AsUnixTime(now.Add(conf.UnbondingPeriod.Duration())),
+		ReleaseTaskID: nil, // Chicken-egg problem. Create without and update later.
+	}
+	id, err := h.unbondings.Put(db, nil, unbonding)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store unbonding")
+	}
+
+	releaseMsg := &ReleaseUnbondingMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		UnbondingID: id,
+	}
+	// Release message requires no authentication.
+	taskID, err := h.scheduler.Schedule(db, unbonding.ReleaseAt.Time(), nil, releaseMsg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule release task")
+	}
+	unbonding.ReleaseTaskID = taskID
+	if _, err := h.unbondings.Put(db, id, unbonding); err != nil {
+		return nil, errors.Wrap(err, "cannot update unbonding")
+	}
+	return &weave.DeliverResult{Data: id, Diff: diff}, nil
+}
+
+func (h *unbondHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UnbondMsg, *Delegation, *Configuration, error) {
+	var msg UnbondMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+	conf, err := loadConf(db)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !h.auth.HasAddress(ctx, msg.Delegator) {
+		return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "delegator signature required")
+	}
+	var delegation Delegation
+	if err := h.delegations.One(db, delegationKey(msg.Delegator, msg.Validator), &delegation); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load delegation")
+	}
+	if !delegation.Amount.IsGTE(msg.Amount) {
+		return nil, nil, nil, errors.Wrapf(errors.ErrAmount, "only %s is bonded", delegation.Amount)
+	}
+	return &msg, &delegation, conf, nil
+}
+
+type releaseUnbondingHandler struct {
+	bank       cash.CoinMover
+	unbondings orm.ModelBucket
+}
+
+var _ weave.Handler = (*releaseUnbondingHandler)(nil)
+
+func (h *releaseUnbondingHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	return nil, errors.Wrap(errors.ErrHuman, "release unbonding handler is to be executed by cron only")
+}
+
+func (h *releaseUnbondingHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	var msg ReleaseUnbondingMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	var unbonding Unbonding
+	if err := h.unbondings.One(db, msg.UnbondingID, &unbonding); err != nil {
+		return nil, errors.Wrap(err, "cannot load unbonding")
+	}
+	if !weave.IsExpired(ctx, unbonding.ReleaseAt) {
+		return nil, errors.Wrapf(errors.ErrState, "unbonding period ends at %s", unbonding.ReleaseAt)
+	}
+	if err := h.bank.MoveCoins(db, BondedCondition.Address(), unbonding.Delegator, unbonding.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot release coins")
+	}
+	if err := h.unbondings.Delete(db, msg.UnbondingID); err != nil {
+		return nil, errors.Wrap(err, "cannot delete unbonding")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func loadConf(db weave.KVStore) (*Configuration, error) {
+	var conf Configuration
+	if err := gconf.Load(db, "staking", &conf); err != nil {
+		return nil, errors.Wrap(err, "load configuration")
+	}
+	return &conf, nil
+}
+
+// updateStake changes the total stake of a validator by given amount. If the
+// validator power changes, a validator update is returned and the validator
+// set kept in the database is updated. Power taken away by the slashing
+// extension is not restored.
+//
+// A validator jailed by the slashing extension is not part of the validator
+// set. Its new power is recorded in the signing information instead and
+// applied once the validator is unjailed.
+func updateStake(
+	db weave.KVStore,
+	stakes orm.ModelBucket,
+	infos orm.ModelBucket,
+	conf *Configuration,
+	validator weave.PubKey,
+	amount coin.Coin,
+) ([]weave.ValidatorUpdate, error) {
+	var stake Stake
+	switch err := stakes.One(db, validator.Data, &stake); {
+	case err == nil:
+		// All good.
+	case errors.ErrNotFound.Is(err):
+		stake = Stake{
+			Metadata:  &weave.Metadata{Schema: 1},
+			Validator: validator,
+			Amount:    coin.Coin{Ticker: amount.Ticker},
+		}
+	default:
+		return nil, errors.Wrap(err, "cannot load stake")
+	}
+
+	prevPower := stake.Power(conf)
+	total, err := stake.Amount.Add(amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "stake amount")
+	}
+	stake.Amount = total
+	if stake.Amount.IsZero() && stake.SlashedPower == 0 {
+		err = stakes.Delete(db, validator.Data)
+	} else {
+		_, err = stakes.Put(db, validator.Data, &stake)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store stake")
+	}
+
+	power := stake.Power(conf)
+	if power == prevPower {
+		return nil, nil
+	}
+	switch jailed, err := setJailedPower(db, infos, validator, power); {
+	case err != nil:
+		return nil, err
+	case jailed:
+		return nil, nil
+	}
+	diff := []weave.ValidatorUpdate{{PubKey: validator, Power: power}}
+	updates, err := weave.GetValidatorUpdates(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load validators")
+	}
+	updates.ValidatorUpdates = append(updates.ValidatorUpdates, diff...)
+	if err := weave.StoreValidatorUpdates(db, updates.Deduplicate(true)); err != nil {
+		return nil, errors.Wrap(err, "cannot store validators")
+	}
+	return diff, nil
+}
+
+// setJailedPower updates the power that a jailed validator regains once it is
+// unjailed. It returns false if the validator is not jailed.
+func setJailedPower(db weave.KVStore, infos orm.ModelBucket, validator weave.PubKey, power int64) (bool, error) {
+	var info slashing.SigningInfo
+	switch err := infos.One(db, slashing.ValidatorAddress(validator), &info); {
+	case err == nil:
+		// All good.
+	case errors.ErrNotFound.Is(err):
+		return false, nil
+	default:
+		return false, errors.Wrap(err, "cannot load signing info")
+	}
+	if !info.Jailed {
+		return false, nil
+	}
+	info.Power = power
+	if _, err := infos.Put(db, info.Address, &info); err != nil {
+		return false, errors.Wrap(err, "cannot store signing info")
+	}
+	return true, nil
+}
+
+// NewPowerSlasher returns a slashing.PowerSlasher that records the power
+// taken away from a validator in its stake, so that changing the stake does
+// not restore it. It must be registered with the slashing end blocker.
+func NewPowerSlasher() slashing.PowerSlasher {
+	return &powerSlasher{stakes: NewStakeBucket()}
+}
+
+type powerSlasher struct {
+	stakes orm.ModelBucket
+}
+
+func (s *powerSlasher) SlashPower(db weave.KVStore, validator weave.PubKey, power int64) error {
+	var stake Stake
+	switch err := s.stakes.One(db, validator.Data, &stake); {
+	case err == nil:
+		// All good.
+	case errors.ErrNotFound.Is(err):
+		// Validator power is not derived from a stake.
+		return nil
+	default:
+		return errors.Wrap(err, "cannot load stake")
+	}
+	stake.SlashedPower += power
+	if _, err := s.stakes.Put(db, validator.Data, &stake); err != nil {
+		return errors.Wrap(err, "cannot store stake")
+	}
+	return nil
+}
@@ -0,0 +1,385 @@
+package staking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/slashing"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestBondAndUnbond(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "staking", "cash")
+
+	conf := Configuration{
+		BondTicker:      "IOV",
+		UnbondingPeriod: weave.AsUnixDuration(time.Hour),
+		TokensPerPower:  10,
+	}
+	if err := gconf.Save(db, "staking", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+
+	alice := weavetest.NewCondition()
+	bob := weavetest.NewCondition()
+	validator := weave.PubKey{
+		Type: "ed25519",
+		Data: weavetest.NewKey().PublicKey().GetEd25519(),
+	}
+
+	ctrl := cash.NewController(cash.NewBucket())
+	if err := ctrl.CoinMint(db, alice.Address(), coin.NewCoin(100, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint coins: %s", err)
+	}
+	if err := ctrl.CoinMint(db, alice.Address(), coin.NewCoin(100, 0, "DOGE")); err != nil {
+		t.Fatalf("cannot mint coins: %s", err)
+	}
+
+	cron := &weavetest.Cron{}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, &weavetest.Auth{Signer: alice}, ctrl, cron)
+	RegisterCronRoutes(rt, ctrl)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	ctx := weave.WithBlockTime(context.Background(), now)
+
+	deliver := func(t testing.TB, ctx weave.Context, msg weave.Msg, wantErr *errors.Error) *weave.DeliverResult {
+		t.Helper()
+		tx := &weavetest.Tx{Msg: msg}
+		cache := db.CacheWrap()
+		if _, err := rt.Check(ctx, cache, tx); !wantErr.Is(err) {
+			t.Fatalf("unexpected check error: %s", err)
+		}
+		cache.Discard()
+		res, err := rt.Deliver(ctx, db, tx)
+		if !wantErr.Is(err) {
+			t.Fatalf("unexpected deliver error: %s", err)
+		}
+		return res
+	}
+	bond := func(amount coin.Coin) *BondMsg {
+		return &BondMsg{
+			Metadata:  &weave.Metadata{Schema: 1},
+			Delegator: alice.Address(),
+			Validator: validator,
+			Amount:    amount,
+		}
+	}
+	unbond := func(amount coin.Coin) *UnbondMsg {
+		return &UnbondMsg{
+			Metadata:  &weave.Metadata{Schema: 1},
+			Delegator: alice.Address(),
+			Validator: validator,
+			Amount:    amount,
+		}
+	}
+
+	res := deliver(t, ctx, bond(coin.NewCoin(35, 0, "IOV")), nil)
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 3}}, res.Diff)
+	assertBalance(t, db, ctrl, alice.Address(), coin.NewCoin(65, 0, "IOV"))
+
+	// Bonding that does not change the power does not produce an update.
+	res = deliver(t, ctx, bond(coin.NewCoin(4, 0, "IOV")), nil)
+	assert.Equal(t, 0, len(res.Diff))
+	res = deliver(t, ctx, bond(coin.NewCoin(1, 0, "IOV")), nil)
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 4}}, res.Diff)
+	assertValidators(t, db, weave.ValidatorUpdate{PubKey: validator, Power: 4})
+
+	deliver(t, ctx, bond(coin.NewCoin(1, 0, "DOGE")), errors.ErrCurrency)
+	deliver(t, ctx, &BondMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Delegator: bob.Address(),
+		Validator: validator,
+		Amount:    coin.NewCoin(1, 0, "IOV"),
+	}, errors.ErrUnauthorized)
+
+	var delegation Delegation
+	assert.Nil(t, NewDelegationBucket().One(db, delegationKey(alice.Address(), validator), &delegation))
+	assert.Equal(t, coin.NewCoin(40, 0, "IOV"), delegation.Amount)
+
+	deliver(t, ctx, unbond(coin.NewCoin(41, 0, "IOV")), errors.ErrAmount)
+	res = deliver(t, ctx, unbond(coin.NewCoin(25, 0, "IOV")), nil)
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 1}}, res.Diff)
+	unbondingID := res.Data
+
+	// Unbonded coins are not returned until the unbonding period is over.
+	assertBalance(t, db, ctrl, alice.Address(), coin.NewCoin(60, 0, "IOV"))
+	var unbonding Unbonding
+	assert.Nil(t, NewUnbondingBucket().One(db, unbondingID, &unbonding))
+	assert.Equal(t, weave.AsUnixTime(now.Add(time.Hour)), unbonding.ReleaseAt)
+	assert.Equal(t, true, len(unbonding.ReleaseTaskID) != 0)
+
+	release := &ReleaseUnbondingMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		UnbondingID: unbondingID,
+	}
+	if _, err := rt.Check(ctx, db, &weavetest.Tx{Msg: release}); !errors.ErrHuman.Is(err) {
+		t.Fatalf("release must not be allowed in check: %s", err)
+	}
+	if _, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: release}); !errors.ErrState.Is(err) {
+		t.Fatalf("release before the unbonding period is over: %s", err)
+	}
+
+	// Release task is scheduled for the end of the unbonding period.
+	later := weave.WithBlockTime(context.Background(), now.Add(time.Hour))
+	assert.Equal(t, 1, len(cron.Tick(later, db).Tags))
+	if _, err := rt.Deliver(later, db, &weavetest.Tx{Msg: release}); err != nil {
+		t.Fatalf("cannot release: %s", err)
+	}
+	assertBalance(t, db, ctrl, alice.Address(), coin.NewCoin(85, 0, "IOV"))
+	if err := NewUnbondingBucket().Has(db, unbondingID); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("unbonding must be deleted: %s", err)
+	}
+
+	// Unbonding everything removes the validator.
+	res = deliver(t, later, unbond(coin.NewCoin(15, 0, "IOV")), nil)
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 0}}, res.Diff)
+	assertValidators(t, db)
+	if err := NewDelegationBucket().Has(db, delegationKey(alice.Address(), validator)); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("delegation must be deleted: %s", err)
+	}
+	if err := NewStakeBucket().Has(db, validator.Data); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("stake must be deleted: %s", err)
+	}
+}
+
+func TestBondToJailedValidator(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "staking", "cash", "slashing")
+
+	conf := Configuration{
+		BondTicker:      "IOV",
+		UnbondingPeriod: weave.AsUnixDuration(time.Hour),
+		TokensPerPower:  10,
+	}
+	if err := gconf.Save(db, "staking", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	slashingConf := slashing.Configuration{
+		MissedBlocksLimit: 1,
+		JailPeriod:        weave.AsUnixDuration(time.Hour),
+	}
+	if err := gconf.Save(db, "slashing", &slashingConf); err != nil {
+		t.Fatalf("cannot save slashing configuration: %s", err)
+	}
+
+	alice := weavetest.NewCondition()
+	validatorKey := weavetest.NewKey()
+	validator := weave.PubKey{
+		Type: "ed25519",
+		Data: validatorKey.PublicKey().GetEd25519(),
+	}
+
+	ctrl := cash.NewController(cash.NewBucket())
+	if err := ctrl.CoinMint(db, alice.Address(), coin.NewCoin(100, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint coins: %s", err)
+	}
+
+	auth := &weavetest.Auth{Signers: []weave.Condition{alice, validatorKey.PublicKey().Condition()}}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, ctrl, &weavetest.Cron{})
+	slashing.RegisterRoutes(rt, auth)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	ctx := weave.WithBlockTime(context.Background(), now)
+
+	deliver := func(t testing.TB, ctx weave.Context, msg weave.Msg) *weave.DeliverResult {
+		t.Helper()
+		res, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: msg})
+		if err != nil {
+			t.Fatalf("cannot deliver %T: %s", msg, err)
+		}
+		return res
+	}
+
+	res := deliver(t, ctx, &BondMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Delegator: alice.Address(),
+		Validator: validator,
+		Amount:    coin.NewCoin(30, 0, "IOV"),
+	})
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 3}}, res.Diff)
+
+	// Validator that did not sign a block is jailed.
+	blockCtx := weave.WithCommitInfo(ctx, weave.CommitInfo{
+		Votes: []abci.VoteInfo{
+			{Validator: abci.Validator{Address: slashing.ValidatorAddress(validator), Power: 3}, SignedLastBlock: false},
+		},
+	})
+	tick := slashing.NewEndBlocker().EndBlock(blockCtx, db)
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 0}}, tick.Diff)
+	assertValidators(t, db)
+
+	// Jailed validator does not regain power when the stake changes.
+	res = deliver(t, ctx, &BondMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Delegator: alice.Address(),
+		Validator: validator,
+		Amount:    coin.NewCoin(20, 0, "IOV"),
+	})
+	assert.Equal(t, 0, len(res.Diff))
+	res = deliver(t, ctx, &UnbondMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Delegator: alice.Address(),
+		Validator: validator,
+		Amount:    coin.NewCoin(10, 0, "IOV"),
+	})
+	assert.Equal(t, 0, len(res.Diff))
+	assertValidators(t, db)
+
+	// Once unjailed, the power reflects the current stake.
+	later := weave.WithBlockTime(context.Background(), now.Add(time.Hour))
+	res = deliver(t, later, &slashing.UnjailMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		PubKey:   validator,
+	})
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 4}}, res.Diff)
+	assertValidators(t, db, weave.ValidatorUpdate{PubKey: validator, Power: 4})
+}
+
+func assertBalance(t testing.TB, db weave.KVStore, ctrl cash.Controller, addr weave.Address, want coin.Coin) {
+	t.Helper()
+	coins, err := ctrl.Balance(db, addr)
+	if err != nil {
+		t.Fatalf("cannot get balance: %s", err)
+	}
+	for _, c := range coins {
+		if c.Ticker == want.Ticker {
+			assert.Equal(t, want, *c)
+			return
+		}
+	}
+	t.Fatalf("no %s coins", want.Ticker)
+}
+
+func assertValidators(t testing.TB, db weave.KVStore, want ...weave.ValidatorUpdate) {
+	t.Helper()
+	updates, err := weave.GetValidatorUpdates(db)
+	if err != nil {
+		t.Fatalf("cannot load validators: %s", err)
+	}
+	if len(want) == 0 {
+		assert.Equal(t, 0, len(updates.ValidatorUpdates))
+		return
+	}
+	assert.Equal(t, want, updates.ValidatorUpdates)
+}
+
+func TestSlashedPowerIsNotRestored(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "staking", "cash", "slashing")
+
+	conf := Configuration{
+		BondTicker:      "IOV",
+		UnbondingPeriod: weave.AsUnixDuration(time.Hour),
+		TokensPerPower:  10,
+	}
+	if err := gconf.Save(db, "staking", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	slashingConf := slashing.Configuration{
+		JailPeriod:             weave.AsUnixDuration(time.Hour),
+		DoubleSignSlashPercent: 50,
+	}
+	if err := gconf.Save(db, "slashing", &slashingConf); err != nil {
+		t.Fatalf("cannot save slashing configuration: %s", err)
+	}
+
+	alice := weavetest.NewCondition()
+	validatorKey := weavetest.NewKey()
+	validator := weave.PubKey{
+		Type: "ed25519",
+		Data: validatorKey.PublicKey().GetEd25519(),
+	}
+
+	ctrl := cash.NewController(cash.NewBucket())
+	if err := ctrl.CoinMint(db, alice.Address(), coin.NewCoin(100, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint coins: %s", err)
+	}
+
+	auth := &weavetest.Auth{Signers: []weave.Condition{alice, validatorKey.PublicKey().Condition()}}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, ctrl, &weavetest.Cron{})
+	slashing.RegisterRoutes(rt, auth)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	ctx := weave.WithBlockTime(context.Background(), now)
+
+	deliver := func(t testing.TB, ctx weave.Context, msg weave.Msg) *weave.DeliverResult {
+		t.Helper()
+		res, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: msg})
+		if err != nil {
+			t.Fatalf("cannot deliver %T: %s", msg, err)
+		}
+		return res
+	}
+
+	res := deliver(t, ctx, &BondMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Delegator: alice.Address(),
+		Validator: validator,
+		Amount:    coin.NewCoin(40, 0, "IOV"),
+	})
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 4}}, res.Diff)
+
+	// Double sign takes away half of the power and jails the validator.
+	blockCtx := weave.WithEvidence(ctx, []weave.Evidence{
+		{
+			Type:      "duplicate/vote",
+			Validator: abci.Validator{Address: slashing.ValidatorAddress(validator), Power: 4},
+			Height:    3,
+			Time:      now,
+		},
+	})
+	tick := slashing.NewEndBlocker().WithPowerSlasher(NewPowerSlasher()).EndBlock(blockCtx, db)
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 0}}, tick.Diff)
+
+	var stake Stake
+	assert.Nil(t, NewStakeBucket().One(db, validator.Data, &stake))
+	assert.Equal(t, int64(2), stake.SlashedPower)
+
+	later := weave.WithBlockTime(context.Background(), now.Add(time.Hour))
+	res = deliver(t, later, &slashing.UnjailMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		PubKey:   validator,
+	})
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 2}}, res.Diff)
+
+	// Bonding more coins does not restore the slashed power.
+	res = deliver(t, later, &BondMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Delegator: alice.Address(),
+		Validator: validator,
+		Amount:    coin.NewCoin(10, 0, "IOV"),
+	})
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 3}}, res.Diff)
+	assertValidators(t, db, weave.ValidatorUpdate{PubKey: validator, Power: 3})
+
+	// Punishment is kept when all coins are unbonded.
+	res = deliver(t, later, &UnbondMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Delegator: alice.Address(),
+		Validator: validator,
+		Amount:    coin.NewCoin(50, 0, "IOV"),
+	})
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 0}}, res.Diff)
+	res = deliver(t, later, &BondMsg{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Delegator: alice.Address(),
+		Validator: validator,
+		Amount:    coin.NewCoin(40, 0, "IOV"),
+	})
+	assert.Equal(t, []weave.ValidatorUpdate{{PubKey: validator, Power: 2}}, res.Diff)
+}
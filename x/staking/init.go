@@ -0,0 +1,257 @@
+package staking
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file
+type Initializer struct {
+	// Scheduler, if set, is used to schedule the release of unbondings
+	// loaded without a release task.
+	Scheduler weave.Scheduler
+}
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+type genesisStaking struct {
+	Delegations []genesisDelegation `json:"delegations"`
+	Stakes      []genesisStake      `json:"stakes"`
+	Unbondings  []genesisUnbonding  `json:"unbondings,omitempty"`
+}
+
+type genesisDelegation struct {
+	Delegator weave.Address `json:"delegator"`
+	Validator weave.PubKey  `json:"validator"`
+	Amount    coin.Coin     `json:"amount"`
+}
+
+type genesisStake struct {
+	Validator    weave.PubKey `json:"validator"`
+	Amount       coin.Coin    `json:"amount"`
+	SlashedPower int64        `json:"slashed_power,omitempty"`
+}
+
+type genesisUnbonding struct {
+	ID        uint64         `json:"id"`
+	Delegator weave.Address  `json:"delegator"`
+	Validator weave.PubKey   `json:"validator"`
+	Amount    coin.Coin      `json:"amount"`
+	ReleaseAt weave.UnixTime `json:"release_at"`
+	// ReleaseTaskID is the ID of the task that releases the unbonded
+	// coins. It must be loaded by the cron extension.
+	ReleaseTaskID []byte `json:"release_task_id,omitempty"`
+}
+
+// FromGenesis will parse the staking configuration from genesis and save it
+// to the database. Configuration is optional, but coins cannot be bonded
+// until it is present. Delegations and stakes are loaded as well. The stake
+// of each validator must be equal to the sum of its delegations. A slashed
+// validator can have a stake without delegations, with a zero amount.
+//
+// Unbondings are stored under their IDs. If an unbonding has no release task
+// and a scheduler is configured, the release is scheduled.
+//
+// Bonded and unbonding coins are not issued, because they are part of the
+// cash extension state.
+func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	if err := gconf.InitConfig(kv, opts, "staking", &Configuration{}); err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "init config")
+	}
+
+	var genesis genesisStaking
+	if err := opts.ReadOptions("staking", &genesis); err != nil {
+		return err
+	}
+
+	// Sum of all delegations, by the validator public key.
+	bonded := make(map[string]coin.Coin)
+
+	delegations := NewDelegationBucket()
+	for i, g := range genesis.Delegations {
+		d := Delegation{
+			Metadata:  &weave.Metadata{Schema: 1},
+			Delegator: g.Delegator,
+			Validator: g.Validator,
+			Amount:    g.Amount,
+		}
+		key := delegationKey(d.Delegator, d.Validator)
+		switch err := delegations.Has(kv, key); {
+		case err == nil:
+			return errors.Wrapf(errors.ErrDuplicate, "delegation %d", i)
+		case !errors.ErrNotFound.Is(err):
+			return errors.Wrapf(err, "delegation %d", i)
+		}
+		if _, err := delegations.Put(kv, key, &d); err != nil {
+			return errors.Wrapf(err, "delegation %d", i)
+		}
+
+		total, ok := bonded[string(d.Validator.Data)]
+		if !ok {
+			total = coin.Coin{Ticker: d.Amount.Ticker}
+		}
+		total, err := total.Add(d.Amount)
+		if err != nil {
+			return errors.Wrapf(err, "delegation %d", i)
+		}
+		bonded[string(d.Validator.Data)] = total
+	}
+
+	stakes := NewStakeBucket()
+	for i, g := range genesis.Stakes {
+		s := Stake{
+			Metadata:     &weave.Metadata{Schema: 1},
+			Validator:    g.Validator,
+			Amount:       g.Amount,
+			SlashedPower: g.SlashedPower,
+		}
+		total, ok := bonded[string(s.Validator.Data)]
+		if !ok {
+			total = coin.Coin{Ticker: s.Amount.Ticker}
+		}
+		if !total.Equals(s.Amount) {
+			return errors.Wrapf(errors.ErrState, "stake %d is not equal to the sum of its delegations", i)
+		}
+		delete(bonded, string(s.Validator.Data))
+		if _, err := stakes.Put(kv, s.Validator.Data, &s); err != nil {
+			return errors.Wrapf(err, "stake %d", i)
+		}
+	}
+	if len(bonded) != 0 {
+		return errors.Wrapf(errors.ErrState, "%d validators with delegations have no stake", len(bonded))
+	}
+
+	unbondings := NewUnbondingBucket()
+	for n, g := range genesis.Unbondings {
+		if g.ID == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "unbonding %d: ID", n)
+		}
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, g.ID)
+		switch err := unbondings.Has(kv, key); {
+		case err == nil:
+			return errors.Wrapf(errors.ErrDuplicate, "unbonding %d", n)
+		case !errors.ErrNotFound.Is(err):
+			return errors.Wrapf(err, "unbonding %d", n)
+		}
+		u := Unbonding{
+			Metadata:      &weave.Metadata{Schema: 1},
+			Delegator:     g.Delegator,
+			Validator:     g.Validator,
+			Amount:        g.Amount,
+			ReleaseAt:     g.ReleaseAt,
+			ReleaseTaskID: g.ReleaseTaskID,
+		}
+		if len(u.ReleaseTaskID) == 0 && i.Scheduler != nil {
+			releaseMsg := &ReleaseUnbondingMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				UnbondingID: key,
+			}
+			taskID, err := i.Scheduler.Schedule(kv, u.ReleaseAt.Time(), nil, releaseMsg)
+			if err != nil {
+				return errors.Wrapf(err, "unbonding %d: cannot schedule release task", n)
+			}
+			u.ReleaseTaskID = taskID
+		}
+		if _, err := unbondings.Put(kv, key, &u); err != nil {
+			return errors.Wrapf(err, "unbonding %d", n)
+		}
+		if err := unbondingSeq.Raise(kv, int64(g.ID)); err != nil {
+			return errors.Wrapf(err, "unbonding %d: sequence", n)
+		}
+	}
+	return nil
+}
+
+// ToGenesis will export the configuration, if present, together with all
+// delegations, stakes and unbondings from the database.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	opts, err := gconf.ExportConfig(kv, "staking", &Configuration{})
+	switch {
+	case errors.ErrNotFound.Is(err):
+		opts = make(weave.Options)
+	case err != nil:
+		return nil, errors.Wrap(err, "export config")
+	}
+
+	genesis := genesisStaking{
+		Delegations: []genesisDelegation{},
+		Stakes:      []genesisStake{},
+	}
+
+	it := orm.IterAll("delegation")
+	for {
+		var d Delegation
+		key, err := it.Next(kv, &d)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "delegation")
+		}
+		if !bytes.Equal(key, delegationKey(d.Delegator, d.Validator)) {
+			return nil, errors.Wrapf(errors.ErrState, "delegation %X is not stored under its key", key)
+		}
+		genesis.Delegations = append(genesis.Delegations, genesisDelegation{
+			Delegator: d.Delegator,
+			Validator: d.Validator,
+			Amount:    d.Amount,
+		})
+	}
+
+	it = orm.IterAll("stake")
+	for {
+		var s Stake
+		key, err := it.Next(kv, &s)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "stake")
+		}
+		if !bytes.Equal(key, s.Validator.Data) {
+			return nil, errors.Wrapf(errors.ErrState, "stake %X is not stored under its validator key", key)
+		}
+		genesis.Stakes = append(genesis.Stakes, genesisStake{
+			Validator:    s.Validator,
+			Amount:       s.Amount,
+			SlashedPower: s.SlashedPower,
+		})
+	}
+
+	it = orm.IterAll("unbonding")
+	for {
+		var u Unbonding
+		key, err := it.Next(kv, &u)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "unbonding")
+		}
+		if err := orm.ValidateSequence(key); err != nil {
+			return nil, errors.Wrapf(err, "unbonding %x", key)
+		}
+		genesis.Unbondings = append(genesis.Unbondings, genesisUnbonding{
+			ID:            binary.BigEndian.Uint64(key),
+			Delegator:     u.Delegator,
+			Validator:     u.Validator,
+			Amount:        u.Amount,
+			ReleaseAt:     u.ReleaseAt,
+			ReleaseTaskID: u.ReleaseTaskID,
+		})
+	}
+
+	if err := opts.WriteOptions("staking", genesis); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
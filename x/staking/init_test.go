@@ -0,0 +1,149 @@
+package staking
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "staking")
+
+	conf := Configuration{
+		Metadata:        &weave.Metadata{Schema: 1},
+		BondTicker:      "IOV",
+		UnbondingPeriod: weave.AsUnixDuration(time.Hour),
+		TokensPerPower:  10,
+	}
+	assert.Nil(t, gconf.Save(db, "staking", &conf))
+
+	validator := weave.PubKey{
+		Type: "ed25519",
+		Data: weavetest.NewKey().PublicKey().GetEd25519(),
+	}
+	alice := Delegation{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Delegator: weavetest.NewCondition().Address(),
+		Validator: validator,
+		Amount:    coin.NewCoin(30, 0, "IOV"),
+	}
+	bob := Delegation{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Delegator: weavetest.NewCondition().Address(),
+		Validator: validator,
+		Amount:    coin.NewCoin(12, 0, "IOV"),
+	}
+	stake := Stake{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Validator:    validator,
+		Amount:       coin.NewCoin(42, 0, "IOV"),
+		SlashedPower: 1,
+	}
+	// Slashed validator stake is kept after all coins are unbonded.
+	slashed := Stake{
+		Metadata: &weave.Metadata{Schema: 1},
+		Validator: weave.PubKey{
+			Type: "ed25519",
+			Data: weavetest.NewKey().PublicKey().GetEd25519(),
+		},
+		Amount:       coin.NewCoin(0, 0, "IOV"),
+		SlashedPower: 3,
+	}
+	delegations := NewDelegationBucket()
+	for _, d := range []Delegation{alice, bob} {
+		_, err := delegations.Put(db, delegationKey(d.Delegator, d.Validator), &d)
+		assert.Nil(t, err)
+	}
+	for _, s := range []Stake{stake, slashed} {
+		_, err := NewStakeBucket().Put(db, s.Validator.Data, &s)
+		assert.Nil(t, err)
+	}
+
+	var ini Initializer
+	opts, err := ini.ToGenesis(db)
+	assert.Nil(t, err)
+
+	// Exported state must survive serialization, as it does when written
+	// to a genesis file.
+	raw, err := json.Marshal(opts)
+	assert.Nil(t, err)
+	var reopts weave.Options
+	assert.Nil(t, json.Unmarshal(raw, &reopts))
+
+	redb := store.MemStore()
+	migration.MustInitPkg(redb, "staking")
+	assert.Nil(t, ini.FromGenesis(reopts, weave.GenesisParams{}, redb))
+
+	for _, d := range []Delegation{alice, bob} {
+		var red Delegation
+		assert.Nil(t, delegations.One(redb, delegationKey(d.Delegator, d.Validator), &red))
+		assert.Equal(t, d, red)
+	}
+	for _, s := range []Stake{stake, slashed} {
+		var restake Stake
+		assert.Nil(t, NewStakeBucket().One(redb, s.Validator.Data, &restake))
+		assert.Equal(t, s, restake)
+	}
+
+	var reconf Configuration
+	assert.Nil(t, gconf.Load(redb, "staking", &reconf))
+	assert.Equal(t, conf, reconf)
+
+	// Unbonding is exported together with its release task.
+	unbonding := Unbonding{
+		Metadata:      &weave.Metadata{Schema: 1},
+		Delegator:     alice.Delegator,
+		Validator:     validator,
+		Amount:        coin.NewCoin(1, 0, "IOV"),
+		ReleaseAt:     weave.AsUnixTime(time.Now()),
+		ReleaseTaskID: weavetest.SequenceID(3),
+	}
+	id, err := NewUnbondingBucket().Put(db, nil, &unbonding)
+	assert.Nil(t, err)
+	opts, err = ini.ToGenesis(db)
+	assert.Nil(t, err)
+	redb = store.MemStore()
+	migration.MustInitPkg(redb, "staking")
+	assert.Nil(t, ini.FromGenesis(opts, weave.GenesisParams{}, redb))
+	var reunbonding Unbonding
+	assert.Nil(t, NewUnbondingBucket().One(redb, id, &reunbonding))
+	assert.Equal(t, unbonding, reunbonding)
+}
+
+func TestGenesisStakeMismatch(t *testing.T) {
+	validator := weave.PubKey{
+		Type: "ed25519",
+		Data: weavetest.NewKey().PublicKey().GetEd25519(),
+	}
+	genesis := genesisStaking{
+		Delegations: []genesisDelegation{
+			{
+				Delegator: weavetest.NewCondition().Address(),
+				Validator: validator,
+				Amount:    coin.NewCoin(30, 0, "IOV"),
+			},
+		},
+		Stakes: []genesisStake{
+			{Validator: validator, Amount: coin.NewCoin(31, 0, "IOV")},
+		},
+	}
+	opts := make(weave.Options)
+	assert.Nil(t, opts.WriteOptions("staking", genesis))
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "staking")
+	var ini Initializer
+	if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package staking
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &Delegation{}, migration.NoModification)
+	migration.MustRegister(1, &Stake{}, migration.NoModification)
+	migration.MustRegister(1, &Unbonding{}, migration.NoModification)
+}
+
+// BondedCondition is the condition of the account that holds all bonded and
+// unbonding coins.
+var BondedCondition = weave.NewCondition("staking", "bonded", []byte("pool"))
+
+var _ orm.Model = (*Delegation)(nil)
+
+func (m *Delegation) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Delegator", m.Delegator.Validate())
+	errs = errors.AppendField(errs, "Validator", validatePubKey(m.Validator))
+	errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
+	return errs
+}
+
+// NewDelegationBucket returns a bucket for storing Delegation entities. A
+// delegation can be queried by the validator public key and by the delegator
+// address.
+func NewDelegationBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("delegation", &Delegation{},
+		orm.WithIndex("validator", delegationValidatorIndex, false),
+		orm.WithIndex("delegator", delegationDelegatorIndex, false),
+	)
+	return migration.NewModelBucket("staking", b)
+}
+
+// delegationKey returns the key of a delegation of given delegator to a
+// validator with given public key.
+func delegationKey(delegator weave.Address, validator weave.PubKey) []byte {
+	return append(append([]byte{}, delegator...), validator.Data...)
+}
+
+func delegationValidatorIndex(obj orm.Object) ([]byte, error) {
+	d, err := asDelegation(obj)
+	if err != nil {
+		return nil, err
+	}
+	return d.Validator.Data, nil
+}
+
+func delegationDelegatorIndex(obj orm.Object) ([]byte, error) {
+	d, err := asDelegation(obj)
+	if err != nil {
+		return nil, err
+	}
+	return d.Delegator, nil
+}
+
+func asDelegation(obj orm.Object) (*Delegation, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	d, ok := obj.Value().(*Delegation)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrModel, "cannot take index of %T", obj.Value())
+	}
+	return d, nil
+}
+
+var _ orm.Model = (*Stake)(nil)
+
+func (m *Stake) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Validator", validatePubKey(m.Validator))
+	if m.SlashedPower == 0 {
+		errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
+	} else {
+		// Stake of a slashed validator is kept when all coins are
+		// unbonded, so that the punishment is not forgotten.
+		errs = errors.AppendField(errs, "Amount", m.Amount.Validate())
+		if !m.Amount.IsNonNegative() {
+			errs = errors.AppendField(errs, "Amount", errors.ErrAmount)
+		}
+	}
+	if m.SlashedPower < 0 {
+		errs = errors.AppendField(errs, "SlashedPower", errors.ErrInput)
+	}
+	return errs
+}
+
+// Power returns the validator power derived from the stake, reduced by the
+// slashed power.
+func (m *Stake) Power(conf *Configuration) int64 {
+	power := conf.Power(m.Amount) - m.SlashedPower
+	if power < 0 {
+		return 0
+	}
+	return power
+}
+
+// NewStakeBucket returns a bucket for storing Stake entities, using the
+// validator public key as the key.
+func NewStakeBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("stake", &Stake{})
+	return migration.NewModelBucket("staking", b)
+}
+
+var _ orm.Model = (*Unbonding)(nil)
+
+func (m *Unbonding) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Delegator", m.Delegator.Validate())
+	errs = errors.AppendField(errs, "Validator", validatePubKey(m.Validator))
+	errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
+	errs = errors.AppendField(errs, "ReleaseAt", m.ReleaseAt.Validate())
+	return errs
+}
+
+// NewUnbondingBucket returns a bucket for storing Unbonding entities. An
+// unbonding can be queried by the delegator address.
+func NewUnbondingBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("unbonding", &Unbonding{},
+		orm.WithIDSequence(unbondingSeq),
+		orm.WithIndex("delegator", unbondingDelegatorIndex, false),
+	)
+	return migration.NewModelBucket("staking", b)
+}
+
+var unbondingSeq = orm.NewSequence("unbonding", "id")
+
+func unbondingDelegatorIndex(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	u, ok := obj.Value().(*Unbonding)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrModel, "cannot take index of %T", obj.Value())
+	}
+	return u.Delegator, nil
+}
+
+func validatePubKey(key weave.PubKey) error {
+	return weave.ValidatorUpdate{PubKey: key}.Validate()
+}
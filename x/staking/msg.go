@@ -0,0 +1,99 @@
+package staking
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &BondMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UnbondMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ReleaseUnbondingMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+}
+
+var _ weave.Msg = (*BondMsg)(nil)
+
+func (m *BondMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Delegator", m.Delegator.Validate())
+	errs = errors.AppendField(errs, "Validator", validatePubKey(m.Validator))
+	errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
+	return errs
+}
+
+func (*BondMsg) Path() string {
+	return "staking/bond"
+}
+
+var _ weave.Msg = (*UnbondMsg)(nil)
+
+func (m *UnbondMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Delegator", m.Delegator.Validate())
+	errs = errors.AppendField(errs, "Validator", validatePubKey(m.Validator))
+	errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
+	return errs
+}
+
+func (*UnbondMsg) Path() string {
+	return "staking/unbond"
+}
+
+var _ weave.Msg = (*ReleaseUnbondingMsg)(nil)
+
+func (m *ReleaseUnbondingMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.UnbondingID) == 0 {
+		errs = errors.AppendField(errs, "UnbondingID", errors.ErrEmpty)
+	}
+	return errs
+}
+
+func (*ReleaseUnbondingMsg) Path() string {
+	return "staking/release_unbonding"
+}
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+// Validate will skip any zero fields and validate the set ones.
+func (m *UpdateConfigurationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	c := m.Patch
+	if c == nil {
+		return errors.AppendField(errs, "Patch", errors.ErrEmpty)
+	}
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	if c.BondTicker != "" && !coin.IsCC(c.BondTicker) {
+		errs = errors.Append(errs, errors.Field("BondTicker", errors.ErrCurrency, "invalid ticker"))
+	}
+	if c.UnbondingPeriod < 0 {
+		errs = errors.Append(errs, errors.Field("UnbondingPeriod", errors.ErrInput, "cannot be negative"))
+	}
+	if c.TokensPerPower < 0 {
+		errs = errors.Append(errs, errors.Field("TokensPerPower", errors.ErrInput, "cannot be negative"))
+	}
+	return errs
+}
+
+func (*UpdateConfigurationMsg) Path() string {
+	return "staking/update_configuration"
+}
+
+func validateAmount(c coin.Coin) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	if !c.IsPositive() {
+		return errors.Wrap(errors.ErrAmount, "must be greater than zero")
+	}
+	return nil
+}
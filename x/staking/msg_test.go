@@ -0,0 +1,122 @@
+package staking
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestValidateMsg(t *testing.T) {
+	delegator := weavetest.NewCondition().Address()
+	validator := weave.PubKey{
+		Data: weavetest.NewKey().PublicKey().GetEd25519(),
+		Type: "ed25519",
+	}
+
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid bond": {
+			Msg: &BondMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Delegator: delegator,
+				Validator: validator,
+				Amount:    coin.NewCoin(1, 0, "IOV"),
+			},
+			WantErr: nil,
+		},
+		"bond missing metadata": {
+			Msg: &BondMsg{
+				Delegator: delegator,
+				Validator: validator,
+				Amount:    coin.NewCoin(1, 0, "IOV"),
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"bond zero amount": {
+			Msg: &BondMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Delegator: delegator,
+				Validator: validator,
+				Amount:    coin.NewCoin(0, 0, "IOV"),
+			},
+			WantErr: errors.ErrAmount,
+		},
+		"bond invalid validator": {
+			Msg: &BondMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Delegator: delegator,
+				Validator: weave.PubKey{Type: "ed25519", Data: []byte("short")},
+				Amount:    coin.NewCoin(1, 0, "IOV"),
+			},
+			WantErr: errors.ErrType,
+		},
+		"valid unbond": {
+			Msg: &UnbondMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Delegator: delegator,
+				Validator: validator,
+				Amount:    coin.NewCoin(0, 1, "IOV"),
+			},
+			WantErr: nil,
+		},
+		"unbond missing delegator": {
+			Msg: &UnbondMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Validator: validator,
+				Amount:    coin.NewCoin(1, 0, "IOV"),
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"valid release": {
+			Msg: &ReleaseUnbondingMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				UnbondingID: weavetest.SequenceID(1),
+			},
+			WantErr: nil,
+		},
+		"release missing unbonding ID": {
+			Msg: &ReleaseUnbondingMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"valid configuration update": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					BondTicker:     "IOV",
+					TokensPerPower: 10,
+				},
+			},
+			WantErr: nil,
+		},
+		"configuration update missing patch": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"configuration update invalid ticker": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					BondTicker: "not a ticker",
+				},
+			},
+			WantErr: errors.ErrCurrency,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
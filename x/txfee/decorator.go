@@ -7,6 +7,7 @@ import (
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/x/cash"
 )
 
 type Decorator struct {
@@ -19,6 +20,9 @@ type Decorator struct {
 // This decorator does not directly deduct fees from transaction fee payers
 // account. This decorator depends on presence of cash.DynamicFeeDecorator to
 // withdraw funds equal to the final transaction fee.
+//
+// When checking, this decorator sets the priority of the transaction to the
+// fee and gas payment offered per byte of the transaction size.
 func NewDecorator() *Decorator {
 	return &Decorator{}
 }
@@ -31,7 +35,13 @@ func (d *Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, n
 		return nil, err
 	}
 
-	fee, err := d.fee(store, tx)
+	size, err := txSize(tx)
+	if err != nil {
+		return nil, err
+	}
+	res.Priority = TransactionPriority(tx, size, priorityTicker(store))
+
+	fee, err := d.fee(store, size)
 	if err != nil {
 		if errors.ErrNotFound.Is(err) {
 			// If configuration does not exist, this decorator is no-op.
@@ -55,7 +65,11 @@ func (d *Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 		return nil, err
 	}
 
-	fee, err := d.fee(store, tx)
+	size, err := txSize(tx)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := d.fee(store, size)
 	if err != nil {
 		if errors.ErrNotFound.Is(err) {
 			// If configuration does not exist, this decorator is no-op.
@@ -73,21 +87,81 @@ func (d *Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 	return res, nil
 }
 
-// fee returns a transaction fee value, computed for given transaction and
-// according to the current extension configuration.
-func (Decorator) fee(db weave.ReadOnlyKVStore, tx weave.Tx) (*coin.Coin, error) {
+// fee returns a transaction fee value, computed for given transaction size
+// and according to the current extension configuration.
+func (Decorator) fee(db weave.ReadOnlyKVStore, txSize int) (*coin.Coin, error) {
 	conf, err := loadConf(db)
 	if err != nil {
 		return nil, errors.Wrap(err, "load conf")
 	}
+	return TransactionFee(txSize, conf.BaseFee, conf.FreeBytes)
+}
 
+// txSize returns the size of the serialized transaction, together with
+// signatures.
+func txSize(tx weave.Tx) (int, error) {
 	raw, err := tx.Marshal()
 	if err != nil {
-		return nil, errors.Wrap(err, "tx marshal")
+		return 0, errors.Wrap(err, "tx marshal")
 	}
-	txSize := len(raw) // Together with signatures.
+	return len(raw), nil
+}
 
-	return TransactionFee(txSize, conf.BaseFee, conf.FreeBytes)
+// TransactionPriority returns the payment offered by the transaction per byte
+// of its size, expressed in fractional units of given currency. The payment
+// is the fee together with the price of the whole declared gas limit.
+// Payments in other currencies are ignored. If no currency is given, the
+// currency of the fee is used. Transactions paying more per byte should be
+// processed first. Transactions that do not pay anything have zero priority.
+func TransactionPriority(tx weave.Tx, txSize int, ticker string) int64 {
+	ftx, ok := tx.(cash.FeeTx)
+	if !ok || txSize <= 0 {
+		return 0
+	}
+	info := ftx.GetFees()
+	var payment int64
+	if fee := info.GetFees(); fee != nil && fee.IsPositive() {
+		if ticker == "" {
+			ticker = fee.Ticker
+		}
+		if fee.Ticker == ticker {
+			payment = fractional(*fee)
+		}
+	}
+	if price := info.GetGasPrice(); price != nil && price.IsPositive() && price.Ticker == ticker {
+		gas, err := price.Multiply(info.GetGasLimit())
+		if err != nil {
+			payment = math.MaxInt64
+		} else if f := fractional(gas); payment > math.MaxInt64-f {
+			payment = math.MaxInt64
+		} else {
+			payment += f
+		}
+	}
+	return payment / int64(txSize)
+}
+
+// fractional returns the value of a non negative coin in fractional units,
+// or math.MaxInt64 if it does not fit.
+func fractional(c coin.Coin) int64 {
+	if c.Whole > (math.MaxInt64-coin.MaxFrac)/coin.FracUnit {
+		return math.MaxInt64
+	}
+	return c.Whole*coin.FracUnit + c.Fractional
+}
+
+// priorityTicker returns the currency in which the transaction priority is
+// computed. It is the currency of the size fee or of the minimal fee, if
+// configured.
+func priorityTicker(db weave.ReadOnlyKVStore) string {
+	if conf, err := loadConf(db); err == nil && conf.BaseFee.Ticker != "" {
+		return conf.BaseFee.Ticker
+	}
+	var conf cash.Configuration
+	if err := gconf.Load(db, "cash", &conf); err == nil {
+		return conf.MinimalFee.Ticker
+	}
+	return ""
 }
 
 func TransactionFee(txSize int, baseFee coin.Coin, freeBytes int32) (*coin.Coin, error) {
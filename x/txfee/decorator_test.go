@@ -1,6 +1,7 @@
 package txfee
 
 import (
+	"math"
 	"testing"
 
 	"github.com/iov-one/weave"
@@ -10,6 +11,7 @@ import (
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/x/cash"
 )
 
 func TestDecorator(t *testing.T) {
@@ -176,3 +178,106 @@ func TestDecoratorWithoutConfiguration(t *testing.T) {
 		t.Fatalf("unexpected deliver fee: %v", dres.RequiredFee)
 	}
 }
+
+func TestTransactionPriority(t *testing.T) {
+	cases := map[string]struct {
+		Tx     weave.Tx
+		Size   int
+		Ticker string
+		Want   int64
+	}{
+		"transaction without fee information": {
+			Tx:   &txMock{},
+			Size: 100,
+			Want: 0,
+		},
+		"transaction without fee": {
+			Tx:   &feeTxMock{Fees: &cash.FeeInfo{}},
+			Size: 100,
+			Want: 0,
+		},
+		"whole and fractional fee": {
+			Tx:   &feeTxMock{Fees: &cash.FeeInfo{Fees: coin.NewCoinp(1, 500000000, "IOV")}},
+			Size: 100,
+			Want: 15000000,
+		},
+		"bigger transaction paying the same fee": {
+			Tx:   &feeTxMock{Fees: &cash.FeeInfo{Fees: coin.NewCoinp(1, 500000000, "IOV")}},
+			Size: 1000,
+			Want: 1500000,
+		},
+		"fee in a different currency": {
+			Tx:     &feeTxMock{Fees: &cash.FeeInfo{Fees: coin.NewCoinp(1, 0, "ETH")}},
+			Size:   100,
+			Ticker: "IOV",
+			Want:   0,
+		},
+		"gas payment is included": {
+			Tx: &feeTxMock{Fees: &cash.FeeInfo{
+				Fees:     coin.NewCoinp(0, 1000, "IOV"),
+				GasLimit: 500,
+				GasPrice: coin.NewCoinp(0, 2, "IOV"),
+			}},
+			Size:   10,
+			Ticker: "IOV",
+			Want:   200,
+		},
+		"gas price in a different currency": {
+			Tx: &feeTxMock{Fees: &cash.FeeInfo{
+				Fees:     coin.NewCoinp(0, 1000, "IOV"),
+				GasLimit: 500,
+				GasPrice: coin.NewCoinp(0, 2, "ETH"),
+			}},
+			Size: 10,
+			Want: 100,
+		},
+		"huge gas payment does not overflow": {
+			Tx: &feeTxMock{Fees: &cash.FeeInfo{
+				Fees:     coin.NewCoinp(1, 0, "IOV"),
+				GasLimit: math.MaxInt64,
+				GasPrice: coin.NewCoinp(1, 0, "IOV"),
+			}},
+			Size: 1,
+			Want: math.MaxInt64,
+		},
+		"huge fee does not overflow": {
+			Tx:   &feeTxMock{Fees: &cash.FeeInfo{Fees: coin.NewCoinp(coin.MaxInt, 0, "IOV")}},
+			Size: 2,
+			Want: math.MaxInt64 / 2,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if got := TransactionPriority(tc.Tx, tc.Size, tc.Ticker); got != tc.Want {
+				t.Fatalf("want %d priority, got %d", tc.Want, got)
+			}
+		})
+	}
+}
+
+func TestDecoratorSetsPriority(t *testing.T) {
+	decorator := NewDecorator()
+	db := store.MemStore()
+	migration.MustInitPkg(db, "txfee")
+
+	tx := &feeTxMock{
+		txMock: txMock{Raw: make([]byte, 10)},
+		Fees:   &cash.FeeInfo{Fees: coin.NewCoinp(0, 100, "IOV")},
+	}
+	cres, err := decorator.Check(nil, db, tx, &weavetest.Handler{})
+	if err != nil {
+		t.Fatalf("unexpected check error: %v", err)
+	}
+	if cres.Priority != 10 {
+		t.Fatalf("unexpected priority: %d", cres.Priority)
+	}
+}
+
+type feeTxMock struct {
+	txMock
+	Fees *cash.FeeInfo
+}
+
+func (m *feeTxMock) GetFees() *cash.FeeInfo {
+	return m.Fees
+}
@@ -11,6 +11,7 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
@@ -22,3 +23,15 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 
 	return nil
 }
+
+// ToGenesis will export the configuration, if present, from the database.
+func (*Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	opts, err := gconf.ExportConfig(kv, "txfee", &Configuration{})
+	switch {
+	case errors.ErrNotFound.Is(err):
+		return weave.Options{}, nil
+	case err != nil:
+		return nil, errors.Wrap(err, "export config")
+	}
+	return opts, nil
+}
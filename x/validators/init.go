@@ -14,6 +14,7 @@ const (
 type Initializer struct{}
 
 var _ weave.Initializer = Initializer{}
+var _ weave.Exporter = Initializer{}
 
 // FromGenesis will parse initial account info from genesis
 // and save it to the database
@@ -39,3 +40,26 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 
 	return errors.Wrap(weave.StoreValidatorUpdates(kv, vu), "store validator updates")
 }
+
+// ToGenesis will export the list of accounts that are allowed to update
+// validators. Current validators are not part of the application state in
+// the genesis file and must be exported separately.
+func (Initializer) ToGenesis(kv weave.ReadOnlyKVStore) (weave.Options, error) {
+	obj, err := NewAccountBucket().Get(kv, []byte(accountListKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load accounts")
+	}
+	accounts := WeaveAccounts{Addresses: []weave.Address{}}
+	if obj != nil {
+		acc, ok := obj.Value().(*Accounts)
+		if !ok {
+			return nil, errors.WithType(errors.ErrModel, obj.Value())
+		}
+		accounts = AsWeaveAccounts(acc)
+	}
+	opts := make(weave.Options)
+	if err := opts.WriteOptions(optKey, accounts); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}